@@ -21,6 +21,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LoopMode int32
+
+const (
+	LoopMode_LOOP_MODE_OFF   LoopMode = 0
+	LoopMode_LOOP_MODE_TRACK LoopMode = 1
+	LoopMode_LOOP_MODE_QUEUE LoopMode = 2
+)
+
+// Enum value maps for LoopMode.
+var (
+	LoopMode_name = map[int32]string{
+		0: "LOOP_MODE_OFF",
+		1: "LOOP_MODE_TRACK",
+		2: "LOOP_MODE_QUEUE",
+	}
+	LoopMode_value = map[string]int32{
+		"LOOP_MODE_OFF":   0,
+		"LOOP_MODE_TRACK": 1,
+		"LOOP_MODE_QUEUE": 2,
+	}
+)
+
+func (x LoopMode) Enum() *LoopMode {
+	p := new(LoopMode)
+	*p = x
+	return p
+}
+
+func (x LoopMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoopMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_thalassa_v1_thalassa_proto_enumTypes[0].Descriptor()
+}
+
+func (LoopMode) Type() protoreflect.EnumType {
+	return &file_thalassa_v1_thalassa_proto_enumTypes[0]
+}
+
+func (x LoopMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoopMode.Descriptor instead.
+func (LoopMode) EnumDescriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{0}
+}
+
+type StatsPeriod int32
+
+const (
+	StatsPeriod_STATS_PERIOD_ALL_TIME StatsPeriod = 0
+	StatsPeriod_STATS_PERIOD_DAY      StatsPeriod = 1
+	StatsPeriod_STATS_PERIOD_WEEK     StatsPeriod = 2
+	StatsPeriod_STATS_PERIOD_MONTH    StatsPeriod = 3
+	StatsPeriod_STATS_PERIOD_YEAR     StatsPeriod = 4
+)
+
+// Enum value maps for StatsPeriod.
+var (
+	StatsPeriod_name = map[int32]string{
+		0: "STATS_PERIOD_ALL_TIME",
+		1: "STATS_PERIOD_DAY",
+		2: "STATS_PERIOD_WEEK",
+		3: "STATS_PERIOD_MONTH",
+		4: "STATS_PERIOD_YEAR",
+	}
+	StatsPeriod_value = map[string]int32{
+		"STATS_PERIOD_ALL_TIME": 0,
+		"STATS_PERIOD_DAY":      1,
+		"STATS_PERIOD_WEEK":     2,
+		"STATS_PERIOD_MONTH":    3,
+		"STATS_PERIOD_YEAR":     4,
+	}
+)
+
+func (x StatsPeriod) Enum() *StatsPeriod {
+	p := new(StatsPeriod)
+	*p = x
+	return p
+}
+
+func (x StatsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_thalassa_v1_thalassa_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsPeriod) Type() protoreflect.EnumType {
+	return &file_thalassa_v1_thalassa_proto_enumTypes[1]
+}
+
+func (x StatsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsPeriod.Descriptor instead.
+func (StatsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{1}
+}
+
 type SongRequestsUpdateEvent_EventType int32
 
 const (
@@ -29,16 +133,28 @@ const (
 	SongRequestsUpdateEvent_SONG_REQUEST_FINISHED    SongRequestsUpdateEvent_EventType = 2
 	SongRequestsUpdateEvent_SONG_REQUEST_SKIPPED     SongRequestsUpdateEvent_EventType = 3
 	SongRequestsUpdateEvent_SONG_REQUEST_SKIPPED_ALL SongRequestsUpdateEvent_EventType = 4
+	SongRequestsUpdateEvent_SONG_REQUEST_REMOVED     SongRequestsUpdateEvent_EventType = 5
+	SongRequestsUpdateEvent_SONG_REQUEST_MOVED       SongRequestsUpdateEvent_EventType = 6
+	SongRequestsUpdateEvent_SONG_QUEUE_SHUFFLED      SongRequestsUpdateEvent_EventType = 7
+	SongRequestsUpdateEvent_SONG_REQUEST_PAUSED      SongRequestsUpdateEvent_EventType = 8
+	SongRequestsUpdateEvent_SONG_REQUEST_RESUMED     SongRequestsUpdateEvent_EventType = 9
+	SongRequestsUpdateEvent_SONG_REQUEST_SEEKED      SongRequestsUpdateEvent_EventType = 10
 )
 
 // Enum value maps for SongRequestsUpdateEvent_EventType.
 var (
 	SongRequestsUpdateEvent_EventType_name = map[int32]string{
-		0: "SONG_REQUEST_ADDED",
-		1: "SONG_REQUEST_PLAYING",
-		2: "SONG_REQUEST_FINISHED",
-		3: "SONG_REQUEST_SKIPPED",
-		4: "SONG_REQUEST_SKIPPED_ALL",
+		0:  "SONG_REQUEST_ADDED",
+		1:  "SONG_REQUEST_PLAYING",
+		2:  "SONG_REQUEST_FINISHED",
+		3:  "SONG_REQUEST_SKIPPED",
+		4:  "SONG_REQUEST_SKIPPED_ALL",
+		5:  "SONG_REQUEST_REMOVED",
+		6:  "SONG_REQUEST_MOVED",
+		7:  "SONG_QUEUE_SHUFFLED",
+		8:  "SONG_REQUEST_PAUSED",
+		9:  "SONG_REQUEST_RESUMED",
+		10: "SONG_REQUEST_SEEKED",
 	}
 	SongRequestsUpdateEvent_EventType_value = map[string]int32{
 		"SONG_REQUEST_ADDED":       0,
@@ -46,6 +162,12 @@ var (
 		"SONG_REQUEST_FINISHED":    2,
 		"SONG_REQUEST_SKIPPED":     3,
 		"SONG_REQUEST_SKIPPED_ALL": 4,
+		"SONG_REQUEST_REMOVED":     5,
+		"SONG_REQUEST_MOVED":       6,
+		"SONG_QUEUE_SHUFFLED":      7,
+		"SONG_REQUEST_PAUSED":      8,
+		"SONG_REQUEST_RESUMED":     9,
+		"SONG_REQUEST_SEEKED":      10,
 	}
 )
 
@@ -60,11 +182,11 @@ func (x SongRequestsUpdateEvent_EventType) String() string {
 }
 
 func (SongRequestsUpdateEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_thalassa_v1_thalassa_proto_enumTypes[0].Descriptor()
+	return file_thalassa_v1_thalassa_proto_enumTypes[2].Descriptor()
 }
 
 func (SongRequestsUpdateEvent_EventType) Type() protoreflect.EnumType {
-	return &file_thalassa_v1_thalassa_proto_enumTypes[0]
+	return &file_thalassa_v1_thalassa_proto_enumTypes[2]
 }
 
 func (x SongRequestsUpdateEvent_EventType) Number() protoreflect.EnumNumber {
@@ -73,7 +195,7 @@ func (x SongRequestsUpdateEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SongRequestsUpdateEvent_EventType.Descriptor instead.
 func (SongRequestsUpdateEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{8, 0}
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type Song struct {
@@ -81,8 +203,9 @@ type Song struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SongName          string `protobuf:"bytes,1,opt,name=song_name,json=songName,proto3" json:"song_name,omitempty"`
-	Url               string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	SongName string `protobuf:"bytes,1,opt,name=song_name,json=songName,proto3" json:"song_name,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Live streams have no duration.
 	IsStream          bool   `protobuf:"varint,3,opt,name=is_stream,json=isStream,proto3" json:"is_stream,omitempty"`
 	Artist            string `protobuf:"bytes,4,opt,name=artist,proto3" json:"artist,omitempty"`
 	Track             string `protobuf:"bytes,5,opt,name=track,proto3" json:"track,omitempty"`
@@ -217,6 +340,8 @@ type SongRequest struct {
 	Song              *Song                  `protobuf:"bytes,7,opt,name=song,proto3" json:"song,omitempty"`
 	Id                int64                  `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 	RequestedByUserId string                 `protobuf:"bytes,9,opt,name=requested_by_user_id,json=requestedByUserId,proto3" json:"requested_by_user_id,omitempty"`
+	Position          int64                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	Autoplay          bool                   `protobuf:"varint,11,opt,name=autoplay,proto3" json:"autoplay,omitempty"`
 }
 
 func (x *SongRequest) Reset() {
@@ -314,6 +439,20 @@ func (x *SongRequest) GetRequestedByUserId() string {
 	return ""
 }
 
+func (x *SongRequest) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SongRequest) GetAutoplay() bool {
+	if x != nil {
+		return x.Autoplay
+	}
+	return false
+}
+
 type GetSongRequestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -602,11 +741,18 @@ type GetCurrentSongPlayingResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
-	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	Song        *Song                  `protobuf:"bytes,3,opt,name=song,proto3" json:"song,omitempty"`
-	RequestedBy string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
-	SongRequest *SongRequest           `protobuf:"bytes,5,opt,name=song_request,json=songRequest,proto3" json:"song_request,omitempty"`
+	RequestedAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Song             *Song                  `protobuf:"bytes,3,opt,name=song,proto3" json:"song,omitempty"`
+	RequestedBy      string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	SongRequest      *SongRequest           `protobuf:"bytes,5,opt,name=song_request,json=songRequest,proto3" json:"song_request,omitempty"`
+	Paused           bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedDurationMs int64                  `protobuf:"varint,7,opt,name=paused_duration_ms,json=pausedDurationMs,proto3" json:"paused_duration_ms,omitempty"`
+	PositionMs       int64                  `protobuf:"varint,8,opt,name=position_ms,json=positionMs,proto3" json:"position_ms,omitempty"`
+	// Time until the song ends, or until a live stream is stopped by the guild's max stream time.
+	RemainingMs int64 `protobuf:"varint,9,opt,name=remaining_ms,json=remainingMs,proto3" json:"remaining_ms,omitempty"`
+	// Set when the remaining time isn't known, like for a live stream that plays until it's skipped.
+	RemainingUnknown bool `protobuf:"varint,10,opt,name=remaining_unknown,json=remainingUnknown,proto3" json:"remaining_unknown,omitempty"`
 }
 
 func (x *GetCurrentSongPlayingResponse) Reset() {
@@ -676,17 +822,51 @@ func (x *GetCurrentSongPlayingResponse) GetSongRequest() *SongRequest {
 	return nil
 }
 
-type SongRequestsUpdateEvent struct {
+func (x *GetCurrentSongPlayingResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GetCurrentSongPlayingResponse) GetPausedDurationMs() int64 {
+	if x != nil {
+		return x.PausedDurationMs
+	}
+	return 0
+}
+
+func (x *GetCurrentSongPlayingResponse) GetPositionMs() int64 {
+	if x != nil {
+		return x.PositionMs
+	}
+	return 0
+}
+
+func (x *GetCurrentSongPlayingResponse) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+func (x *GetCurrentSongPlayingResponse) GetRemainingUnknown() bool {
+	if x != nil {
+		return x.RemainingUnknown
+	}
+	return false
+}
+
+type GetMusicSettingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventType   SongRequestsUpdateEvent_EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=thalassa.v1.SongRequestsUpdateEvent_EventType" json:"event_type,omitempty"`
-	SongRequest *SongRequest                      `protobuf:"bytes,2,opt,name=song_request,json=songRequest,proto3" json:"song_request,omitempty"`
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
 }
 
-func (x *SongRequestsUpdateEvent) Reset() {
-	*x = SongRequestsUpdateEvent{}
+func (x *GetMusicSettingsRequest) Reset() {
+	*x = GetMusicSettingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_thalassa_v1_thalassa_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,13 +874,13 @@ func (x *SongRequestsUpdateEvent) Reset() {
 	}
 }
 
-func (x *SongRequestsUpdateEvent) String() string {
+func (x *GetMusicSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SongRequestsUpdateEvent) ProtoMessage() {}
+func (*GetMusicSettingsRequest) ProtoMessage() {}
 
-func (x *SongRequestsUpdateEvent) ProtoReflect() protoreflect.Message {
+func (x *GetMusicSettingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_thalassa_v1_thalassa_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,35 +892,40 @@ func (x *SongRequestsUpdateEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SongRequestsUpdateEvent.ProtoReflect.Descriptor instead.
-func (*SongRequestsUpdateEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMusicSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetMusicSettingsRequest) Descriptor() ([]byte, []int) {
 	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SongRequestsUpdateEvent) GetEventType() SongRequestsUpdateEvent_EventType {
-	if x != nil {
-		return x.EventType
-	}
-	return SongRequestsUpdateEvent_SONG_REQUEST_ADDED
-}
-
-func (x *SongRequestsUpdateEvent) GetSongRequest() *SongRequest {
+func (x *GetMusicSettingsRequest) GetGuildId() string {
 	if x != nil {
-		return x.SongRequest
+		return x.GuildId
 	}
-	return nil
+	return ""
 }
 
-type SongRequestsUpdateStreamRequest struct {
+type GetMusicSettingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-}
-
-func (x *SongRequestsUpdateStreamRequest) Reset() {
-	*x = SongRequestsUpdateStreamRequest{}
+	// Volume as a multiplier of the original volume, where 1 is 100%.
+	Volume    float32  `protobuf:"fixed32,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	LoopMode  LoopMode `protobuf:"varint,2,opt,name=loop_mode,json=loopMode,proto3,enum=thalassa.v1.LoopMode" json:"loop_mode,omitempty"`
+	Autoplay  bool     `protobuf:"varint,3,opt,name=autoplay,proto3" json:"autoplay,omitempty"`
+	FairQueue bool     `protobuf:"varint,4,opt,name=fair_queue,json=fairQueue,proto3" json:"fair_queue,omitempty"`
+	// Audio filter preset songs are played with, like nightcore or eq:3,0,0,-2,4. Empty when there's none.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Role whose members are DJs. Empty when there's none.
+	DjRoleId string `protobuf:"bytes,6,opt,name=dj_role_id,json=djRoleId,proto3" json:"dj_role_id,omitempty"`
+	// Set when only DJs can add songs.
+	QueueLocked bool `protobuf:"varint,7,opt,name=queue_locked,json=queueLocked,proto3" json:"queue_locked,omitempty"`
+	// Only DJs can add songs while more people than this are listening. 0 when there's no limit.
+	DjOnlyListeners int32 `protobuf:"varint,8,opt,name=dj_only_listeners,json=djOnlyListeners,proto3" json:"dj_only_listeners,omitempty"`
+}
+
+func (x *GetMusicSettingsResponse) Reset() {
+	*x = GetMusicSettingsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_thalassa_v1_thalassa_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,13 +933,13 @@ func (x *SongRequestsUpdateStreamRequest) Reset() {
 	}
 }
 
-func (x *SongRequestsUpdateStreamRequest) String() string {
+func (x *GetMusicSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SongRequestsUpdateStreamRequest) ProtoMessage() {}
+func (*GetMusicSettingsResponse) ProtoMessage() {}
 
-func (x *SongRequestsUpdateStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMusicSettingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_thalassa_v1_thalassa_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,28 +951,78 @@ func (x *SongRequestsUpdateStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SongRequestsUpdateStreamRequest.ProtoReflect.Descriptor instead.
-func (*SongRequestsUpdateStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMusicSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetMusicSettingsResponse) Descriptor() ([]byte, []int) {
 	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SongRequestsUpdateStreamRequest) GetGuildId() string {
+func (x *GetMusicSettingsResponse) GetVolume() float32 {
 	if x != nil {
-		return x.GuildId
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *GetMusicSettingsResponse) GetLoopMode() LoopMode {
+	if x != nil {
+		return x.LoopMode
+	}
+	return LoopMode_LOOP_MODE_OFF
+}
+
+func (x *GetMusicSettingsResponse) GetAutoplay() bool {
+	if x != nil {
+		return x.Autoplay
+	}
+	return false
+}
+
+func (x *GetMusicSettingsResponse) GetFairQueue() bool {
+	if x != nil {
+		return x.FairQueue
+	}
+	return false
+}
+
+func (x *GetMusicSettingsResponse) GetFilter() string {
+	if x != nil {
+		return x.Filter
 	}
 	return ""
 }
 
-type SongRequestsUpdateStreamResponse struct {
+func (x *GetMusicSettingsResponse) GetDjRoleId() string {
+	if x != nil {
+		return x.DjRoleId
+	}
+	return ""
+}
+
+func (x *GetMusicSettingsResponse) GetQueueLocked() bool {
+	if x != nil {
+		return x.QueueLocked
+	}
+	return false
+}
+
+func (x *GetMusicSettingsResponse) GetDjOnlyListeners() int32 {
+	if x != nil {
+		return x.DjOnlyListeners
+	}
+	return 0
+}
+
+type GetMusicAccessRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *SongRequestsUpdateEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *SongRequestsUpdateStreamResponse) Reset() {
-	*x = SongRequestsUpdateStreamResponse{}
+func (x *GetMusicAccessRequest) Reset() {
+	*x = GetMusicAccessRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_thalassa_v1_thalassa_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,13 +1030,13 @@ func (x *SongRequestsUpdateStreamResponse) Reset() {
 	}
 }
 
-func (x *SongRequestsUpdateStreamResponse) String() string {
+func (x *GetMusicAccessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SongRequestsUpdateStreamResponse) ProtoMessage() {}
+func (*GetMusicAccessRequest) ProtoMessage() {}
 
-func (x *SongRequestsUpdateStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMusicAccessRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_thalassa_v1_thalassa_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,222 +1048,2130 @@ func (x *SongRequestsUpdateStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SongRequestsUpdateStreamResponse.ProtoReflect.Descriptor instead.
-func (*SongRequestsUpdateStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMusicAccessRequest.ProtoReflect.Descriptor instead.
+func (*GetMusicAccessRequest) Descriptor() ([]byte, []int) {
 	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SongRequestsUpdateStreamResponse) GetEvent() *SongRequestsUpdateEvent {
+func (x *GetMusicAccessRequest) GetGuildId() string {
 	if x != nil {
-		return x.Event
+		return x.GuildId
 	}
-	return nil
+	return ""
 }
 
-var File_thalassa_v1_thalassa_proto protoreflect.FileDescriptor
-
-var file_thalassa_v1_thalassa_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68,
-	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x68,
-	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x02, 0x0a, 0x04, 0x53,
-	0x6f, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
-	0x62, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d,
-	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf9, 0x02, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x12, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x75, 0x69, 0x6c, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x64, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f,
-	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61,
-	0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e,
-	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65,
-	0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x22, 0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x73,
-	0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x6f,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0x44, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x55, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50,
-	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0xa0, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b,
-	0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b,
-	0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb8, 0x02, 0x0a, 0x17,
-	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x74, 0x68,
-	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
-	0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x4e,
-	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18,
-	0x0a, 0x14, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53,
-	0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4f, 0x4e, 0x47,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44,
-	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x22, 0x3c, 0x0a, 0x1f, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69,
-	0x6c, 0x64, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x20, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73,
-	0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x32, 0xda, 0x01, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x68,
-	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
-	0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x74, 0x68, 0x61,
-	0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f,
-	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x30, 0x5a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x5f, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x74, 0x68, 0x61,
-	0x6c, 0x61, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
-	0x61, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetMusicAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-var (
-	file_thalassa_v1_thalassa_proto_rawDescOnce sync.Once
-	file_thalassa_v1_thalassa_proto_rawDescData = file_thalassa_v1_thalassa_proto_rawDesc
-)
+type GetMusicAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_thalassa_v1_thalassa_proto_rawDescGZIP() []byte {
-	file_thalassa_v1_thalassa_proto_rawDescOnce.Do(func() {
-		file_thalassa_v1_thalassa_proto_rawDescData = protoimpl.X.CompressGZIP(file_thalassa_v1_thalassa_proto_rawDescData)
-	})
-	return file_thalassa_v1_thalassa_proto_rawDescData
+	// Set when the user has full control over the queue.
+	Dj bool `protobuf:"varint,1,opt,name=dj,proto3" json:"dj,omitempty"`
+	// When the user's temporary DJ grant expires. Unset when they don't have one.
+	DjExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dj_expires_at,json=djExpiresAt,proto3" json:"dj_expires_at,omitempty"`
+	// Set when the user can add songs right now.
+	CanQueue bool `protobuf:"varint,3,opt,name=can_queue,json=canQueue,proto3" json:"can_queue,omitempty"`
+	// Why the user can't add songs. Empty when they can.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-var file_thalassa_v1_thalassa_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_thalassa_v1_thalassa_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_thalassa_v1_thalassa_proto_goTypes = []interface{}{
-	(SongRequestsUpdateEvent_EventType)(0),   // 0: thalassa.v1.SongRequestsUpdateEvent.EventType
-	(*Song)(nil),                             // 1: thalassa.v1.Song
-	(*SongRequest)(nil),                      // 2: thalassa.v1.SongRequest
-	(*GetSongRequestsRequest)(nil),           // 3: thalassa.v1.GetSongRequestsRequest
-	(*GetSongRequestsResponse)(nil),          // 4: thalassa.v1.GetSongRequestsResponse
-	(*AddSongRequestRequest)(nil),            // 5: thalassa.v1.AddSongRequestRequest
-	(*AddSongRequestResponse)(nil),           // 6: thalassa.v1.AddSongRequestResponse
-	(*GetCurrentSongPlayingRequest)(nil),     // 7: thalassa.v1.GetCurrentSongPlayingRequest
-	(*GetCurrentSongPlayingResponse)(nil),    // 8: thalassa.v1.GetCurrentSongPlayingResponse
-	(*SongRequestsUpdateEvent)(nil),          // 9: thalassa.v1.SongRequestsUpdateEvent
-	(*SongRequestsUpdateStreamRequest)(nil),  // 10: thalassa.v1.SongRequestsUpdateStreamRequest
-	(*SongRequestsUpdateStreamResponse)(nil), // 11: thalassa.v1.SongRequestsUpdateStreamResponse
-	(*timestamppb.Timestamp)(nil),            // 12: google.protobuf.Timestamp
+func (x *GetMusicAccessResponse) Reset() {
+	*x = GetMusicAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_thalassa_v1_thalassa_proto_depIdxs = []int32{
-	12, // 0: thalassa.v1.SongRequest.requested_at:type_name -> google.protobuf.Timestamp
-	12, // 1: thalassa.v1.SongRequest.played_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: thalassa.v1.SongRequest.song:type_name -> thalassa.v1.Song
-	2,  // 3: thalassa.v1.GetSongRequestsResponse.song_requests:type_name -> thalassa.v1.SongRequest
-	2,  // 4: thalassa.v1.AddSongRequestResponse.song_request:type_name -> thalassa.v1.SongRequest
-	12, // 5: thalassa.v1.GetCurrentSongPlayingResponse.requested_at:type_name -> google.protobuf.Timestamp
-	12, // 6: thalassa.v1.GetCurrentSongPlayingResponse.started_at:type_name -> google.protobuf.Timestamp
-	1,  // 7: thalassa.v1.GetCurrentSongPlayingResponse.song:type_name -> thalassa.v1.Song
-	2,  // 8: thalassa.v1.GetCurrentSongPlayingResponse.song_request:type_name -> thalassa.v1.SongRequest
-	0,  // 9: thalassa.v1.SongRequestsUpdateEvent.event_type:type_name -> thalassa.v1.SongRequestsUpdateEvent.EventType
-	2,  // 10: thalassa.v1.SongRequestsUpdateEvent.song_request:type_name -> thalassa.v1.SongRequest
-	9,  // 11: thalassa.v1.SongRequestsUpdateStreamResponse.event:type_name -> thalassa.v1.SongRequestsUpdateEvent
-	3,  // 12: thalassa.v1.APIService.GetSongRequests:input_type -> thalassa.v1.GetSongRequestsRequest
-	7,  // 13: thalassa.v1.APIService.GetCurrentSongPlaying:input_type -> thalassa.v1.GetCurrentSongPlayingRequest
-	4,  // 14: thalassa.v1.APIService.GetSongRequests:output_type -> thalassa.v1.GetSongRequestsResponse
-	8,  // 15: thalassa.v1.APIService.GetCurrentSongPlaying:output_type -> thalassa.v1.GetCurrentSongPlayingResponse
-	14, // [14:16] is the sub-list for method output_type
-	12, // [12:14] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+func (x *GetMusicAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_thalassa_v1_thalassa_proto_init() }
-func file_thalassa_v1_thalassa_proto_init() {
+func (*GetMusicAccessResponse) ProtoMessage() {}
+
+func (x *GetMusicAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMusicAccessResponse.ProtoReflect.Descriptor instead.
+func (*GetMusicAccessResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetMusicAccessResponse) GetDj() bool {
+	if x != nil {
+		return x.Dj
+	}
+	return false
+}
+
+func (x *GetMusicAccessResponse) GetDjExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DjExpiresAt
+	}
+	return nil
+}
+
+func (x *GetMusicAccessResponse) GetCanQueue() bool {
+	if x != nil {
+		return x.CanQueue
+	}
+	return false
+}
+
+func (x *GetMusicAccessResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SavedPlaylist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty for playlists shared with the whole guild.
+	OwnerUserId     string                 `protobuf:"bytes,3,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Shared          bool                   `protobuf:"varint,4,opt,name=shared,proto3" json:"shared,omitempty"`
+	CreatedByUserId string                 `protobuf:"bytes,5,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SongCount       int32                  `protobuf:"varint,8,opt,name=song_count,json=songCount,proto3" json:"song_count,omitempty"`
+}
+
+func (x *SavedPlaylist) Reset() {
+	*x = SavedPlaylist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedPlaylist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedPlaylist) ProtoMessage() {}
+
+func (x *SavedPlaylist) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedPlaylist.ProtoReflect.Descriptor instead.
+func (*SavedPlaylist) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SavedPlaylist) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedPlaylist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedPlaylist) GetOwnerUserId() string {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return ""
+}
+
+func (x *SavedPlaylist) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *SavedPlaylist) GetCreatedByUserId() string {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return ""
+}
+
+func (x *SavedPlaylist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedPlaylist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SavedPlaylist) GetSongCount() int32 {
+	if x != nil {
+		return x.SongCount
+	}
+	return 0
+}
+
+type SavedPlaylistSong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 1-based position in the playlist.
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Song          *Song                  `protobuf:"bytes,3,opt,name=song,proto3" json:"song,omitempty"`
+	AddedByUserId string                 `protobuf:"bytes,4,opt,name=added_by_user_id,json=addedByUserId,proto3" json:"added_by_user_id,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (x *SavedPlaylistSong) Reset() {
+	*x = SavedPlaylistSong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedPlaylistSong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedPlaylistSong) ProtoMessage() {}
+
+func (x *SavedPlaylistSong) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedPlaylistSong.ProtoReflect.Descriptor instead.
+func (*SavedPlaylistSong) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SavedPlaylistSong) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedPlaylistSong) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SavedPlaylistSong) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *SavedPlaylistSong) GetAddedByUserId() string {
+	if x != nil {
+		return x.AddedByUserId
+	}
+	return ""
+}
+
+func (x *SavedPlaylistSong) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+type GetSavedPlaylistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// The user's own playlists are listed along with the guild's shared playlists.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetSavedPlaylistsRequest) Reset() {
+	*x = GetSavedPlaylistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSavedPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedPlaylistsRequest) ProtoMessage() {}
+
+func (x *GetSavedPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSavedPlaylistsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GetSavedPlaylistsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSavedPlaylistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SavedPlaylists []*SavedPlaylist `protobuf:"bytes,1,rep,name=saved_playlists,json=savedPlaylists,proto3" json:"saved_playlists,omitempty"`
+}
+
+func (x *GetSavedPlaylistsResponse) Reset() {
+	*x = GetSavedPlaylistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSavedPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedPlaylistsResponse) ProtoMessage() {}
+
+func (x *GetSavedPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*GetSavedPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSavedPlaylistsResponse) GetSavedPlaylists() []*SavedPlaylist {
+	if x != nil {
+		return x.SavedPlaylists
+	}
+	return nil
+}
+
+type GetSavedPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The user asking, who can only see their own personal playlists.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetSavedPlaylistRequest) Reset() {
+	*x = GetSavedPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSavedPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedPlaylistRequest) ProtoMessage() {}
+
+func (x *GetSavedPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetSavedPlaylistRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GetSavedPlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSavedPlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSavedPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SavedPlaylist *SavedPlaylist       `protobuf:"bytes,1,opt,name=saved_playlist,json=savedPlaylist,proto3" json:"saved_playlist,omitempty"`
+	Songs         []*SavedPlaylistSong `protobuf:"bytes,2,rep,name=songs,proto3" json:"songs,omitempty"`
+}
+
+func (x *GetSavedPlaylistResponse) Reset() {
+	*x = GetSavedPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSavedPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedPlaylistResponse) ProtoMessage() {}
+
+func (x *GetSavedPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*GetSavedPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSavedPlaylistResponse) GetSavedPlaylist() *SavedPlaylist {
+	if x != nil {
+		return x.SavedPlaylist
+	}
+	return nil
+}
+
+func (x *GetSavedPlaylistResponse) GetSongs() []*SavedPlaylistSong {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+type CreateSavedPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Shared playlists belong to the whole guild instead of the user.
+	Shared bool `protobuf:"varint,4,opt,name=shared,proto3" json:"shared,omitempty"`
+}
+
+func (x *CreateSavedPlaylistRequest) Reset() {
+	*x = CreateSavedPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSavedPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedPlaylistRequest) ProtoMessage() {}
+
+func (x *CreateSavedPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateSavedPlaylistRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaylistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaylistRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type CreateSavedPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SavedPlaylist *SavedPlaylist `protobuf:"bytes,1,opt,name=saved_playlist,json=savedPlaylist,proto3" json:"saved_playlist,omitempty"`
+}
+
+func (x *CreateSavedPlaylistResponse) Reset() {
+	*x = CreateSavedPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSavedPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedPlaylistResponse) ProtoMessage() {}
+
+func (x *CreateSavedPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavedPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateSavedPlaylistResponse) GetSavedPlaylist() *SavedPlaylist {
+	if x != nil {
+		return x.SavedPlaylist
+	}
+	return nil
+}
+
+type RenameSavedPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UserId  string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RenameSavedPlaylistRequest) Reset() {
+	*x = RenameSavedPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameSavedPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSavedPlaylistRequest) ProtoMessage() {}
+
+func (x *RenameSavedPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSavedPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*RenameSavedPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RenameSavedPlaylistRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *RenameSavedPlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameSavedPlaylistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameSavedPlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RenameSavedPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SavedPlaylist *SavedPlaylist `protobuf:"bytes,1,opt,name=saved_playlist,json=savedPlaylist,proto3" json:"saved_playlist,omitempty"`
+}
+
+func (x *RenameSavedPlaylistResponse) Reset() {
+	*x = RenameSavedPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameSavedPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSavedPlaylistResponse) ProtoMessage() {}
+
+func (x *RenameSavedPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSavedPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*RenameSavedPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RenameSavedPlaylistResponse) GetSavedPlaylist() *SavedPlaylist {
+	if x != nil {
+		return x.SavedPlaylist
+	}
+	return nil
+}
+
+type DeleteSavedPlaylistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UserId  string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteSavedPlaylistRequest) Reset() {
+	*x = DeleteSavedPlaylistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSavedPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedPlaylistRequest) ProtoMessage() {}
+
+func (x *DeleteSavedPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteSavedPlaylistRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *DeleteSavedPlaylistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteSavedPlaylistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteSavedPlaylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSavedPlaylistResponse) Reset() {
+	*x = DeleteSavedPlaylistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSavedPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedPlaylistResponse) ProtoMessage() {}
+
+func (x *DeleteSavedPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{23}
+}
+
+type AddSavedPlaylistSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Url     string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	UserId  string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AddSavedPlaylistSongRequest) Reset() {
+	*x = AddSavedPlaylistSongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSavedPlaylistSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSavedPlaylistSongRequest) ProtoMessage() {}
+
+func (x *AddSavedPlaylistSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSavedPlaylistSongRequest.ProtoReflect.Descriptor instead.
+func (*AddSavedPlaylistSongRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddSavedPlaylistSongRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *AddSavedPlaylistSongRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddSavedPlaylistSongRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddSavedPlaylistSongRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddSavedPlaylistSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song *SavedPlaylistSong `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+}
+
+func (x *AddSavedPlaylistSongResponse) Reset() {
+	*x = AddSavedPlaylistSongResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSavedPlaylistSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSavedPlaylistSongResponse) ProtoMessage() {}
+
+func (x *AddSavedPlaylistSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSavedPlaylistSongResponse.ProtoReflect.Descriptor instead.
+func (*AddSavedPlaylistSongResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddSavedPlaylistSongResponse) GetSong() *SavedPlaylistSong {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type RemoveSavedPlaylistSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Id      int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 1-based position of the song to remove.
+	Position int32  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	UserId   string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RemoveSavedPlaylistSongRequest) Reset() {
+	*x = RemoveSavedPlaylistSongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSavedPlaylistSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSavedPlaylistSongRequest) ProtoMessage() {}
+
+func (x *RemoveSavedPlaylistSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSavedPlaylistSongRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSavedPlaylistSongRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveSavedPlaylistSongRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *RemoveSavedPlaylistSongRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveSavedPlaylistSongRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RemoveSavedPlaylistSongRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveSavedPlaylistSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song *SavedPlaylistSong `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+}
+
+func (x *RemoveSavedPlaylistSongResponse) Reset() {
+	*x = RemoveSavedPlaylistSongResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSavedPlaylistSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSavedPlaylistSongResponse) ProtoMessage() {}
+
+func (x *RemoveSavedPlaylistSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSavedPlaylistSongResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSavedPlaylistSongResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveSavedPlaylistSongResponse) GetSong() *SavedPlaylistSong {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+type SongStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song       *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	Plays      int32 `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+	Skips      int32 `protobuf:"varint,3,opt,name=skips,proto3" json:"skips,omitempty"`
+	Requesters int32 `protobuf:"varint,4,opt,name=requesters,proto3" json:"requesters,omitempty"`
+}
+
+func (x *SongStats) Reset() {
+	*x = SongStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongStats) ProtoMessage() {}
+
+func (x *SongStats) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongStats.ProtoReflect.Descriptor instead.
+func (*SongStats) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SongStats) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *SongStats) GetPlays() int32 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *SongStats) GetSkips() int32 {
+	if x != nil {
+		return x.Skips
+	}
+	return 0
+}
+
+func (x *SongStats) GetRequesters() int32 {
+	if x != nil {
+		return x.Requesters
+	}
+	return 0
+}
+
+type RequesterStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Requests      int32  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	Skips         int32  `protobuf:"varint,4,opt,name=skips,proto3" json:"skips,omitempty"`
+	PlayedSeconds int64  `protobuf:"varint,5,opt,name=played_seconds,json=playedSeconds,proto3" json:"played_seconds,omitempty"`
+}
+
+func (x *RequesterStats) Reset() {
+	*x = RequesterStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequesterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequesterStats) ProtoMessage() {}
+
+func (x *RequesterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequesterStats.ProtoReflect.Descriptor instead.
+func (*RequesterStats) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RequesterStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequesterStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RequesterStats) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RequesterStats) GetSkips() int32 {
+	if x != nil {
+		return x.Skips
+	}
+	return 0
+}
+
+func (x *RequesterStats) GetPlayedSeconds() int64 {
+	if x != nil {
+		return x.PlayedSeconds
+	}
+	return 0
+}
+
+type GetMusicStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string      `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Period  StatsPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=thalassa.v1.StatsPeriod" json:"period,omitempty"`
+	// Only counts the user's requests when set.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// How many top songs and requesters to return, 10 by default.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetMusicStatsRequest) Reset() {
+	*x = GetMusicStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMusicStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMusicStatsRequest) ProtoMessage() {}
+
+func (x *GetMusicStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMusicStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMusicStatsRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetMusicStatsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GetMusicStatsRequest) GetPeriod() StatsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StatsPeriod_STATS_PERIOD_ALL_TIME
+}
+
+func (x *GetMusicStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMusicStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMusicStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Played     int32 `protobuf:"varint,1,opt,name=played,proto3" json:"played,omitempty"`
+	Skipped    int32 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Autoplayed int32 `protobuf:"varint,3,opt,name=autoplayed,proto3" json:"autoplayed,omitempty"`
+	// Number of different songs played.
+	Songs      int32 `protobuf:"varint,4,opt,name=songs,proto3" json:"songs,omitempty"`
+	Requesters int32 `protobuf:"varint,5,opt,name=requesters,proto3" json:"requesters,omitempty"`
+	// Time spent listening. Skipped songs count the time they played for.
+	PlayedSeconds int64             `protobuf:"varint,6,opt,name=played_seconds,json=playedSeconds,proto3" json:"played_seconds,omitempty"`
+	TopSongs      []*SongStats      `protobuf:"bytes,7,rep,name=top_songs,json=topSongs,proto3" json:"top_songs,omitempty"`
+	TopRequesters []*RequesterStats `protobuf:"bytes,8,rep,name=top_requesters,json=topRequesters,proto3" json:"top_requesters,omitempty"`
+}
+
+func (x *GetMusicStatsResponse) Reset() {
+	*x = GetMusicStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMusicStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMusicStatsResponse) ProtoMessage() {}
+
+func (x *GetMusicStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMusicStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMusicStatsResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMusicStatsResponse) GetPlayed() int32 {
+	if x != nil {
+		return x.Played
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetAutoplayed() int32 {
+	if x != nil {
+		return x.Autoplayed
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetSongs() int32 {
+	if x != nil {
+		return x.Songs
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetRequesters() int32 {
+	if x != nil {
+		return x.Requesters
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetPlayedSeconds() int64 {
+	if x != nil {
+		return x.PlayedSeconds
+	}
+	return 0
+}
+
+func (x *GetMusicStatsResponse) GetTopSongs() []*SongStats {
+	if x != nil {
+		return x.TopSongs
+	}
+	return nil
+}
+
+func (x *GetMusicStatsResponse) GetTopRequesters() []*RequesterStats {
+	if x != nil {
+		return x.TopRequesters
+	}
+	return nil
+}
+
+type SongRequestsUpdateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType   SongRequestsUpdateEvent_EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=thalassa.v1.SongRequestsUpdateEvent_EventType" json:"event_type,omitempty"`
+	SongRequest *SongRequest                      `protobuf:"bytes,2,opt,name=song_request,json=songRequest,proto3" json:"song_request,omitempty"`
+}
+
+func (x *SongRequestsUpdateEvent) Reset() {
+	*x = SongRequestsUpdateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongRequestsUpdateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongRequestsUpdateEvent) ProtoMessage() {}
+
+func (x *SongRequestsUpdateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongRequestsUpdateEvent.ProtoReflect.Descriptor instead.
+func (*SongRequestsUpdateEvent) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SongRequestsUpdateEvent) GetEventType() SongRequestsUpdateEvent_EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return SongRequestsUpdateEvent_SONG_REQUEST_ADDED
+}
+
+func (x *SongRequestsUpdateEvent) GetSongRequest() *SongRequest {
+	if x != nil {
+		return x.SongRequest
+	}
+	return nil
+}
+
+type SongRequestsUpdateStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GuildId string `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+}
+
+func (x *SongRequestsUpdateStreamRequest) Reset() {
+	*x = SongRequestsUpdateStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongRequestsUpdateStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongRequestsUpdateStreamRequest) ProtoMessage() {}
+
+func (x *SongRequestsUpdateStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongRequestsUpdateStreamRequest.ProtoReflect.Descriptor instead.
+func (*SongRequestsUpdateStreamRequest) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SongRequestsUpdateStreamRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+type SongRequestsUpdateStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *SongRequestsUpdateEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SongRequestsUpdateStreamResponse) Reset() {
+	*x = SongRequestsUpdateStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_thalassa_v1_thalassa_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongRequestsUpdateStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongRequestsUpdateStreamResponse) ProtoMessage() {}
+
+func (x *SongRequestsUpdateStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_thalassa_v1_thalassa_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongRequestsUpdateStreamResponse.ProtoReflect.Descriptor instead.
+func (*SongRequestsUpdateStreamResponse) Descriptor() ([]byte, []int) {
+	return file_thalassa_v1_thalassa_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SongRequestsUpdateStreamResponse) GetEvent() *SongRequestsUpdateEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+var File_thalassa_v1_thalassa_proto protoreflect.FileDescriptor
+
+var file_thalassa_v1_thalassa_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68,
+	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x68,
+	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x02, 0x0a, 0x04, 0x53,
+	0x6f, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb1, 0x03, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x6e, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x12, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x75, 0x69, 0x6c, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61,
+	0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e,
+	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2f, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x68, 0x61,
+	0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x55,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
+	0x22, 0xd7, 0x03, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x73, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61,
+	0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74,
+	0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x34, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
+	0x22, 0xa6, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x6f, 0x70, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61,
+	0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x08, 0x6c, 0x6f, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74,
+	0x6f, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x75, 0x74,
+	0x6f, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x72, 0x5f, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x61, 0x69, 0x72, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x0a,
+	0x64, 0x6a, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6a, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x64, 0x6a, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x6a, 0x4f, 0x6e, 0x6c, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x75, 0x73, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x75,
+	0x73, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x6a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x64,
+	0x6a, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x6a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x6a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb1, 0x02, 0x0a, 0x0d, 0x53, 0x61, 0x76, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x73, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04,
+	0x73, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61,
+	0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x68, 0x61, 0x6c,
+	0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x68, 0x61, 0x6c,
+	0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x7c, 0x0a, 0x1a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x61, 0x76,
+	0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x74, 0x0a, 0x1a, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x60, 0x0a, 0x1b, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73,
+	0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x0d, 0x73, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x60, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x80, 0x01, 0x0a,
+	0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x55, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x7e, 0x0a, 0x09, 0x53, 0x6f, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x6b, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6b,
+	0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x70, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d,
+	0x75, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x68,
+	0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbf, 0x02, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x6f, 0x6e,
+	0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61,
+	0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x08, 0x74, 0x6f, 0x70, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x6f,
+	0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x0d, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0xcf,
+	0x03, 0x0a, 0x17, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e,
+	0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x6f, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x4c, 0x41,
+	0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x53,
+	0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50,
+	0x50, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x4e,
+	0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x4f, 0x4e, 0x47, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x46, 0x46, 0x4c,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x08, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4f, 0x4e, 0x47, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x45, 0x4b, 0x45, 0x44, 0x10, 0x0a,
+	0x22, 0x3c, 0x0a, 0x1f, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x5e,
+	0x0a, 0x20, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x47,
+	0x0a, 0x08, 0x4c, 0x6f, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f,
+	0x4f, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x4f, 0x50, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x02, 0x2a, 0x84, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49,
+	0x4f, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
+	0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x04, 0x32, 0xd4,
+	0x09, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61,
+	0x79, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e,
+	0x67, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x24, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22,
+	0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x75, 0x73, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x74,
+	0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x24, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74,
+	0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x2e,
+	0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73,
+	0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x41, 0x64,
+	0x64, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x28, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74,
+	0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f,
+	0x6e, 0x67, 0x12, 0x2b, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21,
+	0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73,
+	0x61, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f,
+	0x2f, 0x74, 0x68, 0x61, 0x6c, 0x61, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x68, 0x61,
+	0x6c, 0x61, 0x73, 0x73, 0x61, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_thalassa_v1_thalassa_proto_rawDescOnce sync.Once
+	file_thalassa_v1_thalassa_proto_rawDescData = file_thalassa_v1_thalassa_proto_rawDesc
+)
+
+func file_thalassa_v1_thalassa_proto_rawDescGZIP() []byte {
+	file_thalassa_v1_thalassa_proto_rawDescOnce.Do(func() {
+		file_thalassa_v1_thalassa_proto_rawDescData = protoimpl.X.CompressGZIP(file_thalassa_v1_thalassa_proto_rawDescData)
+	})
+	return file_thalassa_v1_thalassa_proto_rawDescData
+}
+
+var file_thalassa_v1_thalassa_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_thalassa_v1_thalassa_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_thalassa_v1_thalassa_proto_goTypes = []interface{}{
+	(LoopMode)(0),                            // 0: thalassa.v1.LoopMode
+	(StatsPeriod)(0),                         // 1: thalassa.v1.StatsPeriod
+	(SongRequestsUpdateEvent_EventType)(0),   // 2: thalassa.v1.SongRequestsUpdateEvent.EventType
+	(*Song)(nil),                             // 3: thalassa.v1.Song
+	(*SongRequest)(nil),                      // 4: thalassa.v1.SongRequest
+	(*GetSongRequestsRequest)(nil),           // 5: thalassa.v1.GetSongRequestsRequest
+	(*GetSongRequestsResponse)(nil),          // 6: thalassa.v1.GetSongRequestsResponse
+	(*AddSongRequestRequest)(nil),            // 7: thalassa.v1.AddSongRequestRequest
+	(*AddSongRequestResponse)(nil),           // 8: thalassa.v1.AddSongRequestResponse
+	(*GetCurrentSongPlayingRequest)(nil),     // 9: thalassa.v1.GetCurrentSongPlayingRequest
+	(*GetCurrentSongPlayingResponse)(nil),    // 10: thalassa.v1.GetCurrentSongPlayingResponse
+	(*GetMusicSettingsRequest)(nil),          // 11: thalassa.v1.GetMusicSettingsRequest
+	(*GetMusicSettingsResponse)(nil),         // 12: thalassa.v1.GetMusicSettingsResponse
+	(*GetMusicAccessRequest)(nil),            // 13: thalassa.v1.GetMusicAccessRequest
+	(*GetMusicAccessResponse)(nil),           // 14: thalassa.v1.GetMusicAccessResponse
+	(*SavedPlaylist)(nil),                    // 15: thalassa.v1.SavedPlaylist
+	(*SavedPlaylistSong)(nil),                // 16: thalassa.v1.SavedPlaylistSong
+	(*GetSavedPlaylistsRequest)(nil),         // 17: thalassa.v1.GetSavedPlaylistsRequest
+	(*GetSavedPlaylistsResponse)(nil),        // 18: thalassa.v1.GetSavedPlaylistsResponse
+	(*GetSavedPlaylistRequest)(nil),          // 19: thalassa.v1.GetSavedPlaylistRequest
+	(*GetSavedPlaylistResponse)(nil),         // 20: thalassa.v1.GetSavedPlaylistResponse
+	(*CreateSavedPlaylistRequest)(nil),       // 21: thalassa.v1.CreateSavedPlaylistRequest
+	(*CreateSavedPlaylistResponse)(nil),      // 22: thalassa.v1.CreateSavedPlaylistResponse
+	(*RenameSavedPlaylistRequest)(nil),       // 23: thalassa.v1.RenameSavedPlaylistRequest
+	(*RenameSavedPlaylistResponse)(nil),      // 24: thalassa.v1.RenameSavedPlaylistResponse
+	(*DeleteSavedPlaylistRequest)(nil),       // 25: thalassa.v1.DeleteSavedPlaylistRequest
+	(*DeleteSavedPlaylistResponse)(nil),      // 26: thalassa.v1.DeleteSavedPlaylistResponse
+	(*AddSavedPlaylistSongRequest)(nil),      // 27: thalassa.v1.AddSavedPlaylistSongRequest
+	(*AddSavedPlaylistSongResponse)(nil),     // 28: thalassa.v1.AddSavedPlaylistSongResponse
+	(*RemoveSavedPlaylistSongRequest)(nil),   // 29: thalassa.v1.RemoveSavedPlaylistSongRequest
+	(*RemoveSavedPlaylistSongResponse)(nil),  // 30: thalassa.v1.RemoveSavedPlaylistSongResponse
+	(*SongStats)(nil),                        // 31: thalassa.v1.SongStats
+	(*RequesterStats)(nil),                   // 32: thalassa.v1.RequesterStats
+	(*GetMusicStatsRequest)(nil),             // 33: thalassa.v1.GetMusicStatsRequest
+	(*GetMusicStatsResponse)(nil),            // 34: thalassa.v1.GetMusicStatsResponse
+	(*SongRequestsUpdateEvent)(nil),          // 35: thalassa.v1.SongRequestsUpdateEvent
+	(*SongRequestsUpdateStreamRequest)(nil),  // 36: thalassa.v1.SongRequestsUpdateStreamRequest
+	(*SongRequestsUpdateStreamResponse)(nil), // 37: thalassa.v1.SongRequestsUpdateStreamResponse
+	(*timestamppb.Timestamp)(nil),            // 38: google.protobuf.Timestamp
+}
+var file_thalassa_v1_thalassa_proto_depIdxs = []int32{
+	38, // 0: thalassa.v1.SongRequest.requested_at:type_name -> google.protobuf.Timestamp
+	38, // 1: thalassa.v1.SongRequest.played_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: thalassa.v1.SongRequest.song:type_name -> thalassa.v1.Song
+	4,  // 3: thalassa.v1.GetSongRequestsResponse.song_requests:type_name -> thalassa.v1.SongRequest
+	4,  // 4: thalassa.v1.AddSongRequestResponse.song_request:type_name -> thalassa.v1.SongRequest
+	38, // 5: thalassa.v1.GetCurrentSongPlayingResponse.requested_at:type_name -> google.protobuf.Timestamp
+	38, // 6: thalassa.v1.GetCurrentSongPlayingResponse.started_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: thalassa.v1.GetCurrentSongPlayingResponse.song:type_name -> thalassa.v1.Song
+	4,  // 8: thalassa.v1.GetCurrentSongPlayingResponse.song_request:type_name -> thalassa.v1.SongRequest
+	0,  // 9: thalassa.v1.GetMusicSettingsResponse.loop_mode:type_name -> thalassa.v1.LoopMode
+	38, // 10: thalassa.v1.GetMusicAccessResponse.dj_expires_at:type_name -> google.protobuf.Timestamp
+	38, // 11: thalassa.v1.SavedPlaylist.created_at:type_name -> google.protobuf.Timestamp
+	38, // 12: thalassa.v1.SavedPlaylist.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 13: thalassa.v1.SavedPlaylistSong.song:type_name -> thalassa.v1.Song
+	38, // 14: thalassa.v1.SavedPlaylistSong.added_at:type_name -> google.protobuf.Timestamp
+	15, // 15: thalassa.v1.GetSavedPlaylistsResponse.saved_playlists:type_name -> thalassa.v1.SavedPlaylist
+	15, // 16: thalassa.v1.GetSavedPlaylistResponse.saved_playlist:type_name -> thalassa.v1.SavedPlaylist
+	16, // 17: thalassa.v1.GetSavedPlaylistResponse.songs:type_name -> thalassa.v1.SavedPlaylistSong
+	15, // 18: thalassa.v1.CreateSavedPlaylistResponse.saved_playlist:type_name -> thalassa.v1.SavedPlaylist
+	15, // 19: thalassa.v1.RenameSavedPlaylistResponse.saved_playlist:type_name -> thalassa.v1.SavedPlaylist
+	16, // 20: thalassa.v1.AddSavedPlaylistSongResponse.song:type_name -> thalassa.v1.SavedPlaylistSong
+	16, // 21: thalassa.v1.RemoveSavedPlaylistSongResponse.song:type_name -> thalassa.v1.SavedPlaylistSong
+	3,  // 22: thalassa.v1.SongStats.song:type_name -> thalassa.v1.Song
+	1,  // 23: thalassa.v1.GetMusicStatsRequest.period:type_name -> thalassa.v1.StatsPeriod
+	31, // 24: thalassa.v1.GetMusicStatsResponse.top_songs:type_name -> thalassa.v1.SongStats
+	32, // 25: thalassa.v1.GetMusicStatsResponse.top_requesters:type_name -> thalassa.v1.RequesterStats
+	2,  // 26: thalassa.v1.SongRequestsUpdateEvent.event_type:type_name -> thalassa.v1.SongRequestsUpdateEvent.EventType
+	4,  // 27: thalassa.v1.SongRequestsUpdateEvent.song_request:type_name -> thalassa.v1.SongRequest
+	35, // 28: thalassa.v1.SongRequestsUpdateStreamResponse.event:type_name -> thalassa.v1.SongRequestsUpdateEvent
+	5,  // 29: thalassa.v1.APIService.GetSongRequests:input_type -> thalassa.v1.GetSongRequestsRequest
+	9,  // 30: thalassa.v1.APIService.GetCurrentSongPlaying:input_type -> thalassa.v1.GetCurrentSongPlayingRequest
+	11, // 31: thalassa.v1.APIService.GetMusicSettings:input_type -> thalassa.v1.GetMusicSettingsRequest
+	13, // 32: thalassa.v1.APIService.GetMusicAccess:input_type -> thalassa.v1.GetMusicAccessRequest
+	17, // 33: thalassa.v1.APIService.GetSavedPlaylists:input_type -> thalassa.v1.GetSavedPlaylistsRequest
+	19, // 34: thalassa.v1.APIService.GetSavedPlaylist:input_type -> thalassa.v1.GetSavedPlaylistRequest
+	21, // 35: thalassa.v1.APIService.CreateSavedPlaylist:input_type -> thalassa.v1.CreateSavedPlaylistRequest
+	23, // 36: thalassa.v1.APIService.RenameSavedPlaylist:input_type -> thalassa.v1.RenameSavedPlaylistRequest
+	25, // 37: thalassa.v1.APIService.DeleteSavedPlaylist:input_type -> thalassa.v1.DeleteSavedPlaylistRequest
+	27, // 38: thalassa.v1.APIService.AddSavedPlaylistSong:input_type -> thalassa.v1.AddSavedPlaylistSongRequest
+	29, // 39: thalassa.v1.APIService.RemoveSavedPlaylistSong:input_type -> thalassa.v1.RemoveSavedPlaylistSongRequest
+	33, // 40: thalassa.v1.APIService.GetMusicStats:input_type -> thalassa.v1.GetMusicStatsRequest
+	6,  // 41: thalassa.v1.APIService.GetSongRequests:output_type -> thalassa.v1.GetSongRequestsResponse
+	10, // 42: thalassa.v1.APIService.GetCurrentSongPlaying:output_type -> thalassa.v1.GetCurrentSongPlayingResponse
+	12, // 43: thalassa.v1.APIService.GetMusicSettings:output_type -> thalassa.v1.GetMusicSettingsResponse
+	14, // 44: thalassa.v1.APIService.GetMusicAccess:output_type -> thalassa.v1.GetMusicAccessResponse
+	18, // 45: thalassa.v1.APIService.GetSavedPlaylists:output_type -> thalassa.v1.GetSavedPlaylistsResponse
+	20, // 46: thalassa.v1.APIService.GetSavedPlaylist:output_type -> thalassa.v1.GetSavedPlaylistResponse
+	22, // 47: thalassa.v1.APIService.CreateSavedPlaylist:output_type -> thalassa.v1.CreateSavedPlaylistResponse
+	24, // 48: thalassa.v1.APIService.RenameSavedPlaylist:output_type -> thalassa.v1.RenameSavedPlaylistResponse
+	26, // 49: thalassa.v1.APIService.DeleteSavedPlaylist:output_type -> thalassa.v1.DeleteSavedPlaylistResponse
+	28, // 50: thalassa.v1.APIService.AddSavedPlaylistSong:output_type -> thalassa.v1.AddSavedPlaylistSongResponse
+	30, // 51: thalassa.v1.APIService.RemoveSavedPlaylistSong:output_type -> thalassa.v1.RemoveSavedPlaylistSongResponse
+	34, // 52: thalassa.v1.APIService.GetMusicStats:output_type -> thalassa.v1.GetMusicStatsResponse
+	41, // [41:53] is the sub-list for method output_type
+	29, // [29:41] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_thalassa_v1_thalassa_proto_init() }
+func file_thalassa_v1_thalassa_proto_init() {
 	if File_thalassa_v1_thalassa_proto != nil {
 		return
 	}
@@ -1130,7 +3273,7 @@ func file_thalassa_v1_thalassa_proto_init() {
 			}
 		}
 		file_thalassa_v1_thalassa_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongRequestsUpdateEvent); i {
+			switch v := v.(*GetMusicSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1142,7 +3285,7 @@ func file_thalassa_v1_thalassa_proto_init() {
 			}
 		}
 		file_thalassa_v1_thalassa_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongRequestsUpdateStreamRequest); i {
+			switch v := v.(*GetMusicSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1154,6 +3297,294 @@ func file_thalassa_v1_thalassa_proto_init() {
 			}
 		}
 		file_thalassa_v1_thalassa_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMusicAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMusicAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedPlaylist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedPlaylistSong); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSavedPlaylistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSavedPlaylistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSavedPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSavedPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSavedPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSavedPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameSavedPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameSavedPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSavedPlaylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSavedPlaylistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSavedPlaylistSongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSavedPlaylistSongResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSavedPlaylistSongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSavedPlaylistSongResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SongStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequesterStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMusicStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMusicStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SongRequestsUpdateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SongRequestsUpdateStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_thalassa_v1_thalassa_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SongRequestsUpdateStreamResponse); i {
 			case 0:
 				return &v.state
@@ -1171,8 +3602,8 @@ func file_thalassa_v1_thalassa_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_thalassa_v1_thalassa_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
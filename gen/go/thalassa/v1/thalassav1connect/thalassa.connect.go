@@ -39,6 +39,36 @@ const (
 	// APIServiceGetCurrentSongPlayingProcedure is the fully-qualified name of the APIService's
 	// GetCurrentSongPlaying RPC.
 	APIServiceGetCurrentSongPlayingProcedure = "/thalassa.v1.APIService/GetCurrentSongPlaying"
+	// APIServiceGetMusicSettingsProcedure is the fully-qualified name of the APIService's
+	// GetMusicSettings RPC.
+	APIServiceGetMusicSettingsProcedure = "/thalassa.v1.APIService/GetMusicSettings"
+	// APIServiceGetMusicAccessProcedure is the fully-qualified name of the APIService's GetMusicAccess
+	// RPC.
+	APIServiceGetMusicAccessProcedure = "/thalassa.v1.APIService/GetMusicAccess"
+	// APIServiceGetSavedPlaylistsProcedure is the fully-qualified name of the APIService's
+	// GetSavedPlaylists RPC.
+	APIServiceGetSavedPlaylistsProcedure = "/thalassa.v1.APIService/GetSavedPlaylists"
+	// APIServiceGetSavedPlaylistProcedure is the fully-qualified name of the APIService's
+	// GetSavedPlaylist RPC.
+	APIServiceGetSavedPlaylistProcedure = "/thalassa.v1.APIService/GetSavedPlaylist"
+	// APIServiceCreateSavedPlaylistProcedure is the fully-qualified name of the APIService's
+	// CreateSavedPlaylist RPC.
+	APIServiceCreateSavedPlaylistProcedure = "/thalassa.v1.APIService/CreateSavedPlaylist"
+	// APIServiceRenameSavedPlaylistProcedure is the fully-qualified name of the APIService's
+	// RenameSavedPlaylist RPC.
+	APIServiceRenameSavedPlaylistProcedure = "/thalassa.v1.APIService/RenameSavedPlaylist"
+	// APIServiceDeleteSavedPlaylistProcedure is the fully-qualified name of the APIService's
+	// DeleteSavedPlaylist RPC.
+	APIServiceDeleteSavedPlaylistProcedure = "/thalassa.v1.APIService/DeleteSavedPlaylist"
+	// APIServiceAddSavedPlaylistSongProcedure is the fully-qualified name of the APIService's
+	// AddSavedPlaylistSong RPC.
+	APIServiceAddSavedPlaylistSongProcedure = "/thalassa.v1.APIService/AddSavedPlaylistSong"
+	// APIServiceRemoveSavedPlaylistSongProcedure is the fully-qualified name of the APIService's
+	// RemoveSavedPlaylistSong RPC.
+	APIServiceRemoveSavedPlaylistSongProcedure = "/thalassa.v1.APIService/RemoveSavedPlaylistSong"
+	// APIServiceGetMusicStatsProcedure is the fully-qualified name of the APIService's GetMusicStats
+	// RPC.
+	APIServiceGetMusicStatsProcedure = "/thalassa.v1.APIService/GetMusicStats"
 )
 
 // APIServiceClient is a client for the thalassa.v1.APIService service.
@@ -46,6 +76,16 @@ type APIServiceClient interface {
 	GetSongRequests(context.Context, *connect_go.Request[v1.GetSongRequestsRequest]) (*connect_go.Response[v1.GetSongRequestsResponse], error)
 	// rpc AddSongRequest(AddSongRequestRequest) returns (AddSongRequestResponse);
 	GetCurrentSongPlaying(context.Context, *connect_go.Request[v1.GetCurrentSongPlayingRequest]) (*connect_go.Response[v1.GetCurrentSongPlayingResponse], error)
+	GetMusicSettings(context.Context, *connect_go.Request[v1.GetMusicSettingsRequest]) (*connect_go.Response[v1.GetMusicSettingsResponse], error)
+	GetMusicAccess(context.Context, *connect_go.Request[v1.GetMusicAccessRequest]) (*connect_go.Response[v1.GetMusicAccessResponse], error)
+	GetSavedPlaylists(context.Context, *connect_go.Request[v1.GetSavedPlaylistsRequest]) (*connect_go.Response[v1.GetSavedPlaylistsResponse], error)
+	GetSavedPlaylist(context.Context, *connect_go.Request[v1.GetSavedPlaylistRequest]) (*connect_go.Response[v1.GetSavedPlaylistResponse], error)
+	CreateSavedPlaylist(context.Context, *connect_go.Request[v1.CreateSavedPlaylistRequest]) (*connect_go.Response[v1.CreateSavedPlaylistResponse], error)
+	RenameSavedPlaylist(context.Context, *connect_go.Request[v1.RenameSavedPlaylistRequest]) (*connect_go.Response[v1.RenameSavedPlaylistResponse], error)
+	DeleteSavedPlaylist(context.Context, *connect_go.Request[v1.DeleteSavedPlaylistRequest]) (*connect_go.Response[v1.DeleteSavedPlaylistResponse], error)
+	AddSavedPlaylistSong(context.Context, *connect_go.Request[v1.AddSavedPlaylistSongRequest]) (*connect_go.Response[v1.AddSavedPlaylistSongResponse], error)
+	RemoveSavedPlaylistSong(context.Context, *connect_go.Request[v1.RemoveSavedPlaylistSongRequest]) (*connect_go.Response[v1.RemoveSavedPlaylistSongResponse], error)
+	GetMusicStats(context.Context, *connect_go.Request[v1.GetMusicStatsRequest]) (*connect_go.Response[v1.GetMusicStatsResponse], error)
 }
 
 // NewAPIServiceClient constructs a client for the thalassa.v1.APIService service. By default, it
@@ -68,13 +108,73 @@ func NewAPIServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+APIServiceGetCurrentSongPlayingProcedure,
 			opts...,
 		),
+		getMusicSettings: connect_go.NewClient[v1.GetMusicSettingsRequest, v1.GetMusicSettingsResponse](
+			httpClient,
+			baseURL+APIServiceGetMusicSettingsProcedure,
+			opts...,
+		),
+		getMusicAccess: connect_go.NewClient[v1.GetMusicAccessRequest, v1.GetMusicAccessResponse](
+			httpClient,
+			baseURL+APIServiceGetMusicAccessProcedure,
+			opts...,
+		),
+		getSavedPlaylists: connect_go.NewClient[v1.GetSavedPlaylistsRequest, v1.GetSavedPlaylistsResponse](
+			httpClient,
+			baseURL+APIServiceGetSavedPlaylistsProcedure,
+			opts...,
+		),
+		getSavedPlaylist: connect_go.NewClient[v1.GetSavedPlaylistRequest, v1.GetSavedPlaylistResponse](
+			httpClient,
+			baseURL+APIServiceGetSavedPlaylistProcedure,
+			opts...,
+		),
+		createSavedPlaylist: connect_go.NewClient[v1.CreateSavedPlaylistRequest, v1.CreateSavedPlaylistResponse](
+			httpClient,
+			baseURL+APIServiceCreateSavedPlaylistProcedure,
+			opts...,
+		),
+		renameSavedPlaylist: connect_go.NewClient[v1.RenameSavedPlaylistRequest, v1.RenameSavedPlaylistResponse](
+			httpClient,
+			baseURL+APIServiceRenameSavedPlaylistProcedure,
+			opts...,
+		),
+		deleteSavedPlaylist: connect_go.NewClient[v1.DeleteSavedPlaylistRequest, v1.DeleteSavedPlaylistResponse](
+			httpClient,
+			baseURL+APIServiceDeleteSavedPlaylistProcedure,
+			opts...,
+		),
+		addSavedPlaylistSong: connect_go.NewClient[v1.AddSavedPlaylistSongRequest, v1.AddSavedPlaylistSongResponse](
+			httpClient,
+			baseURL+APIServiceAddSavedPlaylistSongProcedure,
+			opts...,
+		),
+		removeSavedPlaylistSong: connect_go.NewClient[v1.RemoveSavedPlaylistSongRequest, v1.RemoveSavedPlaylistSongResponse](
+			httpClient,
+			baseURL+APIServiceRemoveSavedPlaylistSongProcedure,
+			opts...,
+		),
+		getMusicStats: connect_go.NewClient[v1.GetMusicStatsRequest, v1.GetMusicStatsResponse](
+			httpClient,
+			baseURL+APIServiceGetMusicStatsProcedure,
+			opts...,
+		),
 	}
 }
 
 // aPIServiceClient implements APIServiceClient.
 type aPIServiceClient struct {
-	getSongRequests       *connect_go.Client[v1.GetSongRequestsRequest, v1.GetSongRequestsResponse]
-	getCurrentSongPlaying *connect_go.Client[v1.GetCurrentSongPlayingRequest, v1.GetCurrentSongPlayingResponse]
+	getSongRequests         *connect_go.Client[v1.GetSongRequestsRequest, v1.GetSongRequestsResponse]
+	getCurrentSongPlaying   *connect_go.Client[v1.GetCurrentSongPlayingRequest, v1.GetCurrentSongPlayingResponse]
+	getMusicSettings        *connect_go.Client[v1.GetMusicSettingsRequest, v1.GetMusicSettingsResponse]
+	getMusicAccess          *connect_go.Client[v1.GetMusicAccessRequest, v1.GetMusicAccessResponse]
+	getSavedPlaylists       *connect_go.Client[v1.GetSavedPlaylistsRequest, v1.GetSavedPlaylistsResponse]
+	getSavedPlaylist        *connect_go.Client[v1.GetSavedPlaylistRequest, v1.GetSavedPlaylistResponse]
+	createSavedPlaylist     *connect_go.Client[v1.CreateSavedPlaylistRequest, v1.CreateSavedPlaylistResponse]
+	renameSavedPlaylist     *connect_go.Client[v1.RenameSavedPlaylistRequest, v1.RenameSavedPlaylistResponse]
+	deleteSavedPlaylist     *connect_go.Client[v1.DeleteSavedPlaylistRequest, v1.DeleteSavedPlaylistResponse]
+	addSavedPlaylistSong    *connect_go.Client[v1.AddSavedPlaylistSongRequest, v1.AddSavedPlaylistSongResponse]
+	removeSavedPlaylistSong *connect_go.Client[v1.RemoveSavedPlaylistSongRequest, v1.RemoveSavedPlaylistSongResponse]
+	getMusicStats           *connect_go.Client[v1.GetMusicStatsRequest, v1.GetMusicStatsResponse]
 }
 
 // GetSongRequests calls thalassa.v1.APIService.GetSongRequests.
@@ -87,11 +187,71 @@ func (c *aPIServiceClient) GetCurrentSongPlaying(ctx context.Context, req *conne
 	return c.getCurrentSongPlaying.CallUnary(ctx, req)
 }
 
+// GetMusicSettings calls thalassa.v1.APIService.GetMusicSettings.
+func (c *aPIServiceClient) GetMusicSettings(ctx context.Context, req *connect_go.Request[v1.GetMusicSettingsRequest]) (*connect_go.Response[v1.GetMusicSettingsResponse], error) {
+	return c.getMusicSettings.CallUnary(ctx, req)
+}
+
+// GetMusicAccess calls thalassa.v1.APIService.GetMusicAccess.
+func (c *aPIServiceClient) GetMusicAccess(ctx context.Context, req *connect_go.Request[v1.GetMusicAccessRequest]) (*connect_go.Response[v1.GetMusicAccessResponse], error) {
+	return c.getMusicAccess.CallUnary(ctx, req)
+}
+
+// GetSavedPlaylists calls thalassa.v1.APIService.GetSavedPlaylists.
+func (c *aPIServiceClient) GetSavedPlaylists(ctx context.Context, req *connect_go.Request[v1.GetSavedPlaylistsRequest]) (*connect_go.Response[v1.GetSavedPlaylistsResponse], error) {
+	return c.getSavedPlaylists.CallUnary(ctx, req)
+}
+
+// GetSavedPlaylist calls thalassa.v1.APIService.GetSavedPlaylist.
+func (c *aPIServiceClient) GetSavedPlaylist(ctx context.Context, req *connect_go.Request[v1.GetSavedPlaylistRequest]) (*connect_go.Response[v1.GetSavedPlaylistResponse], error) {
+	return c.getSavedPlaylist.CallUnary(ctx, req)
+}
+
+// CreateSavedPlaylist calls thalassa.v1.APIService.CreateSavedPlaylist.
+func (c *aPIServiceClient) CreateSavedPlaylist(ctx context.Context, req *connect_go.Request[v1.CreateSavedPlaylistRequest]) (*connect_go.Response[v1.CreateSavedPlaylistResponse], error) {
+	return c.createSavedPlaylist.CallUnary(ctx, req)
+}
+
+// RenameSavedPlaylist calls thalassa.v1.APIService.RenameSavedPlaylist.
+func (c *aPIServiceClient) RenameSavedPlaylist(ctx context.Context, req *connect_go.Request[v1.RenameSavedPlaylistRequest]) (*connect_go.Response[v1.RenameSavedPlaylistResponse], error) {
+	return c.renameSavedPlaylist.CallUnary(ctx, req)
+}
+
+// DeleteSavedPlaylist calls thalassa.v1.APIService.DeleteSavedPlaylist.
+func (c *aPIServiceClient) DeleteSavedPlaylist(ctx context.Context, req *connect_go.Request[v1.DeleteSavedPlaylistRequest]) (*connect_go.Response[v1.DeleteSavedPlaylistResponse], error) {
+	return c.deleteSavedPlaylist.CallUnary(ctx, req)
+}
+
+// AddSavedPlaylistSong calls thalassa.v1.APIService.AddSavedPlaylistSong.
+func (c *aPIServiceClient) AddSavedPlaylistSong(ctx context.Context, req *connect_go.Request[v1.AddSavedPlaylistSongRequest]) (*connect_go.Response[v1.AddSavedPlaylistSongResponse], error) {
+	return c.addSavedPlaylistSong.CallUnary(ctx, req)
+}
+
+// RemoveSavedPlaylistSong calls thalassa.v1.APIService.RemoveSavedPlaylistSong.
+func (c *aPIServiceClient) RemoveSavedPlaylistSong(ctx context.Context, req *connect_go.Request[v1.RemoveSavedPlaylistSongRequest]) (*connect_go.Response[v1.RemoveSavedPlaylistSongResponse], error) {
+	return c.removeSavedPlaylistSong.CallUnary(ctx, req)
+}
+
+// GetMusicStats calls thalassa.v1.APIService.GetMusicStats.
+func (c *aPIServiceClient) GetMusicStats(ctx context.Context, req *connect_go.Request[v1.GetMusicStatsRequest]) (*connect_go.Response[v1.GetMusicStatsResponse], error) {
+	return c.getMusicStats.CallUnary(ctx, req)
+}
+
 // APIServiceHandler is an implementation of the thalassa.v1.APIService service.
 type APIServiceHandler interface {
 	GetSongRequests(context.Context, *connect_go.Request[v1.GetSongRequestsRequest]) (*connect_go.Response[v1.GetSongRequestsResponse], error)
 	// rpc AddSongRequest(AddSongRequestRequest) returns (AddSongRequestResponse);
 	GetCurrentSongPlaying(context.Context, *connect_go.Request[v1.GetCurrentSongPlayingRequest]) (*connect_go.Response[v1.GetCurrentSongPlayingResponse], error)
+	GetMusicSettings(context.Context, *connect_go.Request[v1.GetMusicSettingsRequest]) (*connect_go.Response[v1.GetMusicSettingsResponse], error)
+	GetMusicAccess(context.Context, *connect_go.Request[v1.GetMusicAccessRequest]) (*connect_go.Response[v1.GetMusicAccessResponse], error)
+	GetSavedPlaylists(context.Context, *connect_go.Request[v1.GetSavedPlaylistsRequest]) (*connect_go.Response[v1.GetSavedPlaylistsResponse], error)
+	GetSavedPlaylist(context.Context, *connect_go.Request[v1.GetSavedPlaylistRequest]) (*connect_go.Response[v1.GetSavedPlaylistResponse], error)
+	CreateSavedPlaylist(context.Context, *connect_go.Request[v1.CreateSavedPlaylistRequest]) (*connect_go.Response[v1.CreateSavedPlaylistResponse], error)
+	RenameSavedPlaylist(context.Context, *connect_go.Request[v1.RenameSavedPlaylistRequest]) (*connect_go.Response[v1.RenameSavedPlaylistResponse], error)
+	DeleteSavedPlaylist(context.Context, *connect_go.Request[v1.DeleteSavedPlaylistRequest]) (*connect_go.Response[v1.DeleteSavedPlaylistResponse], error)
+	AddSavedPlaylistSong(context.Context, *connect_go.Request[v1.AddSavedPlaylistSongRequest]) (*connect_go.Response[v1.AddSavedPlaylistSongResponse], error)
+	RemoveSavedPlaylistSong(context.Context, *connect_go.Request[v1.RemoveSavedPlaylistSongRequest]) (*connect_go.Response[v1.RemoveSavedPlaylistSongResponse], error)
+	GetMusicStats(context.Context, *connect_go.Request[v1.GetMusicStatsRequest]) (*connect_go.Response[v1.GetMusicStatsResponse], error)
 }
 
 // NewAPIServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -111,6 +271,56 @@ func NewAPIServiceHandler(svc APIServiceHandler, opts ...connect_go.HandlerOptio
 		svc.GetCurrentSongPlaying,
 		opts...,
 	))
+	mux.Handle(APIServiceGetMusicSettingsProcedure, connect_go.NewUnaryHandler(
+		APIServiceGetMusicSettingsProcedure,
+		svc.GetMusicSettings,
+		opts...,
+	))
+	mux.Handle(APIServiceGetMusicAccessProcedure, connect_go.NewUnaryHandler(
+		APIServiceGetMusicAccessProcedure,
+		svc.GetMusicAccess,
+		opts...,
+	))
+	mux.Handle(APIServiceGetSavedPlaylistsProcedure, connect_go.NewUnaryHandler(
+		APIServiceGetSavedPlaylistsProcedure,
+		svc.GetSavedPlaylists,
+		opts...,
+	))
+	mux.Handle(APIServiceGetSavedPlaylistProcedure, connect_go.NewUnaryHandler(
+		APIServiceGetSavedPlaylistProcedure,
+		svc.GetSavedPlaylist,
+		opts...,
+	))
+	mux.Handle(APIServiceCreateSavedPlaylistProcedure, connect_go.NewUnaryHandler(
+		APIServiceCreateSavedPlaylistProcedure,
+		svc.CreateSavedPlaylist,
+		opts...,
+	))
+	mux.Handle(APIServiceRenameSavedPlaylistProcedure, connect_go.NewUnaryHandler(
+		APIServiceRenameSavedPlaylistProcedure,
+		svc.RenameSavedPlaylist,
+		opts...,
+	))
+	mux.Handle(APIServiceDeleteSavedPlaylistProcedure, connect_go.NewUnaryHandler(
+		APIServiceDeleteSavedPlaylistProcedure,
+		svc.DeleteSavedPlaylist,
+		opts...,
+	))
+	mux.Handle(APIServiceAddSavedPlaylistSongProcedure, connect_go.NewUnaryHandler(
+		APIServiceAddSavedPlaylistSongProcedure,
+		svc.AddSavedPlaylistSong,
+		opts...,
+	))
+	mux.Handle(APIServiceRemoveSavedPlaylistSongProcedure, connect_go.NewUnaryHandler(
+		APIServiceRemoveSavedPlaylistSongProcedure,
+		svc.RemoveSavedPlaylistSong,
+		opts...,
+	))
+	mux.Handle(APIServiceGetMusicStatsProcedure, connect_go.NewUnaryHandler(
+		APIServiceGetMusicStatsProcedure,
+		svc.GetMusicStats,
+		opts...,
+	))
 	return "/thalassa.v1.APIService/", mux
 }
 
@@ -124,3 +334,43 @@ func (UnimplementedAPIServiceHandler) GetSongRequests(context.Context, *connect_
 func (UnimplementedAPIServiceHandler) GetCurrentSongPlaying(context.Context, *connect_go.Request[v1.GetCurrentSongPlayingRequest]) (*connect_go.Response[v1.GetCurrentSongPlayingResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetCurrentSongPlaying is not implemented"))
 }
+
+func (UnimplementedAPIServiceHandler) GetMusicSettings(context.Context, *connect_go.Request[v1.GetMusicSettingsRequest]) (*connect_go.Response[v1.GetMusicSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetMusicSettings is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetMusicAccess(context.Context, *connect_go.Request[v1.GetMusicAccessRequest]) (*connect_go.Response[v1.GetMusicAccessResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetMusicAccess is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetSavedPlaylists(context.Context, *connect_go.Request[v1.GetSavedPlaylistsRequest]) (*connect_go.Response[v1.GetSavedPlaylistsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetSavedPlaylists is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetSavedPlaylist(context.Context, *connect_go.Request[v1.GetSavedPlaylistRequest]) (*connect_go.Response[v1.GetSavedPlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetSavedPlaylist is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) CreateSavedPlaylist(context.Context, *connect_go.Request[v1.CreateSavedPlaylistRequest]) (*connect_go.Response[v1.CreateSavedPlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.CreateSavedPlaylist is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) RenameSavedPlaylist(context.Context, *connect_go.Request[v1.RenameSavedPlaylistRequest]) (*connect_go.Response[v1.RenameSavedPlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.RenameSavedPlaylist is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) DeleteSavedPlaylist(context.Context, *connect_go.Request[v1.DeleteSavedPlaylistRequest]) (*connect_go.Response[v1.DeleteSavedPlaylistResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.DeleteSavedPlaylist is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) AddSavedPlaylistSong(context.Context, *connect_go.Request[v1.AddSavedPlaylistSongRequest]) (*connect_go.Response[v1.AddSavedPlaylistSongResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.AddSavedPlaylistSong is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) RemoveSavedPlaylistSong(context.Context, *connect_go.Request[v1.RemoveSavedPlaylistSongRequest]) (*connect_go.Response[v1.RemoveSavedPlaylistSongResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.RemoveSavedPlaylistSong is not implemented"))
+}
+
+func (UnimplementedAPIServiceHandler) GetMusicStats(context.Context, *connect_go.Request[v1.GetMusicStatsRequest]) (*connect_go.Response[v1.GetMusicStatsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("thalassa.v1.APIService.GetMusicStats is not implemented"))
+}
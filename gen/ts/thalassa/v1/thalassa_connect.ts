@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetCurrentSongPlayingRequest, GetCurrentSongPlayingResponse, GetSongRequestsRequest, GetSongRequestsResponse } from "./thalassa_pb.js";
+import { AddSavedPlaylistSongRequest, AddSavedPlaylistSongResponse, CreateSavedPlaylistRequest, CreateSavedPlaylistResponse, DeleteSavedPlaylistRequest, DeleteSavedPlaylistResponse, GetCurrentSongPlayingRequest, GetCurrentSongPlayingResponse, GetMusicAccessRequest, GetMusicAccessResponse, GetMusicSettingsRequest, GetMusicSettingsResponse, GetMusicStatsRequest, GetMusicStatsResponse, GetSavedPlaylistRequest, GetSavedPlaylistResponse, GetSavedPlaylistsRequest, GetSavedPlaylistsResponse, GetSongRequestsRequest, GetSongRequestsResponse, RemoveSavedPlaylistSongRequest, RemoveSavedPlaylistSongResponse, RenameSavedPlaylistRequest, RenameSavedPlaylistResponse } from "./thalassa_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -34,6 +34,96 @@ export const APIService = {
       O: GetCurrentSongPlayingResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc thalassa.v1.APIService.GetMusicSettings
+     */
+    getMusicSettings: {
+      name: "GetMusicSettings",
+      I: GetMusicSettingsRequest,
+      O: GetMusicSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.GetMusicAccess
+     */
+    getMusicAccess: {
+      name: "GetMusicAccess",
+      I: GetMusicAccessRequest,
+      O: GetMusicAccessResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.GetSavedPlaylists
+     */
+    getSavedPlaylists: {
+      name: "GetSavedPlaylists",
+      I: GetSavedPlaylistsRequest,
+      O: GetSavedPlaylistsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.GetSavedPlaylist
+     */
+    getSavedPlaylist: {
+      name: "GetSavedPlaylist",
+      I: GetSavedPlaylistRequest,
+      O: GetSavedPlaylistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.CreateSavedPlaylist
+     */
+    createSavedPlaylist: {
+      name: "CreateSavedPlaylist",
+      I: CreateSavedPlaylistRequest,
+      O: CreateSavedPlaylistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.RenameSavedPlaylist
+     */
+    renameSavedPlaylist: {
+      name: "RenameSavedPlaylist",
+      I: RenameSavedPlaylistRequest,
+      O: RenameSavedPlaylistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.DeleteSavedPlaylist
+     */
+    deleteSavedPlaylist: {
+      name: "DeleteSavedPlaylist",
+      I: DeleteSavedPlaylistRequest,
+      O: DeleteSavedPlaylistResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.AddSavedPlaylistSong
+     */
+    addSavedPlaylistSong: {
+      name: "AddSavedPlaylistSong",
+      I: AddSavedPlaylistSongRequest,
+      O: AddSavedPlaylistSongResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.RemoveSavedPlaylistSong
+     */
+    removeSavedPlaylistSong: {
+      name: "RemoveSavedPlaylistSong",
+      I: RemoveSavedPlaylistSongRequest,
+      O: RemoveSavedPlaylistSongResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc thalassa.v1.APIService.GetMusicStats
+     */
+    getMusicStats: {
+      name: "GetMusicStats",
+      I: GetMusicStatsRequest,
+      O: GetMusicStatsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
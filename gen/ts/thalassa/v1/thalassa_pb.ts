@@ -6,6 +6,70 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum thalassa.v1.LoopMode
+ */
+export enum LoopMode {
+  /**
+   * @generated from enum value: LOOP_MODE_OFF = 0;
+   */
+  LOOP_MODE_OFF = 0,
+
+  /**
+   * @generated from enum value: LOOP_MODE_TRACK = 1;
+   */
+  LOOP_MODE_TRACK = 1,
+
+  /**
+   * @generated from enum value: LOOP_MODE_QUEUE = 2;
+   */
+  LOOP_MODE_QUEUE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(LoopMode)
+proto3.util.setEnumType(LoopMode, "thalassa.v1.LoopMode", [
+  { no: 0, name: "LOOP_MODE_OFF" },
+  { no: 1, name: "LOOP_MODE_TRACK" },
+  { no: 2, name: "LOOP_MODE_QUEUE" },
+]);
+
+/**
+ * @generated from enum thalassa.v1.StatsPeriod
+ */
+export enum StatsPeriod {
+  /**
+   * @generated from enum value: STATS_PERIOD_ALL_TIME = 0;
+   */
+  STATS_PERIOD_ALL_TIME = 0,
+
+  /**
+   * @generated from enum value: STATS_PERIOD_DAY = 1;
+   */
+  STATS_PERIOD_DAY = 1,
+
+  /**
+   * @generated from enum value: STATS_PERIOD_WEEK = 2;
+   */
+  STATS_PERIOD_WEEK = 2,
+
+  /**
+   * @generated from enum value: STATS_PERIOD_MONTH = 3;
+   */
+  STATS_PERIOD_MONTH = 3,
+
+  /**
+   * @generated from enum value: STATS_PERIOD_YEAR = 4;
+   */
+  STATS_PERIOD_YEAR = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(StatsPeriod)
+proto3.util.setEnumType(StatsPeriod, "thalassa.v1.StatsPeriod", [
+  { no: 0, name: "STATS_PERIOD_ALL_TIME" },
+  { no: 1, name: "STATS_PERIOD_DAY" },
+  { no: 2, name: "STATS_PERIOD_WEEK" },
+  { no: 3, name: "STATS_PERIOD_MONTH" },
+  { no: 4, name: "STATS_PERIOD_YEAR" },
+]);
+
 /**
  * @generated from message thalassa.v1.Song
  */
@@ -21,6 +85,8 @@ export class Song extends Message<Song> {
   url = "";
 
   /**
+   * Live streams have no duration.
+   *
    * @generated from field: bool is_stream = 3;
    */
   isStream = false;
@@ -152,6 +218,16 @@ export class SongRequest extends Message<SongRequest> {
    */
   requestedByUserId = "";
 
+  /**
+   * @generated from field: int64 position = 10;
+   */
+  position = protoInt64.zero;
+
+  /**
+   * @generated from field: bool autoplay = 11;
+   */
+  autoplay = false;
+
   constructor(data?: PartialMessage<SongRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -169,6 +245,8 @@ export class SongRequest extends Message<SongRequest> {
     { no: 7, name: "song", kind: "message", T: Song },
     { no: 8, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 9, name: "requested_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "position", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "autoplay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongRequest {
@@ -438,6 +516,35 @@ export class GetCurrentSongPlayingResponse extends Message<GetCurrentSongPlaying
    */
   songRequest?: SongRequest;
 
+  /**
+   * @generated from field: bool paused = 6;
+   */
+  paused = false;
+
+  /**
+   * @generated from field: int64 paused_duration_ms = 7;
+   */
+  pausedDurationMs = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 position_ms = 8;
+   */
+  positionMs = protoInt64.zero;
+
+  /**
+   * Time until the song ends, or until a live stream is stopped by the guild's max stream time.
+   *
+   * @generated from field: int64 remaining_ms = 9;
+   */
+  remainingMs = protoInt64.zero;
+
+  /**
+   * Set when the remaining time isn't known, like for a live stream that plays until it's skipped.
+   *
+   * @generated from field: bool remaining_unknown = 10;
+   */
+  remainingUnknown = false;
+
   constructor(data?: PartialMessage<GetCurrentSongPlayingResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -451,6 +558,11 @@ export class GetCurrentSongPlayingResponse extends Message<GetCurrentSongPlaying
     { no: 3, name: "song", kind: "message", T: Song },
     { no: 4, name: "requested_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "song_request", kind: "message", T: SongRequest },
+    { no: 6, name: "paused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "paused_duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "position_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "remaining_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "remaining_unknown", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentSongPlayingResponse {
@@ -471,76 +583,1368 @@ export class GetCurrentSongPlayingResponse extends Message<GetCurrentSongPlaying
 }
 
 /**
- * @generated from message thalassa.v1.SongRequestsUpdateEvent
+ * @generated from message thalassa.v1.GetMusicSettingsRequest
  */
-export class SongRequestsUpdateEvent extends Message<SongRequestsUpdateEvent> {
+export class GetMusicSettingsRequest extends Message<GetMusicSettingsRequest> {
   /**
-   * @generated from field: thalassa.v1.SongRequestsUpdateEvent.EventType event_type = 1;
+   * @generated from field: string guild_id = 1;
    */
-  eventType = SongRequestsUpdateEvent_EventType.SONG_REQUEST_ADDED;
+  guildId = "";
+
+  constructor(data?: PartialMessage<GetMusicSettingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetMusicSettingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicSettingsRequest {
+    return new GetMusicSettingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicSettingsRequest {
+    return new GetMusicSettingsRequest().fromJson(jsonValue, options);
+  }
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicSettingsRequest {
+    return new GetMusicSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMusicSettingsRequest | PlainMessage<GetMusicSettingsRequest> | undefined, b: GetMusicSettingsRequest | PlainMessage<GetMusicSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(GetMusicSettingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetMusicSettingsResponse
+ */
+export class GetMusicSettingsResponse extends Message<GetMusicSettingsResponse> {
   /**
-   * @generated from field: thalassa.v1.SongRequest song_request = 2;
+   * Volume as a multiplier of the original volume, where 1 is 100%.
+   *
+   * @generated from field: float volume = 1;
    */
-  songRequest?: SongRequest;
+  volume = 0;
 
-  constructor(data?: PartialMessage<SongRequestsUpdateEvent>) {
+  /**
+   * @generated from field: thalassa.v1.LoopMode loop_mode = 2;
+   */
+  loopMode = LoopMode.LOOP_MODE_OFF;
+
+  /**
+   * @generated from field: bool autoplay = 3;
+   */
+  autoplay = false;
+
+  /**
+   * @generated from field: bool fair_queue = 4;
+   */
+  fairQueue = false;
+
+  /**
+   * Audio filter preset songs are played with, like nightcore or eq:3,0,0,-2,4. Empty when there's none.
+   *
+   * @generated from field: string filter = 5;
+   */
+  filter = "";
+
+  /**
+   * Role whose members are DJs. Empty when there's none.
+   *
+   * @generated from field: string dj_role_id = 6;
+   */
+  djRoleId = "";
+
+  /**
+   * Set when only DJs can add songs.
+   *
+   * @generated from field: bool queue_locked = 7;
+   */
+  queueLocked = false;
+
+  /**
+   * Only DJs can add songs while more people than this are listening. 0 when there's no limit.
+   *
+   * @generated from field: int32 dj_only_listeners = 8;
+   */
+  djOnlyListeners = 0;
+
+  constructor(data?: PartialMessage<GetMusicSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "thalassa.v1.SongRequestsUpdateEvent";
+  static readonly typeName = "thalassa.v1.GetMusicSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "event_type", kind: "enum", T: proto3.getEnumType(SongRequestsUpdateEvent_EventType) },
-    { no: 2, name: "song_request", kind: "message", T: SongRequest },
+    { no: 1, name: "volume", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 2, name: "loop_mode", kind: "enum", T: proto3.getEnumType(LoopMode) },
+    { no: 3, name: "autoplay", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "fair_queue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "dj_role_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "queue_locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "dj_only_listeners", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongRequestsUpdateEvent {
-    return new SongRequestsUpdateEvent().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicSettingsResponse {
+    return new GetMusicSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongRequestsUpdateEvent {
-    return new SongRequestsUpdateEvent().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicSettingsResponse {
+    return new GetMusicSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongRequestsUpdateEvent {
-    return new SongRequestsUpdateEvent().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicSettingsResponse {
+    return new GetMusicSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SongRequestsUpdateEvent | PlainMessage<SongRequestsUpdateEvent> | undefined, b: SongRequestsUpdateEvent | PlainMessage<SongRequestsUpdateEvent> | undefined): boolean {
-    return proto3.util.equals(SongRequestsUpdateEvent, a, b);
+  static equals(a: GetMusicSettingsResponse | PlainMessage<GetMusicSettingsResponse> | undefined, b: GetMusicSettingsResponse | PlainMessage<GetMusicSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(GetMusicSettingsResponse, a, b);
   }
 }
 
 /**
- * @generated from enum thalassa.v1.SongRequestsUpdateEvent.EventType
+ * @generated from message thalassa.v1.GetMusicAccessRequest
  */
-export enum SongRequestsUpdateEvent_EventType {
+export class GetMusicAccessRequest extends Message<GetMusicAccessRequest> {
   /**
-   * @generated from enum value: SONG_REQUEST_ADDED = 0;
+   * @generated from field: string guild_id = 1;
    */
-  SONG_REQUEST_ADDED = 0,
+  guildId = "";
 
   /**
-   * @generated from enum value: SONG_REQUEST_PLAYING = 1;
+   * @generated from field: string user_id = 2;
    */
-  SONG_REQUEST_PLAYING = 1,
+  userId = "";
+
+  constructor(data?: PartialMessage<GetMusicAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetMusicAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicAccessRequest {
+    return new GetMusicAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicAccessRequest {
+    return new GetMusicAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicAccessRequest {
+    return new GetMusicAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMusicAccessRequest | PlainMessage<GetMusicAccessRequest> | undefined, b: GetMusicAccessRequest | PlainMessage<GetMusicAccessRequest> | undefined): boolean {
+    return proto3.util.equals(GetMusicAccessRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message thalassa.v1.GetMusicAccessResponse
+ */
+export class GetMusicAccessResponse extends Message<GetMusicAccessResponse> {
   /**
-   * @generated from enum value: SONG_REQUEST_FINISHED = 2;
+   * Set when the user has full control over the queue.
+   *
+   * @generated from field: bool dj = 1;
    */
-  SONG_REQUEST_FINISHED = 2,
+  dj = false;
 
   /**
-   * @generated from enum value: SONG_REQUEST_SKIPPED = 3;
+   * When the user's temporary DJ grant expires. Unset when they don't have one.
+   *
+   * @generated from field: google.protobuf.Timestamp dj_expires_at = 2;
    */
-  SONG_REQUEST_SKIPPED = 3,
+  djExpiresAt?: Timestamp;
 
   /**
-   * @generated from enum value: SONG_REQUEST_SKIPPED_ALL = 4;
+   * Set when the user can add songs right now.
+   *
+   * @generated from field: bool can_queue = 3;
    */
-  SONG_REQUEST_SKIPPED_ALL = 4,
+  canQueue = false;
+
+  /**
+   * Why the user can't add songs. Empty when they can.
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<GetMusicAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetMusicAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dj", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "dj_expires_at", kind: "message", T: Timestamp },
+    { no: 3, name: "can_queue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicAccessResponse {
+    return new GetMusicAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicAccessResponse {
+    return new GetMusicAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicAccessResponse {
+    return new GetMusicAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMusicAccessResponse | PlainMessage<GetMusicAccessResponse> | undefined, b: GetMusicAccessResponse | PlainMessage<GetMusicAccessResponse> | undefined): boolean {
+    return proto3.util.equals(GetMusicAccessResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.SavedPlaylist
+ */
+export class SavedPlaylist extends Message<SavedPlaylist> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Empty for playlists shared with the whole guild.
+   *
+   * @generated from field: string owner_user_id = 3;
+   */
+  ownerUserId = "";
+
+  /**
+   * @generated from field: bool shared = 4;
+   */
+  shared = false;
+
+  /**
+   * @generated from field: string created_by_user_id = 5;
+   */
+  createdByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 song_count = 8;
+   */
+  songCount = 0;
+
+  constructor(data?: PartialMessage<SavedPlaylist>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.SavedPlaylist";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "owner_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 8, name: "song_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SavedPlaylist {
+    return new SavedPlaylist().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SavedPlaylist {
+    return new SavedPlaylist().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SavedPlaylist {
+    return new SavedPlaylist().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SavedPlaylist | PlainMessage<SavedPlaylist> | undefined, b: SavedPlaylist | PlainMessage<SavedPlaylist> | undefined): boolean {
+    return proto3.util.equals(SavedPlaylist, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.SavedPlaylistSong
+ */
+export class SavedPlaylistSong extends Message<SavedPlaylistSong> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * 1-based position in the playlist.
+   *
+   * @generated from field: int32 position = 2;
+   */
+  position = 0;
+
+  /**
+   * @generated from field: thalassa.v1.Song song = 3;
+   */
+  song?: Song;
+
+  /**
+   * @generated from field: string added_by_user_id = 4;
+   */
+  addedByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp added_at = 5;
+   */
+  addedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<SavedPlaylistSong>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.SavedPlaylistSong";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "song", kind: "message", T: Song },
+    { no: 4, name: "added_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "added_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SavedPlaylistSong {
+    return new SavedPlaylistSong().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SavedPlaylistSong {
+    return new SavedPlaylistSong().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SavedPlaylistSong {
+    return new SavedPlaylistSong().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SavedPlaylistSong | PlainMessage<SavedPlaylistSong> | undefined, b: SavedPlaylistSong | PlainMessage<SavedPlaylistSong> | undefined): boolean {
+    return proto3.util.equals(SavedPlaylistSong, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetSavedPlaylistsRequest
+ */
+export class GetSavedPlaylistsRequest extends Message<GetSavedPlaylistsRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * The user's own playlists are listed along with the guild's shared playlists.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<GetSavedPlaylistsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetSavedPlaylistsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSavedPlaylistsRequest {
+    return new GetSavedPlaylistsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSavedPlaylistsRequest {
+    return new GetSavedPlaylistsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSavedPlaylistsRequest {
+    return new GetSavedPlaylistsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSavedPlaylistsRequest | PlainMessage<GetSavedPlaylistsRequest> | undefined, b: GetSavedPlaylistsRequest | PlainMessage<GetSavedPlaylistsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSavedPlaylistsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetSavedPlaylistsResponse
+ */
+export class GetSavedPlaylistsResponse extends Message<GetSavedPlaylistsResponse> {
+  /**
+   * @generated from field: repeated thalassa.v1.SavedPlaylist saved_playlists = 1;
+   */
+  savedPlaylists: SavedPlaylist[] = [];
+
+  constructor(data?: PartialMessage<GetSavedPlaylistsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetSavedPlaylistsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saved_playlists", kind: "message", T: SavedPlaylist, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSavedPlaylistsResponse {
+    return new GetSavedPlaylistsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSavedPlaylistsResponse {
+    return new GetSavedPlaylistsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSavedPlaylistsResponse {
+    return new GetSavedPlaylistsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSavedPlaylistsResponse | PlainMessage<GetSavedPlaylistsResponse> | undefined, b: GetSavedPlaylistsResponse | PlainMessage<GetSavedPlaylistsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSavedPlaylistsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetSavedPlaylistRequest
+ */
+export class GetSavedPlaylistRequest extends Message<GetSavedPlaylistRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * The user asking, who can only see their own personal playlists.
+   *
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<GetSavedPlaylistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetSavedPlaylistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSavedPlaylistRequest {
+    return new GetSavedPlaylistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSavedPlaylistRequest {
+    return new GetSavedPlaylistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSavedPlaylistRequest {
+    return new GetSavedPlaylistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSavedPlaylistRequest | PlainMessage<GetSavedPlaylistRequest> | undefined, b: GetSavedPlaylistRequest | PlainMessage<GetSavedPlaylistRequest> | undefined): boolean {
+    return proto3.util.equals(GetSavedPlaylistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetSavedPlaylistResponse
+ */
+export class GetSavedPlaylistResponse extends Message<GetSavedPlaylistResponse> {
+  /**
+   * @generated from field: thalassa.v1.SavedPlaylist saved_playlist = 1;
+   */
+  savedPlaylist?: SavedPlaylist;
+
+  /**
+   * @generated from field: repeated thalassa.v1.SavedPlaylistSong songs = 2;
+   */
+  songs: SavedPlaylistSong[] = [];
+
+  constructor(data?: PartialMessage<GetSavedPlaylistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetSavedPlaylistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saved_playlist", kind: "message", T: SavedPlaylist },
+    { no: 2, name: "songs", kind: "message", T: SavedPlaylistSong, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSavedPlaylistResponse {
+    return new GetSavedPlaylistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSavedPlaylistResponse {
+    return new GetSavedPlaylistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSavedPlaylistResponse {
+    return new GetSavedPlaylistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSavedPlaylistResponse | PlainMessage<GetSavedPlaylistResponse> | undefined, b: GetSavedPlaylistResponse | PlainMessage<GetSavedPlaylistResponse> | undefined): boolean {
+    return proto3.util.equals(GetSavedPlaylistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.CreateSavedPlaylistRequest
+ */
+export class CreateSavedPlaylistRequest extends Message<CreateSavedPlaylistRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * Shared playlists belong to the whole guild instead of the user.
+   *
+   * @generated from field: bool shared = 4;
+   */
+  shared = false;
+
+  constructor(data?: PartialMessage<CreateSavedPlaylistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.CreateSavedPlaylistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "shared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSavedPlaylistRequest {
+    return new CreateSavedPlaylistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSavedPlaylistRequest {
+    return new CreateSavedPlaylistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSavedPlaylistRequest {
+    return new CreateSavedPlaylistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSavedPlaylistRequest | PlainMessage<CreateSavedPlaylistRequest> | undefined, b: CreateSavedPlaylistRequest | PlainMessage<CreateSavedPlaylistRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSavedPlaylistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.CreateSavedPlaylistResponse
+ */
+export class CreateSavedPlaylistResponse extends Message<CreateSavedPlaylistResponse> {
+  /**
+   * @generated from field: thalassa.v1.SavedPlaylist saved_playlist = 1;
+   */
+  savedPlaylist?: SavedPlaylist;
+
+  constructor(data?: PartialMessage<CreateSavedPlaylistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.CreateSavedPlaylistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saved_playlist", kind: "message", T: SavedPlaylist },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSavedPlaylistResponse {
+    return new CreateSavedPlaylistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSavedPlaylistResponse {
+    return new CreateSavedPlaylistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSavedPlaylistResponse {
+    return new CreateSavedPlaylistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSavedPlaylistResponse | PlainMessage<CreateSavedPlaylistResponse> | undefined, b: CreateSavedPlaylistResponse | PlainMessage<CreateSavedPlaylistResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSavedPlaylistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.RenameSavedPlaylistRequest
+ */
+export class RenameSavedPlaylistRequest extends Message<RenameSavedPlaylistRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<RenameSavedPlaylistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.RenameSavedPlaylistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameSavedPlaylistRequest {
+    return new RenameSavedPlaylistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameSavedPlaylistRequest {
+    return new RenameSavedPlaylistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameSavedPlaylistRequest {
+    return new RenameSavedPlaylistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameSavedPlaylistRequest | PlainMessage<RenameSavedPlaylistRequest> | undefined, b: RenameSavedPlaylistRequest | PlainMessage<RenameSavedPlaylistRequest> | undefined): boolean {
+    return proto3.util.equals(RenameSavedPlaylistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.RenameSavedPlaylistResponse
+ */
+export class RenameSavedPlaylistResponse extends Message<RenameSavedPlaylistResponse> {
+  /**
+   * @generated from field: thalassa.v1.SavedPlaylist saved_playlist = 1;
+   */
+  savedPlaylist?: SavedPlaylist;
+
+  constructor(data?: PartialMessage<RenameSavedPlaylistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.RenameSavedPlaylistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saved_playlist", kind: "message", T: SavedPlaylist },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameSavedPlaylistResponse {
+    return new RenameSavedPlaylistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameSavedPlaylistResponse {
+    return new RenameSavedPlaylistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameSavedPlaylistResponse {
+    return new RenameSavedPlaylistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameSavedPlaylistResponse | PlainMessage<RenameSavedPlaylistResponse> | undefined, b: RenameSavedPlaylistResponse | PlainMessage<RenameSavedPlaylistResponse> | undefined): boolean {
+    return proto3.util.equals(RenameSavedPlaylistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.DeleteSavedPlaylistRequest
+ */
+export class DeleteSavedPlaylistRequest extends Message<DeleteSavedPlaylistRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<DeleteSavedPlaylistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.DeleteSavedPlaylistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSavedPlaylistRequest {
+    return new DeleteSavedPlaylistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSavedPlaylistRequest {
+    return new DeleteSavedPlaylistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSavedPlaylistRequest {
+    return new DeleteSavedPlaylistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSavedPlaylistRequest | PlainMessage<DeleteSavedPlaylistRequest> | undefined, b: DeleteSavedPlaylistRequest | PlainMessage<DeleteSavedPlaylistRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSavedPlaylistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.DeleteSavedPlaylistResponse
+ */
+export class DeleteSavedPlaylistResponse extends Message<DeleteSavedPlaylistResponse> {
+  constructor(data?: PartialMessage<DeleteSavedPlaylistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.DeleteSavedPlaylistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSavedPlaylistResponse {
+    return new DeleteSavedPlaylistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSavedPlaylistResponse {
+    return new DeleteSavedPlaylistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSavedPlaylistResponse {
+    return new DeleteSavedPlaylistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSavedPlaylistResponse | PlainMessage<DeleteSavedPlaylistResponse> | undefined, b: DeleteSavedPlaylistResponse | PlainMessage<DeleteSavedPlaylistResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSavedPlaylistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.AddSavedPlaylistSongRequest
+ */
+export class AddSavedPlaylistSongRequest extends Message<AddSavedPlaylistSongRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<AddSavedPlaylistSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.AddSavedPlaylistSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddSavedPlaylistSongRequest {
+    return new AddSavedPlaylistSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddSavedPlaylistSongRequest {
+    return new AddSavedPlaylistSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddSavedPlaylistSongRequest {
+    return new AddSavedPlaylistSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddSavedPlaylistSongRequest | PlainMessage<AddSavedPlaylistSongRequest> | undefined, b: AddSavedPlaylistSongRequest | PlainMessage<AddSavedPlaylistSongRequest> | undefined): boolean {
+    return proto3.util.equals(AddSavedPlaylistSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.AddSavedPlaylistSongResponse
+ */
+export class AddSavedPlaylistSongResponse extends Message<AddSavedPlaylistSongResponse> {
+  /**
+   * @generated from field: thalassa.v1.SavedPlaylistSong song = 1;
+   */
+  song?: SavedPlaylistSong;
+
+  constructor(data?: PartialMessage<AddSavedPlaylistSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.AddSavedPlaylistSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song", kind: "message", T: SavedPlaylistSong },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddSavedPlaylistSongResponse {
+    return new AddSavedPlaylistSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddSavedPlaylistSongResponse {
+    return new AddSavedPlaylistSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddSavedPlaylistSongResponse {
+    return new AddSavedPlaylistSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddSavedPlaylistSongResponse | PlainMessage<AddSavedPlaylistSongResponse> | undefined, b: AddSavedPlaylistSongResponse | PlainMessage<AddSavedPlaylistSongResponse> | undefined): boolean {
+    return proto3.util.equals(AddSavedPlaylistSongResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.RemoveSavedPlaylistSongRequest
+ */
+export class RemoveSavedPlaylistSongRequest extends Message<RemoveSavedPlaylistSongRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: int64 id = 2;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * 1-based position of the song to remove.
+   *
+   * @generated from field: int32 position = 3;
+   */
+  position = 0;
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<RemoveSavedPlaylistSongRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.RemoveSavedPlaylistSongRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "position", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveSavedPlaylistSongRequest {
+    return new RemoveSavedPlaylistSongRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveSavedPlaylistSongRequest {
+    return new RemoveSavedPlaylistSongRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveSavedPlaylistSongRequest {
+    return new RemoveSavedPlaylistSongRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveSavedPlaylistSongRequest | PlainMessage<RemoveSavedPlaylistSongRequest> | undefined, b: RemoveSavedPlaylistSongRequest | PlainMessage<RemoveSavedPlaylistSongRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveSavedPlaylistSongRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.RemoveSavedPlaylistSongResponse
+ */
+export class RemoveSavedPlaylistSongResponse extends Message<RemoveSavedPlaylistSongResponse> {
+  /**
+   * @generated from field: thalassa.v1.SavedPlaylistSong song = 1;
+   */
+  song?: SavedPlaylistSong;
+
+  constructor(data?: PartialMessage<RemoveSavedPlaylistSongResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.RemoveSavedPlaylistSongResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song", kind: "message", T: SavedPlaylistSong },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveSavedPlaylistSongResponse {
+    return new RemoveSavedPlaylistSongResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveSavedPlaylistSongResponse {
+    return new RemoveSavedPlaylistSongResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveSavedPlaylistSongResponse {
+    return new RemoveSavedPlaylistSongResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveSavedPlaylistSongResponse | PlainMessage<RemoveSavedPlaylistSongResponse> | undefined, b: RemoveSavedPlaylistSongResponse | PlainMessage<RemoveSavedPlaylistSongResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveSavedPlaylistSongResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.SongStats
+ */
+export class SongStats extends Message<SongStats> {
+  /**
+   * @generated from field: thalassa.v1.Song song = 1;
+   */
+  song?: Song;
+
+  /**
+   * @generated from field: int32 plays = 2;
+   */
+  plays = 0;
+
+  /**
+   * @generated from field: int32 skips = 3;
+   */
+  skips = 0;
+
+  /**
+   * @generated from field: int32 requesters = 4;
+   */
+  requesters = 0;
+
+  constructor(data?: PartialMessage<SongStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.SongStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "song", kind: "message", T: Song },
+    { no: 2, name: "plays", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "skips", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "requesters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongStats {
+    return new SongStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongStats {
+    return new SongStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongStats {
+    return new SongStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SongStats | PlainMessage<SongStats> | undefined, b: SongStats | PlainMessage<SongStats> | undefined): boolean {
+    return proto3.util.equals(SongStats, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.RequesterStats
+ */
+export class RequesterStats extends Message<RequesterStats> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: int32 requests = 3;
+   */
+  requests = 0;
+
+  /**
+   * @generated from field: int32 skips = 4;
+   */
+  skips = 0;
+
+  /**
+   * @generated from field: int64 played_seconds = 5;
+   */
+  playedSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RequesterStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.RequesterStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "requests", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "skips", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "played_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequesterStats {
+    return new RequesterStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequesterStats {
+    return new RequesterStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequesterStats {
+    return new RequesterStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequesterStats | PlainMessage<RequesterStats> | undefined, b: RequesterStats | PlainMessage<RequesterStats> | undefined): boolean {
+    return proto3.util.equals(RequesterStats, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetMusicStatsRequest
+ */
+export class GetMusicStatsRequest extends Message<GetMusicStatsRequest> {
+  /**
+   * @generated from field: string guild_id = 1;
+   */
+  guildId = "";
+
+  /**
+   * @generated from field: thalassa.v1.StatsPeriod period = 2;
+   */
+  period = StatsPeriod.STATS_PERIOD_ALL_TIME;
+
+  /**
+   * Only counts the user's requests when set.
+   *
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  /**
+   * How many top songs and requesters to return, 10 by default.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<GetMusicStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetMusicStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "guild_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "period", kind: "enum", T: proto3.getEnumType(StatsPeriod) },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicStatsRequest {
+    return new GetMusicStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicStatsRequest {
+    return new GetMusicStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicStatsRequest {
+    return new GetMusicStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMusicStatsRequest | PlainMessage<GetMusicStatsRequest> | undefined, b: GetMusicStatsRequest | PlainMessage<GetMusicStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetMusicStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.GetMusicStatsResponse
+ */
+export class GetMusicStatsResponse extends Message<GetMusicStatsResponse> {
+  /**
+   * @generated from field: int32 played = 1;
+   */
+  played = 0;
+
+  /**
+   * @generated from field: int32 skipped = 2;
+   */
+  skipped = 0;
+
+  /**
+   * @generated from field: int32 autoplayed = 3;
+   */
+  autoplayed = 0;
+
+  /**
+   * Number of different songs played.
+   *
+   * @generated from field: int32 songs = 4;
+   */
+  songs = 0;
+
+  /**
+   * @generated from field: int32 requesters = 5;
+   */
+  requesters = 0;
+
+  /**
+   * Time spent listening. Skipped songs count the time they played for.
+   *
+   * @generated from field: int64 played_seconds = 6;
+   */
+  playedSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated thalassa.v1.SongStats top_songs = 7;
+   */
+  topSongs: SongStats[] = [];
+
+  /**
+   * @generated from field: repeated thalassa.v1.RequesterStats top_requesters = 8;
+   */
+  topRequesters: RequesterStats[] = [];
+
+  constructor(data?: PartialMessage<GetMusicStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.GetMusicStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "played", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "skipped", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "autoplayed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "songs", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "requesters", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "played_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "top_songs", kind: "message", T: SongStats, repeated: true },
+    { no: 8, name: "top_requesters", kind: "message", T: RequesterStats, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMusicStatsResponse {
+    return new GetMusicStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMusicStatsResponse {
+    return new GetMusicStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMusicStatsResponse {
+    return new GetMusicStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMusicStatsResponse | PlainMessage<GetMusicStatsResponse> | undefined, b: GetMusicStatsResponse | PlainMessage<GetMusicStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetMusicStatsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message thalassa.v1.SongRequestsUpdateEvent
+ */
+export class SongRequestsUpdateEvent extends Message<SongRequestsUpdateEvent> {
+  /**
+   * @generated from field: thalassa.v1.SongRequestsUpdateEvent.EventType event_type = 1;
+   */
+  eventType = SongRequestsUpdateEvent_EventType.SONG_REQUEST_ADDED;
+
+  /**
+   * @generated from field: thalassa.v1.SongRequest song_request = 2;
+   */
+  songRequest?: SongRequest;
+
+  constructor(data?: PartialMessage<SongRequestsUpdateEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "thalassa.v1.SongRequestsUpdateEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_type", kind: "enum", T: proto3.getEnumType(SongRequestsUpdateEvent_EventType) },
+    { no: 2, name: "song_request", kind: "message", T: SongRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SongRequestsUpdateEvent {
+    return new SongRequestsUpdateEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SongRequestsUpdateEvent {
+    return new SongRequestsUpdateEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SongRequestsUpdateEvent {
+    return new SongRequestsUpdateEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SongRequestsUpdateEvent | PlainMessage<SongRequestsUpdateEvent> | undefined, b: SongRequestsUpdateEvent | PlainMessage<SongRequestsUpdateEvent> | undefined): boolean {
+    return proto3.util.equals(SongRequestsUpdateEvent, a, b);
+  }
+}
+
+/**
+ * @generated from enum thalassa.v1.SongRequestsUpdateEvent.EventType
+ */
+export enum SongRequestsUpdateEvent_EventType {
+  /**
+   * @generated from enum value: SONG_REQUEST_ADDED = 0;
+   */
+  SONG_REQUEST_ADDED = 0,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_PLAYING = 1;
+   */
+  SONG_REQUEST_PLAYING = 1,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_FINISHED = 2;
+   */
+  SONG_REQUEST_FINISHED = 2,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_SKIPPED = 3;
+   */
+  SONG_REQUEST_SKIPPED = 3,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_SKIPPED_ALL = 4;
+   */
+  SONG_REQUEST_SKIPPED_ALL = 4,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_REMOVED = 5;
+   */
+  SONG_REQUEST_REMOVED = 5,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_MOVED = 6;
+   */
+  SONG_REQUEST_MOVED = 6,
+
+  /**
+   * @generated from enum value: SONG_QUEUE_SHUFFLED = 7;
+   */
+  SONG_QUEUE_SHUFFLED = 7,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_PAUSED = 8;
+   */
+  SONG_REQUEST_PAUSED = 8,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_RESUMED = 9;
+   */
+  SONG_REQUEST_RESUMED = 9,
+
+  /**
+   * @generated from enum value: SONG_REQUEST_SEEKED = 10;
+   */
+  SONG_REQUEST_SEEKED = 10,
 }
 // Retrieve enum metadata with: proto3.getEnumType(SongRequestsUpdateEvent_EventType)
 proto3.util.setEnumType(SongRequestsUpdateEvent_EventType, "thalassa.v1.SongRequestsUpdateEvent.EventType", [
@@ -549,6 +1953,12 @@ proto3.util.setEnumType(SongRequestsUpdateEvent_EventType, "thalassa.v1.SongRequ
   { no: 2, name: "SONG_REQUEST_FINISHED" },
   { no: 3, name: "SONG_REQUEST_SKIPPED" },
   { no: 4, name: "SONG_REQUEST_SKIPPED_ALL" },
+  { no: 5, name: "SONG_REQUEST_REMOVED" },
+  { no: 6, name: "SONG_REQUEST_MOVED" },
+  { no: 7, name: "SONG_QUEUE_SHUFFLED" },
+  { no: 8, name: "SONG_REQUEST_PAUSED" },
+  { no: 9, name: "SONG_REQUEST_RESUMED" },
+  { no: 10, name: "SONG_REQUEST_SEEKED" },
 ]);
 
 /**
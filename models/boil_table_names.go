@@ -4,19 +4,29 @@
 package models
 
 var TableNames = struct {
-	ChatHistory    string
-	CustomCommand  string
-	DiscordServer  string
-	MutedMembers   string
-	RolePermission string
-	Song           string
-	SongRequest    string
+	ChatHistory       string
+	CustomCommand     string
+	DiscordServer     string
+	MusicBlocklist    string
+	MusicDJGrant      string
+	MusicPolicy       string
+	MutedMembers      string
+	RolePermission    string
+	SavedPlaylist     string
+	SavedPlaylistSong string
+	Song              string
+	SongRequest       string
 }{
-	ChatHistory:    "chat_history",
-	CustomCommand:  "custom_command",
-	DiscordServer:  "discord_server",
-	MutedMembers:   "muted_members",
-	RolePermission: "role_permission",
-	Song:           "song",
-	SongRequest:    "song_request",
+	ChatHistory:       "chat_history",
+	CustomCommand:     "custom_command",
+	DiscordServer:     "discord_server",
+	MusicBlocklist:    "music_blocklist",
+	MusicDJGrant:      "music_dj_grant",
+	MusicPolicy:       "music_policy",
+	MutedMembers:      "muted_members",
+	RolePermission:    "role_permission",
+	SavedPlaylist:     "saved_playlist",
+	SavedPlaylistSong: "saved_playlist_song",
+	Song:              "song",
+	SongRequest:       "song_request",
 }
@@ -24,102 +24,147 @@ import (
 
 // DiscordServer is an object representing the database table.
 type DiscordServer struct {
-	GuildID                 string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	GuildName               string      `boil:"guild_name" json:"guild_name" toml:"guild_name" yaml:"guild_name"`
-	LinkRemovalEnabled      bool        `boil:"link_removal_enabled" json:"link_removal_enabled" toml:"link_removal_enabled" yaml:"link_removal_enabled"`
-	MusicEnabled            bool        `boil:"music_enabled" json:"music_enabled" toml:"music_enabled" yaml:"music_enabled"`
-	CustomCommandsEnabled   bool        `boil:"custom_commands_enabled" json:"custom_commands_enabled" toml:"custom_commands_enabled" yaml:"custom_commands_enabled"`
-	DiceRollEnabled         bool        `boil:"dice_roll_enabled" json:"dice_roll_enabled" toml:"dice_roll_enabled" yaml:"dice_roll_enabled"`
-	PrefixCommand           string      `boil:"prefix_command" json:"prefix_command" toml:"prefix_command" yaml:"prefix_command"`
-	MusicTextChannelID      null.String `boil:"music_text_channel_id" json:"music_text_channel_id,omitempty" toml:"music_text_channel_id" yaml:"music_text_channel_id,omitempty"`
-	MusicVoiceChannelID     null.String `boil:"music_voice_channel_id" json:"music_voice_channel_id,omitempty" toml:"music_voice_channel_id" yaml:"music_voice_channel_id,omitempty"`
-	MusicVolume             float32     `boil:"music_volume" json:"music_volume" toml:"music_volume" yaml:"music_volume"`
-	AnnounceSongs           bool        `boil:"announce_songs" json:"announce_songs" toml:"announce_songs" yaml:"announce_songs"`
-	ThrottleCommandsEnabled bool        `boil:"throttle_commands_enabled" json:"throttle_commands_enabled" toml:"throttle_commands_enabled" yaml:"throttle_commands_enabled"`
-	ThrottleCommandsSeconds null.Int64  `boil:"throttle_commands_seconds" json:"throttle_commands_seconds,omitempty" toml:"throttle_commands_seconds" yaml:"throttle_commands_seconds,omitempty"`
-	WelcomeMessageEnabled   bool        `boil:"welcome_message_enabled" json:"welcome_message_enabled" toml:"welcome_message_enabled" yaml:"welcome_message_enabled"`
-	WelcomeMessage          null.String `boil:"welcome_message" json:"welcome_message,omitempty" toml:"welcome_message" yaml:"welcome_message,omitempty"`
-	ModerationMuteEnabled   bool        `boil:"moderation_mute_enabled" json:"moderation_mute_enabled" toml:"moderation_mute_enabled" yaml:"moderation_mute_enabled"`
-	NotifyMeRoleEnabled     bool        `boil:"notify_me_role_enabled" json:"notify_me_role_enabled" toml:"notify_me_role_enabled" yaml:"notify_me_role_enabled"`
+	GuildID                  string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	GuildName                string      `boil:"guild_name" json:"guild_name" toml:"guild_name" yaml:"guild_name"`
+	LinkRemovalEnabled       bool        `boil:"link_removal_enabled" json:"link_removal_enabled" toml:"link_removal_enabled" yaml:"link_removal_enabled"`
+	MusicEnabled             bool        `boil:"music_enabled" json:"music_enabled" toml:"music_enabled" yaml:"music_enabled"`
+	CustomCommandsEnabled    bool        `boil:"custom_commands_enabled" json:"custom_commands_enabled" toml:"custom_commands_enabled" yaml:"custom_commands_enabled"`
+	DiceRollEnabled          bool        `boil:"dice_roll_enabled" json:"dice_roll_enabled" toml:"dice_roll_enabled" yaml:"dice_roll_enabled"`
+	PrefixCommand            string      `boil:"prefix_command" json:"prefix_command" toml:"prefix_command" yaml:"prefix_command"`
+	MusicTextChannelID       null.String `boil:"music_text_channel_id" json:"music_text_channel_id,omitempty" toml:"music_text_channel_id" yaml:"music_text_channel_id,omitempty"`
+	MusicVoiceChannelID      null.String `boil:"music_voice_channel_id" json:"music_voice_channel_id,omitempty" toml:"music_voice_channel_id" yaml:"music_voice_channel_id,omitempty"`
+	MusicVolume              float32     `boil:"music_volume" json:"music_volume" toml:"music_volume" yaml:"music_volume"`
+	AnnounceSongs            bool        `boil:"announce_songs" json:"announce_songs" toml:"announce_songs" yaml:"announce_songs"`
+	ThrottleCommandsEnabled  bool        `boil:"throttle_commands_enabled" json:"throttle_commands_enabled" toml:"throttle_commands_enabled" yaml:"throttle_commands_enabled"`
+	ThrottleCommandsSeconds  null.Int64  `boil:"throttle_commands_seconds" json:"throttle_commands_seconds,omitempty" toml:"throttle_commands_seconds" yaml:"throttle_commands_seconds,omitempty"`
+	WelcomeMessageEnabled    bool        `boil:"welcome_message_enabled" json:"welcome_message_enabled" toml:"welcome_message_enabled" yaml:"welcome_message_enabled"`
+	WelcomeMessage           null.String `boil:"welcome_message" json:"welcome_message,omitempty" toml:"welcome_message" yaml:"welcome_message,omitempty"`
+	ModerationMuteEnabled    bool        `boil:"moderation_mute_enabled" json:"moderation_mute_enabled" toml:"moderation_mute_enabled" yaml:"moderation_mute_enabled"`
+	NotifyMeRoleEnabled      bool        `boil:"notify_me_role_enabled" json:"notify_me_role_enabled" toml:"notify_me_role_enabled" yaml:"notify_me_role_enabled"`
+	VoteSkipThresholdPercent int         `boil:"vote_skip_threshold_percent" json:"vote_skip_threshold_percent" toml:"vote_skip_threshold_percent" yaml:"vote_skip_threshold_percent"`
+	MusicLoopMode            string      `boil:"music_loop_mode" json:"music_loop_mode" toml:"music_loop_mode" yaml:"music_loop_mode"`
+	MusicAutoplayEnabled     bool        `boil:"music_autoplay_enabled" json:"music_autoplay_enabled" toml:"music_autoplay_enabled" yaml:"music_autoplay_enabled"`
+	MusicFairQueue           bool        `boil:"music_fair_queue" json:"music_fair_queue" toml:"music_fair_queue" yaml:"music_fair_queue"`
+	MusicFilter              string      `boil:"music_filter" json:"music_filter" toml:"music_filter" yaml:"music_filter"`
+	MusicFollowRequester     bool        `boil:"music_follow_requester" json:"music_follow_requester" toml:"music_follow_requester" yaml:"music_follow_requester"`
+	MusicIdleSeconds         int         `boil:"music_idle_seconds" json:"music_idle_seconds" toml:"music_idle_seconds" yaml:"music_idle_seconds"`
+	MusicIdleAction          string      `boil:"music_idle_action" json:"music_idle_action" toml:"music_idle_action" yaml:"music_idle_action"`
+	MusicKeepUnheard         bool        `boil:"music_keep_unheard" json:"music_keep_unheard" toml:"music_keep_unheard" yaml:"music_keep_unheard"`
 
 	R *discordServerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L discordServerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var DiscordServerColumns = struct {
-	GuildID                 string
-	GuildName               string
-	LinkRemovalEnabled      string
-	MusicEnabled            string
-	CustomCommandsEnabled   string
-	DiceRollEnabled         string
-	PrefixCommand           string
-	MusicTextChannelID      string
-	MusicVoiceChannelID     string
-	MusicVolume             string
-	AnnounceSongs           string
-	ThrottleCommandsEnabled string
-	ThrottleCommandsSeconds string
-	WelcomeMessageEnabled   string
-	WelcomeMessage          string
-	ModerationMuteEnabled   string
-	NotifyMeRoleEnabled     string
+	GuildID                  string
+	GuildName                string
+	LinkRemovalEnabled       string
+	MusicEnabled             string
+	CustomCommandsEnabled    string
+	DiceRollEnabled          string
+	PrefixCommand            string
+	MusicTextChannelID       string
+	MusicVoiceChannelID      string
+	MusicVolume              string
+	AnnounceSongs            string
+	ThrottleCommandsEnabled  string
+	ThrottleCommandsSeconds  string
+	WelcomeMessageEnabled    string
+	WelcomeMessage           string
+	ModerationMuteEnabled    string
+	NotifyMeRoleEnabled      string
+	VoteSkipThresholdPercent string
+	MusicLoopMode            string
+	MusicAutoplayEnabled     string
+	MusicFairQueue           string
+	MusicFilter              string
+	MusicFollowRequester     string
+	MusicIdleSeconds         string
+	MusicIdleAction          string
+	MusicKeepUnheard         string
 }{
-	GuildID:                 "guild_id",
-	GuildName:               "guild_name",
-	LinkRemovalEnabled:      "link_removal_enabled",
-	MusicEnabled:            "music_enabled",
-	CustomCommandsEnabled:   "custom_commands_enabled",
-	DiceRollEnabled:         "dice_roll_enabled",
-	PrefixCommand:           "prefix_command",
-	MusicTextChannelID:      "music_text_channel_id",
-	MusicVoiceChannelID:     "music_voice_channel_id",
-	MusicVolume:             "music_volume",
-	AnnounceSongs:           "announce_songs",
-	ThrottleCommandsEnabled: "throttle_commands_enabled",
-	ThrottleCommandsSeconds: "throttle_commands_seconds",
-	WelcomeMessageEnabled:   "welcome_message_enabled",
-	WelcomeMessage:          "welcome_message",
-	ModerationMuteEnabled:   "moderation_mute_enabled",
-	NotifyMeRoleEnabled:     "notify_me_role_enabled",
+	GuildID:                  "guild_id",
+	GuildName:                "guild_name",
+	LinkRemovalEnabled:       "link_removal_enabled",
+	MusicEnabled:             "music_enabled",
+	CustomCommandsEnabled:    "custom_commands_enabled",
+	DiceRollEnabled:          "dice_roll_enabled",
+	PrefixCommand:            "prefix_command",
+	MusicTextChannelID:       "music_text_channel_id",
+	MusicVoiceChannelID:      "music_voice_channel_id",
+	MusicVolume:              "music_volume",
+	AnnounceSongs:            "announce_songs",
+	ThrottleCommandsEnabled:  "throttle_commands_enabled",
+	ThrottleCommandsSeconds:  "throttle_commands_seconds",
+	WelcomeMessageEnabled:    "welcome_message_enabled",
+	WelcomeMessage:           "welcome_message",
+	ModerationMuteEnabled:    "moderation_mute_enabled",
+	NotifyMeRoleEnabled:      "notify_me_role_enabled",
+	VoteSkipThresholdPercent: "vote_skip_threshold_percent",
+	MusicLoopMode:            "music_loop_mode",
+	MusicAutoplayEnabled:     "music_autoplay_enabled",
+	MusicFairQueue:           "music_fair_queue",
+	MusicFilter:              "music_filter",
+	MusicFollowRequester:     "music_follow_requester",
+	MusicIdleSeconds:         "music_idle_seconds",
+	MusicIdleAction:          "music_idle_action",
+	MusicKeepUnheard:         "music_keep_unheard",
 }
 
 var DiscordServerTableColumns = struct {
-	GuildID                 string
-	GuildName               string
-	LinkRemovalEnabled      string
-	MusicEnabled            string
-	CustomCommandsEnabled   string
-	DiceRollEnabled         string
-	PrefixCommand           string
-	MusicTextChannelID      string
-	MusicVoiceChannelID     string
-	MusicVolume             string
-	AnnounceSongs           string
-	ThrottleCommandsEnabled string
-	ThrottleCommandsSeconds string
-	WelcomeMessageEnabled   string
-	WelcomeMessage          string
-	ModerationMuteEnabled   string
-	NotifyMeRoleEnabled     string
+	GuildID                  string
+	GuildName                string
+	LinkRemovalEnabled       string
+	MusicEnabled             string
+	CustomCommandsEnabled    string
+	DiceRollEnabled          string
+	PrefixCommand            string
+	MusicTextChannelID       string
+	MusicVoiceChannelID      string
+	MusicVolume              string
+	AnnounceSongs            string
+	ThrottleCommandsEnabled  string
+	ThrottleCommandsSeconds  string
+	WelcomeMessageEnabled    string
+	WelcomeMessage           string
+	ModerationMuteEnabled    string
+	NotifyMeRoleEnabled      string
+	VoteSkipThresholdPercent string
+	MusicLoopMode            string
+	MusicAutoplayEnabled     string
+	MusicFairQueue           string
+	MusicFilter              string
+	MusicFollowRequester     string
+	MusicIdleSeconds         string
+	MusicIdleAction          string
+	MusicKeepUnheard         string
 }{
-	GuildID:                 "discord_server.guild_id",
-	GuildName:               "discord_server.guild_name",
-	LinkRemovalEnabled:      "discord_server.link_removal_enabled",
-	MusicEnabled:            "discord_server.music_enabled",
-	CustomCommandsEnabled:   "discord_server.custom_commands_enabled",
-	DiceRollEnabled:         "discord_server.dice_roll_enabled",
-	PrefixCommand:           "discord_server.prefix_command",
-	MusicTextChannelID:      "discord_server.music_text_channel_id",
-	MusicVoiceChannelID:     "discord_server.music_voice_channel_id",
-	MusicVolume:             "discord_server.music_volume",
-	AnnounceSongs:           "discord_server.announce_songs",
-	ThrottleCommandsEnabled: "discord_server.throttle_commands_enabled",
-	ThrottleCommandsSeconds: "discord_server.throttle_commands_seconds",
-	WelcomeMessageEnabled:   "discord_server.welcome_message_enabled",
-	WelcomeMessage:          "discord_server.welcome_message",
-	ModerationMuteEnabled:   "discord_server.moderation_mute_enabled",
-	NotifyMeRoleEnabled:     "discord_server.notify_me_role_enabled",
+	GuildID:                  "discord_server.guild_id",
+	GuildName:                "discord_server.guild_name",
+	LinkRemovalEnabled:       "discord_server.link_removal_enabled",
+	MusicEnabled:             "discord_server.music_enabled",
+	CustomCommandsEnabled:    "discord_server.custom_commands_enabled",
+	DiceRollEnabled:          "discord_server.dice_roll_enabled",
+	PrefixCommand:            "discord_server.prefix_command",
+	MusicTextChannelID:       "discord_server.music_text_channel_id",
+	MusicVoiceChannelID:      "discord_server.music_voice_channel_id",
+	MusicVolume:              "discord_server.music_volume",
+	AnnounceSongs:            "discord_server.announce_songs",
+	ThrottleCommandsEnabled:  "discord_server.throttle_commands_enabled",
+	ThrottleCommandsSeconds:  "discord_server.throttle_commands_seconds",
+	WelcomeMessageEnabled:    "discord_server.welcome_message_enabled",
+	WelcomeMessage:           "discord_server.welcome_message",
+	ModerationMuteEnabled:    "discord_server.moderation_mute_enabled",
+	NotifyMeRoleEnabled:      "discord_server.notify_me_role_enabled",
+	VoteSkipThresholdPercent: "discord_server.vote_skip_threshold_percent",
+	MusicLoopMode:            "discord_server.music_loop_mode",
+	MusicAutoplayEnabled:     "discord_server.music_autoplay_enabled",
+	MusicFairQueue:           "discord_server.music_fair_queue",
+	MusicFilter:              "discord_server.music_filter",
+	MusicFollowRequester:     "discord_server.music_follow_requester",
+	MusicIdleSeconds:         "discord_server.music_idle_seconds",
+	MusicIdleAction:          "discord_server.music_idle_action",
+	MusicKeepUnheard:         "discord_server.music_keep_unheard",
 }
 
 // Generated where
@@ -238,60 +283,113 @@ func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
 func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var DiscordServerWhere = struct {
-	GuildID                 whereHelperstring
-	GuildName               whereHelperstring
-	LinkRemovalEnabled      whereHelperbool
-	MusicEnabled            whereHelperbool
-	CustomCommandsEnabled   whereHelperbool
-	DiceRollEnabled         whereHelperbool
-	PrefixCommand           whereHelperstring
-	MusicTextChannelID      whereHelpernull_String
-	MusicVoiceChannelID     whereHelpernull_String
-	MusicVolume             whereHelperfloat32
-	AnnounceSongs           whereHelperbool
-	ThrottleCommandsEnabled whereHelperbool
-	ThrottleCommandsSeconds whereHelpernull_Int64
-	WelcomeMessageEnabled   whereHelperbool
-	WelcomeMessage          whereHelpernull_String
-	ModerationMuteEnabled   whereHelperbool
-	NotifyMeRoleEnabled     whereHelperbool
+	GuildID                  whereHelperstring
+	GuildName                whereHelperstring
+	LinkRemovalEnabled       whereHelperbool
+	MusicEnabled             whereHelperbool
+	CustomCommandsEnabled    whereHelperbool
+	DiceRollEnabled          whereHelperbool
+	PrefixCommand            whereHelperstring
+	MusicTextChannelID       whereHelpernull_String
+	MusicVoiceChannelID      whereHelpernull_String
+	MusicVolume              whereHelperfloat32
+	AnnounceSongs            whereHelperbool
+	ThrottleCommandsEnabled  whereHelperbool
+	ThrottleCommandsSeconds  whereHelpernull_Int64
+	WelcomeMessageEnabled    whereHelperbool
+	WelcomeMessage           whereHelpernull_String
+	ModerationMuteEnabled    whereHelperbool
+	NotifyMeRoleEnabled      whereHelperbool
+	VoteSkipThresholdPercent whereHelperint
+	MusicLoopMode            whereHelperstring
+	MusicAutoplayEnabled     whereHelperbool
+	MusicFairQueue           whereHelperbool
+	MusicFilter              whereHelperstring
+	MusicFollowRequester     whereHelperbool
+	MusicIdleSeconds         whereHelperint
+	MusicIdleAction          whereHelperstring
+	MusicKeepUnheard         whereHelperbool
 }{
-	GuildID:                 whereHelperstring{field: "\"discord_server\".\"guild_id\""},
-	GuildName:               whereHelperstring{field: "\"discord_server\".\"guild_name\""},
-	LinkRemovalEnabled:      whereHelperbool{field: "\"discord_server\".\"link_removal_enabled\""},
-	MusicEnabled:            whereHelperbool{field: "\"discord_server\".\"music_enabled\""},
-	CustomCommandsEnabled:   whereHelperbool{field: "\"discord_server\".\"custom_commands_enabled\""},
-	DiceRollEnabled:         whereHelperbool{field: "\"discord_server\".\"dice_roll_enabled\""},
-	PrefixCommand:           whereHelperstring{field: "\"discord_server\".\"prefix_command\""},
-	MusicTextChannelID:      whereHelpernull_String{field: "\"discord_server\".\"music_text_channel_id\""},
-	MusicVoiceChannelID:     whereHelpernull_String{field: "\"discord_server\".\"music_voice_channel_id\""},
-	MusicVolume:             whereHelperfloat32{field: "\"discord_server\".\"music_volume\""},
-	AnnounceSongs:           whereHelperbool{field: "\"discord_server\".\"announce_songs\""},
-	ThrottleCommandsEnabled: whereHelperbool{field: "\"discord_server\".\"throttle_commands_enabled\""},
-	ThrottleCommandsSeconds: whereHelpernull_Int64{field: "\"discord_server\".\"throttle_commands_seconds\""},
-	WelcomeMessageEnabled:   whereHelperbool{field: "\"discord_server\".\"welcome_message_enabled\""},
-	WelcomeMessage:          whereHelpernull_String{field: "\"discord_server\".\"welcome_message\""},
-	ModerationMuteEnabled:   whereHelperbool{field: "\"discord_server\".\"moderation_mute_enabled\""},
-	NotifyMeRoleEnabled:     whereHelperbool{field: "\"discord_server\".\"notify_me_role_enabled\""},
+	GuildID:                  whereHelperstring{field: "\"discord_server\".\"guild_id\""},
+	GuildName:                whereHelperstring{field: "\"discord_server\".\"guild_name\""},
+	LinkRemovalEnabled:       whereHelperbool{field: "\"discord_server\".\"link_removal_enabled\""},
+	MusicEnabled:             whereHelperbool{field: "\"discord_server\".\"music_enabled\""},
+	CustomCommandsEnabled:    whereHelperbool{field: "\"discord_server\".\"custom_commands_enabled\""},
+	DiceRollEnabled:          whereHelperbool{field: "\"discord_server\".\"dice_roll_enabled\""},
+	PrefixCommand:            whereHelperstring{field: "\"discord_server\".\"prefix_command\""},
+	MusicTextChannelID:       whereHelpernull_String{field: "\"discord_server\".\"music_text_channel_id\""},
+	MusicVoiceChannelID:      whereHelpernull_String{field: "\"discord_server\".\"music_voice_channel_id\""},
+	MusicVolume:              whereHelperfloat32{field: "\"discord_server\".\"music_volume\""},
+	AnnounceSongs:            whereHelperbool{field: "\"discord_server\".\"announce_songs\""},
+	ThrottleCommandsEnabled:  whereHelperbool{field: "\"discord_server\".\"throttle_commands_enabled\""},
+	ThrottleCommandsSeconds:  whereHelpernull_Int64{field: "\"discord_server\".\"throttle_commands_seconds\""},
+	WelcomeMessageEnabled:    whereHelperbool{field: "\"discord_server\".\"welcome_message_enabled\""},
+	WelcomeMessage:           whereHelpernull_String{field: "\"discord_server\".\"welcome_message\""},
+	ModerationMuteEnabled:    whereHelperbool{field: "\"discord_server\".\"moderation_mute_enabled\""},
+	NotifyMeRoleEnabled:      whereHelperbool{field: "\"discord_server\".\"notify_me_role_enabled\""},
+	VoteSkipThresholdPercent: whereHelperint{field: "\"discord_server\".\"vote_skip_threshold_percent\""},
+	MusicLoopMode:            whereHelperstring{field: "\"discord_server\".\"music_loop_mode\""},
+	MusicAutoplayEnabled:     whereHelperbool{field: "\"discord_server\".\"music_autoplay_enabled\""},
+	MusicFairQueue:           whereHelperbool{field: "\"discord_server\".\"music_fair_queue\""},
+	MusicFilter:              whereHelperstring{field: "\"discord_server\".\"music_filter\""},
+	MusicFollowRequester:     whereHelperbool{field: "\"discord_server\".\"music_follow_requester\""},
+	MusicIdleSeconds:         whereHelperint{field: "\"discord_server\".\"music_idle_seconds\""},
+	MusicIdleAction:          whereHelperstring{field: "\"discord_server\".\"music_idle_action\""},
+	MusicKeepUnheard:         whereHelperbool{field: "\"discord_server\".\"music_keep_unheard\""},
 }
 
 // DiscordServerRels is where relationship names are stored.
 var DiscordServerRels = struct {
-	GuildChatHistories  string
-	GuildCustomCommands string
-	GuildSongRequests   string
+	GuildMusicPolicy     string
+	GuildChatHistories   string
+	GuildCustomCommands  string
+	GuildMusicBlocklists string
+	GuildMusicDJGrants   string
+	GuildSavedPlaylists  string
+	GuildSongRequests    string
 }{
-	GuildChatHistories:  "GuildChatHistories",
-	GuildCustomCommands: "GuildCustomCommands",
-	GuildSongRequests:   "GuildSongRequests",
+	GuildMusicPolicy:     "GuildMusicPolicy",
+	GuildChatHistories:   "GuildChatHistories",
+	GuildCustomCommands:  "GuildCustomCommands",
+	GuildMusicBlocklists: "GuildMusicBlocklists",
+	GuildMusicDJGrants:   "GuildMusicDJGrants",
+	GuildSavedPlaylists:  "GuildSavedPlaylists",
+	GuildSongRequests:    "GuildSongRequests",
 }
 
 // discordServerR is where relationships are stored.
 type discordServerR struct {
-	GuildChatHistories  ChatHistorySlice   `boil:"GuildChatHistories" json:"GuildChatHistories" toml:"GuildChatHistories" yaml:"GuildChatHistories"`
-	GuildCustomCommands CustomCommandSlice `boil:"GuildCustomCommands" json:"GuildCustomCommands" toml:"GuildCustomCommands" yaml:"GuildCustomCommands"`
-	GuildSongRequests   SongRequestSlice   `boil:"GuildSongRequests" json:"GuildSongRequests" toml:"GuildSongRequests" yaml:"GuildSongRequests"`
+	GuildMusicPolicy     *MusicPolicy        `boil:"GuildMusicPolicy" json:"GuildMusicPolicy" toml:"GuildMusicPolicy" yaml:"GuildMusicPolicy"`
+	GuildChatHistories   ChatHistorySlice    `boil:"GuildChatHistories" json:"GuildChatHistories" toml:"GuildChatHistories" yaml:"GuildChatHistories"`
+	GuildCustomCommands  CustomCommandSlice  `boil:"GuildCustomCommands" json:"GuildCustomCommands" toml:"GuildCustomCommands" yaml:"GuildCustomCommands"`
+	GuildMusicBlocklists MusicBlocklistSlice `boil:"GuildMusicBlocklists" json:"GuildMusicBlocklists" toml:"GuildMusicBlocklists" yaml:"GuildMusicBlocklists"`
+	GuildMusicDJGrants   MusicDJGrantSlice   `boil:"GuildMusicDJGrants" json:"GuildMusicDJGrants" toml:"GuildMusicDJGrants" yaml:"GuildMusicDJGrants"`
+	GuildSavedPlaylists  SavedPlaylistSlice  `boil:"GuildSavedPlaylists" json:"GuildSavedPlaylists" toml:"GuildSavedPlaylists" yaml:"GuildSavedPlaylists"`
+	GuildSongRequests    SongRequestSlice    `boil:"GuildSongRequests" json:"GuildSongRequests" toml:"GuildSongRequests" yaml:"GuildSongRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -299,6 +397,13 @@ func (*discordServerR) NewStruct() *discordServerR {
 	return &discordServerR{}
 }
 
+func (r *discordServerR) GetGuildMusicPolicy() *MusicPolicy {
+	if r == nil {
+		return nil
+	}
+	return r.GuildMusicPolicy
+}
+
 func (r *discordServerR) GetGuildChatHistories() ChatHistorySlice {
 	if r == nil {
 		return nil
@@ -313,6 +418,27 @@ func (r *discordServerR) GetGuildCustomCommands() CustomCommandSlice {
 	return r.GuildCustomCommands
 }
 
+func (r *discordServerR) GetGuildMusicBlocklists() MusicBlocklistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GuildMusicBlocklists
+}
+
+func (r *discordServerR) GetGuildMusicDJGrants() MusicDJGrantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GuildMusicDJGrants
+}
+
+func (r *discordServerR) GetGuildSavedPlaylists() SavedPlaylistSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GuildSavedPlaylists
+}
+
 func (r *discordServerR) GetGuildSongRequests() SongRequestSlice {
 	if r == nil {
 		return nil
@@ -324,9 +450,9 @@ func (r *discordServerR) GetGuildSongRequests() SongRequestSlice {
 type discordServerL struct{}
 
 var (
-	discordServerAllColumns            = []string{"guild_id", "guild_name", "link_removal_enabled", "music_enabled", "custom_commands_enabled", "dice_roll_enabled", "prefix_command", "music_text_channel_id", "music_voice_channel_id", "music_volume", "announce_songs", "throttle_commands_enabled", "throttle_commands_seconds", "welcome_message_enabled", "welcome_message", "moderation_mute_enabled", "notify_me_role_enabled"}
+	discordServerAllColumns            = []string{"guild_id", "guild_name", "link_removal_enabled", "music_enabled", "custom_commands_enabled", "dice_roll_enabled", "prefix_command", "music_text_channel_id", "music_voice_channel_id", "music_volume", "announce_songs", "throttle_commands_enabled", "throttle_commands_seconds", "welcome_message_enabled", "welcome_message", "moderation_mute_enabled", "notify_me_role_enabled", "vote_skip_threshold_percent", "music_loop_mode", "music_autoplay_enabled", "music_fair_queue", "music_filter", "music_follow_requester", "music_idle_seconds", "music_idle_action", "music_keep_unheard"}
 	discordServerColumnsWithoutDefault = []string{"guild_id", "guild_name", "link_removal_enabled", "music_enabled", "custom_commands_enabled", "dice_roll_enabled", "announce_songs", "throttle_commands_enabled", "welcome_message_enabled"}
-	discordServerColumnsWithDefault    = []string{"prefix_command", "music_text_channel_id", "music_voice_channel_id", "music_volume", "throttle_commands_seconds", "welcome_message", "moderation_mute_enabled", "notify_me_role_enabled"}
+	discordServerColumnsWithDefault    = []string{"prefix_command", "music_text_channel_id", "music_voice_channel_id", "music_volume", "throttle_commands_seconds", "welcome_message", "moderation_mute_enabled", "notify_me_role_enabled", "vote_skip_threshold_percent", "music_loop_mode", "music_autoplay_enabled", "music_fair_queue", "music_filter", "music_follow_requester", "music_idle_seconds", "music_idle_action", "music_keep_unheard"}
 	discordServerPrimaryKeyColumns     = []string{"guild_id"}
 	discordServerGeneratedColumns      = []string{}
 )
@@ -609,6 +735,17 @@ func (q discordServerQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// GuildMusicPolicy pointed to by the foreign key.
+func (o *DiscordServer) GuildMusicPolicy(mods ...qm.QueryMod) musicPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"guild_id\" = ?", o.GuildID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return MusicPolicies(queryMods...)
+}
+
 // GuildChatHistories retrieves all the chat_history's ChatHistories with an executor via guild_id column.
 func (o *DiscordServer) GuildChatHistories(mods ...qm.QueryMod) chatHistoryQuery {
 	var queryMods []qm.QueryMod
@@ -637,6 +774,48 @@ func (o *DiscordServer) GuildCustomCommands(mods ...qm.QueryMod) customCommandQu
 	return CustomCommands(queryMods...)
 }
 
+// GuildMusicBlocklists retrieves all the music_blocklist's MusicBlocklists with an executor via guild_id column.
+func (o *DiscordServer) GuildMusicBlocklists(mods ...qm.QueryMod) musicBlocklistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"music_blocklist\".\"guild_id\"=?", o.GuildID),
+	)
+
+	return MusicBlocklists(queryMods...)
+}
+
+// GuildMusicDJGrants retrieves all the music_dj_grant's MusicDJGrants with an executor via guild_id column.
+func (o *DiscordServer) GuildMusicDJGrants(mods ...qm.QueryMod) musicDJGrantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"music_dj_grant\".\"guild_id\"=?", o.GuildID),
+	)
+
+	return MusicDJGrants(queryMods...)
+}
+
+// GuildSavedPlaylists retrieves all the saved_playlist's SavedPlaylists with an executor via guild_id column.
+func (o *DiscordServer) GuildSavedPlaylists(mods ...qm.QueryMod) savedPlaylistQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"saved_playlist\".\"guild_id\"=?", o.GuildID),
+	)
+
+	return SavedPlaylists(queryMods...)
+}
+
 // GuildSongRequests retrieves all the song_request's SongRequests with an executor via guild_id column.
 func (o *DiscordServer) GuildSongRequests(mods ...qm.QueryMod) songRequestQuery {
 	var queryMods []qm.QueryMod
@@ -651,6 +830,123 @@ func (o *DiscordServer) GuildSongRequests(mods ...qm.QueryMod) songRequestQuery
 	return SongRequests(queryMods...)
 }
 
+// LoadGuildMusicPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (discordServerL) LoadGuildMusicPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
+	var slice []*DiscordServer
+	var object *DiscordServer
+
+	if singular {
+		var ok bool
+		object, ok = maybeDiscordServer.(*DiscordServer)
+		if !ok {
+			object = new(DiscordServer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDiscordServer))
+			}
+		}
+	} else {
+		s, ok := maybeDiscordServer.(*[]*DiscordServer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDiscordServer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &discordServerR{}
+		}
+		args = append(args, object.GuildID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &discordServerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`music_policy`),
+		qm.WhereIn(`music_policy.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load MusicPolicy")
+	}
+
+	var resultSlice []*MusicPolicy
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice MusicPolicy")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for music_policy")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for music_policy")
+	}
+
+	if len(musicPolicyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.GuildMusicPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &musicPolicyR{}
+		}
+		foreign.R.Guild = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuildID == foreign.GuildID {
+				local.R.GuildMusicPolicy = foreign
+				if foreign.R == nil {
+					foreign.R = &musicPolicyR{}
+				}
+				foreign.R.Guild = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadGuildChatHistories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (discordServerL) LoadGuildChatHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
@@ -879,9 +1175,9 @@ func (discordServerL) LoadGuildCustomCommands(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadGuildSongRequests allows an eager lookup of values, cached into the
+// LoadGuildMusicBlocklists allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
+func (discordServerL) LoadGuildMusicBlocklists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
 	var slice []*DiscordServer
 	var object *DiscordServer
 
@@ -935,8 +1231,8 @@ func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextE
 	}
 
 	query := NewQuery(
-		qm.From(`song_request`),
-		qm.WhereIn(`song_request.guild_id in ?`, args...),
+		qm.From(`music_blocklist`),
+		qm.WhereIn(`music_blocklist.guild_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -944,22 +1240,22 @@ func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextE
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load song_request")
+		return errors.Wrap(err, "failed to eager load music_blocklist")
 	}
 
-	var resultSlice []*SongRequest
+	var resultSlice []*MusicBlocklist
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice song_request")
+		return errors.Wrap(err, "failed to bind eager loaded slice music_blocklist")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on song_request")
+		return errors.Wrap(err, "failed to close results in eager load on music_blocklist")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for song_request")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for music_blocklist")
 	}
 
-	if len(songRequestAfterSelectHooks) != 0 {
+	if len(musicBlocklistAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -967,10 +1263,10 @@ func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextE
 		}
 	}
 	if singular {
-		object.R.GuildSongRequests = resultSlice
+		object.R.GuildMusicBlocklists = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &songRequestR{}
+				foreign.R = &musicBlocklistR{}
 			}
 			foreign.R.Guild = object
 		}
@@ -980,9 +1276,9 @@ func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextE
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.GuildID == foreign.GuildID {
-				local.R.GuildSongRequests = append(local.R.GuildSongRequests, foreign)
+				local.R.GuildMusicBlocklists = append(local.R.GuildMusicBlocklists, foreign)
 				if foreign.R == nil {
-					foreign.R = &songRequestR{}
+					foreign.R = &musicBlocklistR{}
 				}
 				foreign.R.Guild = local
 				break
@@ -993,58 +1289,450 @@ func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextE
 	return nil
 }
 
-// AddGuildChatHistories adds the given related objects to the existing relationships
-// of the discord_server, optionally inserting them as new records.
-// Appends related to o.R.GuildChatHistories.
-// Sets related.R.Guild appropriately.
-func (o *DiscordServer) AddGuildChatHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatHistory) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.GuildID = o.GuildID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"chat_history\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
-				strmangle.WhereClause("\"", "\"", 2, chatHistoryPrimaryKeyColumns),
-			)
-			values := []interface{}{o.GuildID, rel.ID}
+// LoadGuildMusicDJGrants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (discordServerL) LoadGuildMusicDJGrants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
+	var slice []*DiscordServer
+	var object *DiscordServer
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeDiscordServer.(*DiscordServer)
+		if !ok {
+			object = new(DiscordServer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDiscordServer))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeDiscordServer.(*[]*DiscordServer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDiscordServer))
 			}
-
-			rel.GuildID = o.GuildID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &discordServerR{
-			GuildChatHistories: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &discordServerR{}
 		}
+		args = append(args, object.GuildID)
 	} else {
-		o.R.GuildChatHistories = append(o.R.GuildChatHistories, related...)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &discordServerR{}
+			}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &chatHistoryR{
-				Guild: o,
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
 			}
-		} else {
-			rel.R.Guild = o
+
+			args = append(args, obj.GuildID)
 		}
 	}
-	return nil
-}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`music_dj_grant`),
+		qm.WhereIn(`music_dj_grant.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load music_dj_grant")
+	}
+
+	var resultSlice []*MusicDJGrant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice music_dj_grant")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on music_dj_grant")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for music_dj_grant")
+	}
+
+	if len(musicDJGrantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GuildMusicDJGrants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &musicDJGrantR{}
+			}
+			foreign.R.Guild = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.GuildID == foreign.GuildID {
+				local.R.GuildMusicDJGrants = append(local.R.GuildMusicDJGrants, foreign)
+				if foreign.R == nil {
+					foreign.R = &musicDJGrantR{}
+				}
+				foreign.R.Guild = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGuildSavedPlaylists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (discordServerL) LoadGuildSavedPlaylists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
+	var slice []*DiscordServer
+	var object *DiscordServer
+
+	if singular {
+		var ok bool
+		object, ok = maybeDiscordServer.(*DiscordServer)
+		if !ok {
+			object = new(DiscordServer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDiscordServer))
+			}
+		}
+	} else {
+		s, ok := maybeDiscordServer.(*[]*DiscordServer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDiscordServer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &discordServerR{}
+		}
+		args = append(args, object.GuildID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &discordServerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`saved_playlist`),
+		qm.WhereIn(`saved_playlist.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load saved_playlist")
+	}
+
+	var resultSlice []*SavedPlaylist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice saved_playlist")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on saved_playlist")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saved_playlist")
+	}
+
+	if len(savedPlaylistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GuildSavedPlaylists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &savedPlaylistR{}
+			}
+			foreign.R.Guild = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.GuildID == foreign.GuildID {
+				local.R.GuildSavedPlaylists = append(local.R.GuildSavedPlaylists, foreign)
+				if foreign.R == nil {
+					foreign.R = &savedPlaylistR{}
+				}
+				foreign.R.Guild = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGuildSongRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (discordServerL) LoadGuildSongRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDiscordServer interface{}, mods queries.Applicator) error {
+	var slice []*DiscordServer
+	var object *DiscordServer
+
+	if singular {
+		var ok bool
+		object, ok = maybeDiscordServer.(*DiscordServer)
+		if !ok {
+			object = new(DiscordServer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDiscordServer))
+			}
+		}
+	} else {
+		s, ok := maybeDiscordServer.(*[]*DiscordServer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDiscordServer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDiscordServer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &discordServerR{}
+		}
+		args = append(args, object.GuildID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &discordServerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`song_request`),
+		qm.WhereIn(`song_request.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load song_request")
+	}
+
+	var resultSlice []*SongRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice song_request")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on song_request")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for song_request")
+	}
+
+	if len(songRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GuildSongRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &songRequestR{}
+			}
+			foreign.R.Guild = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.GuildID == foreign.GuildID {
+				local.R.GuildSongRequests = append(local.R.GuildSongRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &songRequestR{}
+				}
+				foreign.R.Guild = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuildMusicPolicy of the discordServer to the related item.
+// Sets o.R.GuildMusicPolicy to related.
+// Adds o to related.R.Guild.
+func (o *DiscordServer) SetGuildMusicPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *MusicPolicy) error {
+	var err error
+
+	if insert {
+		related.GuildID = o.GuildID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"music_policy\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+			strmangle.WhereClause("\"", "\"", 2, musicPolicyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.GuildID, related.GuildID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.GuildID = o.GuildID
+	}
+
+	if o.R == nil {
+		o.R = &discordServerR{
+			GuildMusicPolicy: related,
+		}
+	} else {
+		o.R.GuildMusicPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &musicPolicyR{
+			Guild: o,
+		}
+	} else {
+		related.R.Guild = o
+	}
+	return nil
+}
+
+// AddGuildChatHistories adds the given related objects to the existing relationships
+// of the discord_server, optionally inserting them as new records.
+// Appends related to o.R.GuildChatHistories.
+// Sets related.R.Guild appropriately.
+func (o *DiscordServer) AddGuildChatHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GuildID = o.GuildID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.GuildID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GuildID = o.GuildID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &discordServerR{
+			GuildChatHistories: related,
+		}
+	} else {
+		o.R.GuildChatHistories = append(o.R.GuildChatHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatHistoryR{
+				Guild: o,
+			}
+		} else {
+			rel.R.Guild = o
+		}
+	}
+	return nil
+}
 
 // AddGuildCustomCommands adds the given related objects to the existing relationships
 // of the discord_server, optionally inserting them as new records.
@@ -1099,6 +1787,165 @@ func (o *DiscordServer) AddGuildCustomCommands(ctx context.Context, exec boil.Co
 	return nil
 }
 
+// AddGuildMusicBlocklists adds the given related objects to the existing relationships
+// of the discord_server, optionally inserting them as new records.
+// Appends related to o.R.GuildMusicBlocklists.
+// Sets related.R.Guild appropriately.
+func (o *DiscordServer) AddGuildMusicBlocklists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MusicBlocklist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GuildID = o.GuildID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"music_blocklist\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+				strmangle.WhereClause("\"", "\"", 2, musicBlocklistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.GuildID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GuildID = o.GuildID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &discordServerR{
+			GuildMusicBlocklists: related,
+		}
+	} else {
+		o.R.GuildMusicBlocklists = append(o.R.GuildMusicBlocklists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &musicBlocklistR{
+				Guild: o,
+			}
+		} else {
+			rel.R.Guild = o
+		}
+	}
+	return nil
+}
+
+// AddGuildMusicDJGrants adds the given related objects to the existing relationships
+// of the discord_server, optionally inserting them as new records.
+// Appends related to o.R.GuildMusicDJGrants.
+// Sets related.R.Guild appropriately.
+func (o *DiscordServer) AddGuildMusicDJGrants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MusicDJGrant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GuildID = o.GuildID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"music_dj_grant\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+				strmangle.WhereClause("\"", "\"", 2, musicDJGrantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.GuildID, rel.GuildID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GuildID = o.GuildID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &discordServerR{
+			GuildMusicDJGrants: related,
+		}
+	} else {
+		o.R.GuildMusicDJGrants = append(o.R.GuildMusicDJGrants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &musicDJGrantR{
+				Guild: o,
+			}
+		} else {
+			rel.R.Guild = o
+		}
+	}
+	return nil
+}
+
+// AddGuildSavedPlaylists adds the given related objects to the existing relationships
+// of the discord_server, optionally inserting them as new records.
+// Appends related to o.R.GuildSavedPlaylists.
+// Sets related.R.Guild appropriately.
+func (o *DiscordServer) AddGuildSavedPlaylists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SavedPlaylist) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GuildID = o.GuildID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"saved_playlist\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+				strmangle.WhereClause("\"", "\"", 2, savedPlaylistPrimaryKeyColumns),
+			)
+			values := []interface{}{o.GuildID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GuildID = o.GuildID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &discordServerR{
+			GuildSavedPlaylists: related,
+		}
+	} else {
+		o.R.GuildSavedPlaylists = append(o.R.GuildSavedPlaylists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &savedPlaylistR{
+				Guild: o,
+			}
+		} else {
+			rel.R.Guild = o
+		}
+	}
+	return nil
+}
+
 // AddGuildSongRequests adds the given related objects to the existing relationships
 // of the discord_server, optionally inserting them as new records.
 // Appends related to o.R.GuildSongRequests.
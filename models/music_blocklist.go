@@ -0,0 +1,1134 @@
+// Code generated by SQLBoiler 4.14.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MusicBlocklist is an object representing the database table.
+type MusicBlocklist struct {
+	ID            int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID       string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	EntryType     string      `boil:"entry_type" json:"entry_type" toml:"entry_type" yaml:"entry_type"`
+	Value         string      `boil:"value" json:"value" toml:"value" yaml:"value"`
+	Label         null.String `boil:"label" json:"label,omitempty" toml:"label" yaml:"label,omitempty"`
+	AddedByUserID string      `boil:"added_by_user_id" json:"added_by_user_id" toml:"added_by_user_id" yaml:"added_by_user_id"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *musicBlocklistR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L musicBlocklistL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MusicBlocklistColumns = struct {
+	ID            string
+	GuildID       string
+	EntryType     string
+	Value         string
+	Label         string
+	AddedByUserID string
+	CreatedAt     string
+}{
+	ID:            "id",
+	GuildID:       "guild_id",
+	EntryType:     "entry_type",
+	Value:         "value",
+	Label:         "label",
+	AddedByUserID: "added_by_user_id",
+	CreatedAt:     "created_at",
+}
+
+var MusicBlocklistTableColumns = struct {
+	ID            string
+	GuildID       string
+	EntryType     string
+	Value         string
+	Label         string
+	AddedByUserID string
+	CreatedAt     string
+}{
+	ID:            "music_blocklist.id",
+	GuildID:       "music_blocklist.guild_id",
+	EntryType:     "music_blocklist.entry_type",
+	Value:         "music_blocklist.value",
+	Label:         "music_blocklist.label",
+	AddedByUserID: "music_blocklist.added_by_user_id",
+	CreatedAt:     "music_blocklist.created_at",
+}
+
+// Generated where
+
+var MusicBlocklistWhere = struct {
+	ID            whereHelperint64
+	GuildID       whereHelperstring
+	EntryType     whereHelperstring
+	Value         whereHelperstring
+	Label         whereHelpernull_String
+	AddedByUserID whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint64{field: "\"music_blocklist\".\"id\""},
+	GuildID:       whereHelperstring{field: "\"music_blocklist\".\"guild_id\""},
+	EntryType:     whereHelperstring{field: "\"music_blocklist\".\"entry_type\""},
+	Value:         whereHelperstring{field: "\"music_blocklist\".\"value\""},
+	Label:         whereHelpernull_String{field: "\"music_blocklist\".\"label\""},
+	AddedByUserID: whereHelperstring{field: "\"music_blocklist\".\"added_by_user_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"music_blocklist\".\"created_at\""},
+}
+
+// MusicBlocklistRels is where relationship names are stored.
+var MusicBlocklistRels = struct {
+	Guild string
+}{
+	Guild: "Guild",
+}
+
+// musicBlocklistR is where relationships are stored.
+type musicBlocklistR struct {
+	Guild *DiscordServer `boil:"Guild" json:"Guild" toml:"Guild" yaml:"Guild"`
+}
+
+// NewStruct creates a new relationship struct
+func (*musicBlocklistR) NewStruct() *musicBlocklistR {
+	return &musicBlocklistR{}
+}
+
+func (r *musicBlocklistR) GetGuild() *DiscordServer {
+	if r == nil {
+		return nil
+	}
+	return r.Guild
+}
+
+// musicBlocklistL is where Load methods for each relationship are stored.
+type musicBlocklistL struct{}
+
+var (
+	musicBlocklistAllColumns            = []string{"id", "guild_id", "entry_type", "value", "label", "added_by_user_id", "created_at"}
+	musicBlocklistColumnsWithoutDefault = []string{"guild_id", "entry_type", "value", "added_by_user_id"}
+	musicBlocklistColumnsWithDefault    = []string{"id", "label", "created_at"}
+	musicBlocklistPrimaryKeyColumns     = []string{"id"}
+	musicBlocklistGeneratedColumns      = []string{}
+)
+
+type (
+	// MusicBlocklistSlice is an alias for a slice of pointers to MusicBlocklist.
+	// This should almost always be used instead of []MusicBlocklist.
+	MusicBlocklistSlice []*MusicBlocklist
+	// MusicBlocklistHook is the signature for custom MusicBlocklist hook methods
+	MusicBlocklistHook func(context.Context, boil.ContextExecutor, *MusicBlocklist) error
+
+	musicBlocklistQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	musicBlocklistType                 = reflect.TypeOf(&MusicBlocklist{})
+	musicBlocklistMapping              = queries.MakeStructMapping(musicBlocklistType)
+	musicBlocklistPrimaryKeyMapping, _ = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, musicBlocklistPrimaryKeyColumns)
+	musicBlocklistInsertCacheMut       sync.RWMutex
+	musicBlocklistInsertCache          = make(map[string]insertCache)
+	musicBlocklistUpdateCacheMut       sync.RWMutex
+	musicBlocklistUpdateCache          = make(map[string]updateCache)
+	musicBlocklistUpsertCacheMut       sync.RWMutex
+	musicBlocklistUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var musicBlocklistAfterSelectHooks []MusicBlocklistHook
+
+var musicBlocklistBeforeInsertHooks []MusicBlocklistHook
+var musicBlocklistAfterInsertHooks []MusicBlocklistHook
+
+var musicBlocklistBeforeUpdateHooks []MusicBlocklistHook
+var musicBlocklistAfterUpdateHooks []MusicBlocklistHook
+
+var musicBlocklistBeforeDeleteHooks []MusicBlocklistHook
+var musicBlocklistAfterDeleteHooks []MusicBlocklistHook
+
+var musicBlocklistBeforeUpsertHooks []MusicBlocklistHook
+var musicBlocklistAfterUpsertHooks []MusicBlocklistHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MusicBlocklist) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MusicBlocklist) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MusicBlocklist) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MusicBlocklist) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MusicBlocklist) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MusicBlocklist) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MusicBlocklist) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MusicBlocklist) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MusicBlocklist) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicBlocklistAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMusicBlocklistHook registers your hook function for all future operations.
+func AddMusicBlocklistHook(hookPoint boil.HookPoint, musicBlocklistHook MusicBlocklistHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		musicBlocklistAfterSelectHooks = append(musicBlocklistAfterSelectHooks, musicBlocklistHook)
+	case boil.BeforeInsertHook:
+		musicBlocklistBeforeInsertHooks = append(musicBlocklistBeforeInsertHooks, musicBlocklistHook)
+	case boil.AfterInsertHook:
+		musicBlocklistAfterInsertHooks = append(musicBlocklistAfterInsertHooks, musicBlocklistHook)
+	case boil.BeforeUpdateHook:
+		musicBlocklistBeforeUpdateHooks = append(musicBlocklistBeforeUpdateHooks, musicBlocklistHook)
+	case boil.AfterUpdateHook:
+		musicBlocklistAfterUpdateHooks = append(musicBlocklistAfterUpdateHooks, musicBlocklistHook)
+	case boil.BeforeDeleteHook:
+		musicBlocklistBeforeDeleteHooks = append(musicBlocklistBeforeDeleteHooks, musicBlocklistHook)
+	case boil.AfterDeleteHook:
+		musicBlocklistAfterDeleteHooks = append(musicBlocklistAfterDeleteHooks, musicBlocklistHook)
+	case boil.BeforeUpsertHook:
+		musicBlocklistBeforeUpsertHooks = append(musicBlocklistBeforeUpsertHooks, musicBlocklistHook)
+	case boil.AfterUpsertHook:
+		musicBlocklistAfterUpsertHooks = append(musicBlocklistAfterUpsertHooks, musicBlocklistHook)
+	}
+}
+
+// One returns a single musicBlocklist record from the query.
+func (q musicBlocklistQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MusicBlocklist, error) {
+	o := &MusicBlocklist{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for music_blocklist")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MusicBlocklist records from the query.
+func (q musicBlocklistQuery) All(ctx context.Context, exec boil.ContextExecutor) (MusicBlocklistSlice, error) {
+	var o []*MusicBlocklist
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MusicBlocklist slice")
+	}
+
+	if len(musicBlocklistAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MusicBlocklist records in the query.
+func (q musicBlocklistQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count music_blocklist rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q musicBlocklistQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if music_blocklist exists")
+	}
+
+	return count > 0, nil
+}
+
+// Guild pointed to by the foreign key.
+func (o *MusicBlocklist) Guild(mods ...qm.QueryMod) discordServerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"guild_id\" = ?", o.GuildID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DiscordServers(queryMods...)
+}
+
+// LoadGuild allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (musicBlocklistL) LoadGuild(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMusicBlocklist interface{}, mods queries.Applicator) error {
+	var slice []*MusicBlocklist
+	var object *MusicBlocklist
+
+	if singular {
+		var ok bool
+		object, ok = maybeMusicBlocklist.(*MusicBlocklist)
+		if !ok {
+			object = new(MusicBlocklist)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMusicBlocklist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMusicBlocklist))
+			}
+		}
+	} else {
+		s, ok := maybeMusicBlocklist.(*[]*MusicBlocklist)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMusicBlocklist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMusicBlocklist))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &musicBlocklistR{}
+		}
+		args = append(args, object.GuildID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &musicBlocklistR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`discord_server`),
+		qm.WhereIn(`discord_server.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DiscordServer")
+	}
+
+	var resultSlice []*DiscordServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DiscordServer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for discord_server")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for discord_server")
+	}
+
+	if len(discordServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Guild = foreign
+		if foreign.R == nil {
+			foreign.R = &discordServerR{}
+		}
+		foreign.R.GuildMusicBlocklists = append(foreign.R.GuildMusicBlocklists, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuildID == foreign.GuildID {
+				local.R.Guild = foreign
+				if foreign.R == nil {
+					foreign.R = &discordServerR{}
+				}
+				foreign.R.GuildMusicBlocklists = append(foreign.R.GuildMusicBlocklists, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuild of the musicBlocklist to the related item.
+// Sets o.R.Guild to related.
+// Adds o to related.R.GuildMusicBlocklists.
+func (o *MusicBlocklist) SetGuild(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DiscordServer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"music_blocklist\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+		strmangle.WhereClause("\"", "\"", 2, musicBlocklistPrimaryKeyColumns),
+	)
+	values := []interface{}{related.GuildID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GuildID = related.GuildID
+	if o.R == nil {
+		o.R = &musicBlocklistR{
+			Guild: related,
+		}
+	} else {
+		o.R.Guild = related
+	}
+
+	if related.R == nil {
+		related.R = &discordServerR{
+			GuildMusicBlocklists: MusicBlocklistSlice{o},
+		}
+	} else {
+		related.R.GuildMusicBlocklists = append(related.R.GuildMusicBlocklists, o)
+	}
+
+	return nil
+}
+
+// MusicBlocklists retrieves all the records using an executor.
+func MusicBlocklists(mods ...qm.QueryMod) musicBlocklistQuery {
+	mods = append(mods, qm.From("\"music_blocklist\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"music_blocklist\".*"})
+	}
+
+	return musicBlocklistQuery{q}
+}
+
+// FindMusicBlocklist retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMusicBlocklist(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*MusicBlocklist, error) {
+	musicBlocklistObj := &MusicBlocklist{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"music_blocklist\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, musicBlocklistObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from music_blocklist")
+	}
+
+	if err = musicBlocklistObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return musicBlocklistObj, err
+	}
+
+	return musicBlocklistObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MusicBlocklist) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_blocklist provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicBlocklistColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	musicBlocklistInsertCacheMut.RLock()
+	cache, cached := musicBlocklistInsertCache[key]
+	musicBlocklistInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			musicBlocklistAllColumns,
+			musicBlocklistColumnsWithDefault,
+			musicBlocklistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"music_blocklist\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"music_blocklist\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into music_blocklist")
+	}
+
+	if !cached {
+		musicBlocklistInsertCacheMut.Lock()
+		musicBlocklistInsertCache[key] = cache
+		musicBlocklistInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MusicBlocklist.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MusicBlocklist) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	musicBlocklistUpdateCacheMut.RLock()
+	cache, cached := musicBlocklistUpdateCache[key]
+	musicBlocklistUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			musicBlocklistAllColumns,
+			musicBlocklistPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update music_blocklist, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"music_blocklist\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, musicBlocklistPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, append(wl, musicBlocklistPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update music_blocklist row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for music_blocklist")
+	}
+
+	if !cached {
+		musicBlocklistUpdateCacheMut.Lock()
+		musicBlocklistUpdateCache[key] = cache
+		musicBlocklistUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q musicBlocklistQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for music_blocklist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for music_blocklist")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MusicBlocklistSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicBlocklistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"music_blocklist\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, musicBlocklistPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in musicBlocklist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all musicBlocklist")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MusicBlocklist) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_blocklist provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicBlocklistColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	musicBlocklistUpsertCacheMut.RLock()
+	cache, cached := musicBlocklistUpsertCache[key]
+	musicBlocklistUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			musicBlocklistAllColumns,
+			musicBlocklistColumnsWithDefault,
+			musicBlocklistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			musicBlocklistAllColumns,
+			musicBlocklistPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert music_blocklist, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(musicBlocklistPrimaryKeyColumns))
+			copy(conflict, musicBlocklistPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"music_blocklist\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(musicBlocklistType, musicBlocklistMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert music_blocklist")
+	}
+
+	if !cached {
+		musicBlocklistUpsertCacheMut.Lock()
+		musicBlocklistUpsertCache[key] = cache
+		musicBlocklistUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MusicBlocklist record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MusicBlocklist) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MusicBlocklist provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), musicBlocklistPrimaryKeyMapping)
+	sql := "DELETE FROM \"music_blocklist\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from music_blocklist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for music_blocklist")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q musicBlocklistQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no musicBlocklistQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from music_blocklist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_blocklist")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MusicBlocklistSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(musicBlocklistBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicBlocklistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"music_blocklist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicBlocklistPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from musicBlocklist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_blocklist")
+	}
+
+	if len(musicBlocklistAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MusicBlocklist) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMusicBlocklist(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MusicBlocklistSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MusicBlocklistSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicBlocklistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"music_blocklist\".* FROM \"music_blocklist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicBlocklistPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MusicBlocklistSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MusicBlocklistExists checks if the MusicBlocklist row exists.
+func MusicBlocklistExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"music_blocklist\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if music_blocklist exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MusicBlocklist row exists.
+func (o *MusicBlocklist) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MusicBlocklistExists(ctx, exec, o.ID)
+}
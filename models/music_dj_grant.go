@@ -0,0 +1,1119 @@
+// Code generated by SQLBoiler 4.14.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MusicDJGrant is an object representing the database table.
+type MusicDJGrant struct {
+	GuildID         string    `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	UserID          string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	GrantedByUserID string    `boil:"granted_by_user_id" json:"granted_by_user_id" toml:"granted_by_user_id" yaml:"granted_by_user_id"`
+	ExpiresAt       time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *musicDJGrantR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L musicDJGrantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MusicDJGrantColumns = struct {
+	GuildID         string
+	UserID          string
+	GrantedByUserID string
+	ExpiresAt       string
+	CreatedAt       string
+}{
+	GuildID:         "guild_id",
+	UserID:          "user_id",
+	GrantedByUserID: "granted_by_user_id",
+	ExpiresAt:       "expires_at",
+	CreatedAt:       "created_at",
+}
+
+var MusicDJGrantTableColumns = struct {
+	GuildID         string
+	UserID          string
+	GrantedByUserID string
+	ExpiresAt       string
+	CreatedAt       string
+}{
+	GuildID:         "music_dj_grant.guild_id",
+	UserID:          "music_dj_grant.user_id",
+	GrantedByUserID: "music_dj_grant.granted_by_user_id",
+	ExpiresAt:       "music_dj_grant.expires_at",
+	CreatedAt:       "music_dj_grant.created_at",
+}
+
+// Generated where
+
+var MusicDJGrantWhere = struct {
+	GuildID         whereHelperstring
+	UserID          whereHelperstring
+	GrantedByUserID whereHelperstring
+	ExpiresAt       whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+}{
+	GuildID:         whereHelperstring{field: "\"music_dj_grant\".\"guild_id\""},
+	UserID:          whereHelperstring{field: "\"music_dj_grant\".\"user_id\""},
+	GrantedByUserID: whereHelperstring{field: "\"music_dj_grant\".\"granted_by_user_id\""},
+	ExpiresAt:       whereHelpertime_Time{field: "\"music_dj_grant\".\"expires_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"music_dj_grant\".\"created_at\""},
+}
+
+// MusicDJGrantRels is where relationship names are stored.
+var MusicDJGrantRels = struct {
+	Guild string
+}{
+	Guild: "Guild",
+}
+
+// musicDJGrantR is where relationships are stored.
+type musicDJGrantR struct {
+	Guild *DiscordServer `boil:"Guild" json:"Guild" toml:"Guild" yaml:"Guild"`
+}
+
+// NewStruct creates a new relationship struct
+func (*musicDJGrantR) NewStruct() *musicDJGrantR {
+	return &musicDJGrantR{}
+}
+
+func (r *musicDJGrantR) GetGuild() *DiscordServer {
+	if r == nil {
+		return nil
+	}
+	return r.Guild
+}
+
+// musicDJGrantL is where Load methods for each relationship are stored.
+type musicDJGrantL struct{}
+
+var (
+	musicDJGrantAllColumns            = []string{"guild_id", "user_id", "granted_by_user_id", "expires_at", "created_at"}
+	musicDJGrantColumnsWithoutDefault = []string{"guild_id", "user_id", "granted_by_user_id", "expires_at"}
+	musicDJGrantColumnsWithDefault    = []string{"created_at"}
+	musicDJGrantPrimaryKeyColumns     = []string{"guild_id", "user_id"}
+	musicDJGrantGeneratedColumns      = []string{}
+)
+
+type (
+	// MusicDJGrantSlice is an alias for a slice of pointers to MusicDJGrant.
+	// This should almost always be used instead of []MusicDJGrant.
+	MusicDJGrantSlice []*MusicDJGrant
+	// MusicDJGrantHook is the signature for custom MusicDJGrant hook methods
+	MusicDJGrantHook func(context.Context, boil.ContextExecutor, *MusicDJGrant) error
+
+	musicDJGrantQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	musicDJGrantType                 = reflect.TypeOf(&MusicDJGrant{})
+	musicDJGrantMapping              = queries.MakeStructMapping(musicDJGrantType)
+	musicDJGrantPrimaryKeyMapping, _ = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, musicDJGrantPrimaryKeyColumns)
+	musicDJGrantInsertCacheMut       sync.RWMutex
+	musicDJGrantInsertCache          = make(map[string]insertCache)
+	musicDJGrantUpdateCacheMut       sync.RWMutex
+	musicDJGrantUpdateCache          = make(map[string]updateCache)
+	musicDJGrantUpsertCacheMut       sync.RWMutex
+	musicDJGrantUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var musicDJGrantAfterSelectHooks []MusicDJGrantHook
+
+var musicDJGrantBeforeInsertHooks []MusicDJGrantHook
+var musicDJGrantAfterInsertHooks []MusicDJGrantHook
+
+var musicDJGrantBeforeUpdateHooks []MusicDJGrantHook
+var musicDJGrantAfterUpdateHooks []MusicDJGrantHook
+
+var musicDJGrantBeforeDeleteHooks []MusicDJGrantHook
+var musicDJGrantAfterDeleteHooks []MusicDJGrantHook
+
+var musicDJGrantBeforeUpsertHooks []MusicDJGrantHook
+var musicDJGrantAfterUpsertHooks []MusicDJGrantHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MusicDJGrant) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MusicDJGrant) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MusicDJGrant) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MusicDJGrant) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MusicDJGrant) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MusicDJGrant) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MusicDJGrant) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MusicDJGrant) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MusicDJGrant) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicDJGrantAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMusicDJGrantHook registers your hook function for all future operations.
+func AddMusicDJGrantHook(hookPoint boil.HookPoint, musicDJGrantHook MusicDJGrantHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		musicDJGrantAfterSelectHooks = append(musicDJGrantAfterSelectHooks, musicDJGrantHook)
+	case boil.BeforeInsertHook:
+		musicDJGrantBeforeInsertHooks = append(musicDJGrantBeforeInsertHooks, musicDJGrantHook)
+	case boil.AfterInsertHook:
+		musicDJGrantAfterInsertHooks = append(musicDJGrantAfterInsertHooks, musicDJGrantHook)
+	case boil.BeforeUpdateHook:
+		musicDJGrantBeforeUpdateHooks = append(musicDJGrantBeforeUpdateHooks, musicDJGrantHook)
+	case boil.AfterUpdateHook:
+		musicDJGrantAfterUpdateHooks = append(musicDJGrantAfterUpdateHooks, musicDJGrantHook)
+	case boil.BeforeDeleteHook:
+		musicDJGrantBeforeDeleteHooks = append(musicDJGrantBeforeDeleteHooks, musicDJGrantHook)
+	case boil.AfterDeleteHook:
+		musicDJGrantAfterDeleteHooks = append(musicDJGrantAfterDeleteHooks, musicDJGrantHook)
+	case boil.BeforeUpsertHook:
+		musicDJGrantBeforeUpsertHooks = append(musicDJGrantBeforeUpsertHooks, musicDJGrantHook)
+	case boil.AfterUpsertHook:
+		musicDJGrantAfterUpsertHooks = append(musicDJGrantAfterUpsertHooks, musicDJGrantHook)
+	}
+}
+
+// One returns a single musicDJGrant record from the query.
+func (q musicDJGrantQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MusicDJGrant, error) {
+	o := &MusicDJGrant{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for music_dj_grant")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MusicDJGrant records from the query.
+func (q musicDJGrantQuery) All(ctx context.Context, exec boil.ContextExecutor) (MusicDJGrantSlice, error) {
+	var o []*MusicDJGrant
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MusicDJGrant slice")
+	}
+
+	if len(musicDJGrantAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MusicDJGrant records in the query.
+func (q musicDJGrantQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count music_dj_grant rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q musicDJGrantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if music_dj_grant exists")
+	}
+
+	return count > 0, nil
+}
+
+// Guild pointed to by the foreign key.
+func (o *MusicDJGrant) Guild(mods ...qm.QueryMod) discordServerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"guild_id\" = ?", o.GuildID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DiscordServers(queryMods...)
+}
+
+// LoadGuild allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (musicDJGrantL) LoadGuild(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMusicDJGrant interface{}, mods queries.Applicator) error {
+	var slice []*MusicDJGrant
+	var object *MusicDJGrant
+
+	if singular {
+		var ok bool
+		object, ok = maybeMusicDJGrant.(*MusicDJGrant)
+		if !ok {
+			object = new(MusicDJGrant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMusicDJGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMusicDJGrant))
+			}
+		}
+	} else {
+		s, ok := maybeMusicDJGrant.(*[]*MusicDJGrant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMusicDJGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMusicDJGrant))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &musicDJGrantR{}
+		}
+		args = append(args, object.GuildID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &musicDJGrantR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`discord_server`),
+		qm.WhereIn(`discord_server.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DiscordServer")
+	}
+
+	var resultSlice []*DiscordServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DiscordServer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for discord_server")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for discord_server")
+	}
+
+	if len(discordServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Guild = foreign
+		if foreign.R == nil {
+			foreign.R = &discordServerR{}
+		}
+		foreign.R.GuildMusicDJGrants = append(foreign.R.GuildMusicDJGrants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuildID == foreign.GuildID {
+				local.R.Guild = foreign
+				if foreign.R == nil {
+					foreign.R = &discordServerR{}
+				}
+				foreign.R.GuildMusicDJGrants = append(foreign.R.GuildMusicDJGrants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuild of the musicDJGrant to the related item.
+// Sets o.R.Guild to related.
+// Adds o to related.R.GuildMusicDJGrants.
+func (o *MusicDJGrant) SetGuild(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DiscordServer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"music_dj_grant\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+		strmangle.WhereClause("\"", "\"", 2, musicDJGrantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.GuildID, o.GuildID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GuildID = related.GuildID
+	if o.R == nil {
+		o.R = &musicDJGrantR{
+			Guild: related,
+		}
+	} else {
+		o.R.Guild = related
+	}
+
+	if related.R == nil {
+		related.R = &discordServerR{
+			GuildMusicDJGrants: MusicDJGrantSlice{o},
+		}
+	} else {
+		related.R.GuildMusicDJGrants = append(related.R.GuildMusicDJGrants, o)
+	}
+
+	return nil
+}
+
+// MusicDJGrants retrieves all the records using an executor.
+func MusicDJGrants(mods ...qm.QueryMod) musicDJGrantQuery {
+	mods = append(mods, qm.From("\"music_dj_grant\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"music_dj_grant\".*"})
+	}
+
+	return musicDJGrantQuery{q}
+}
+
+// FindMusicDJGrant retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMusicDJGrant(ctx context.Context, exec boil.ContextExecutor, guildID string, userID string, selectCols ...string) (*MusicDJGrant, error) {
+	musicDJGrantObj := &MusicDJGrant{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"music_dj_grant\" where \"guild_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, guildID, userID)
+
+	err := q.Bind(ctx, exec, musicDJGrantObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from music_dj_grant")
+	}
+
+	if err = musicDJGrantObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return musicDJGrantObj, err
+	}
+
+	return musicDJGrantObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MusicDJGrant) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_dj_grant provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicDJGrantColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	musicDJGrantInsertCacheMut.RLock()
+	cache, cached := musicDJGrantInsertCache[key]
+	musicDJGrantInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			musicDJGrantAllColumns,
+			musicDJGrantColumnsWithDefault,
+			musicDJGrantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"music_dj_grant\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"music_dj_grant\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into music_dj_grant")
+	}
+
+	if !cached {
+		musicDJGrantInsertCacheMut.Lock()
+		musicDJGrantInsertCache[key] = cache
+		musicDJGrantInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MusicDJGrant.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MusicDJGrant) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	musicDJGrantUpdateCacheMut.RLock()
+	cache, cached := musicDJGrantUpdateCache[key]
+	musicDJGrantUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			musicDJGrantAllColumns,
+			musicDJGrantPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update music_dj_grant, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"music_dj_grant\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, musicDJGrantPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, append(wl, musicDJGrantPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update music_dj_grant row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for music_dj_grant")
+	}
+
+	if !cached {
+		musicDJGrantUpdateCacheMut.Lock()
+		musicDJGrantUpdateCache[key] = cache
+		musicDJGrantUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q musicDJGrantQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for music_dj_grant")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for music_dj_grant")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MusicDJGrantSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicDJGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"music_dj_grant\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, musicDJGrantPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in musicDJGrant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all musicDJGrant")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MusicDJGrant) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_dj_grant provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicDJGrantColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	musicDJGrantUpsertCacheMut.RLock()
+	cache, cached := musicDJGrantUpsertCache[key]
+	musicDJGrantUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			musicDJGrantAllColumns,
+			musicDJGrantColumnsWithDefault,
+			musicDJGrantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			musicDJGrantAllColumns,
+			musicDJGrantPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert music_dj_grant, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(musicDJGrantPrimaryKeyColumns))
+			copy(conflict, musicDJGrantPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"music_dj_grant\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(musicDJGrantType, musicDJGrantMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert music_dj_grant")
+	}
+
+	if !cached {
+		musicDJGrantUpsertCacheMut.Lock()
+		musicDJGrantUpsertCache[key] = cache
+		musicDJGrantUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MusicDJGrant record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MusicDJGrant) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MusicDJGrant provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), musicDJGrantPrimaryKeyMapping)
+	sql := "DELETE FROM \"music_dj_grant\" WHERE \"guild_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from music_dj_grant")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for music_dj_grant")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q musicDJGrantQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no musicDJGrantQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from music_dj_grant")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_dj_grant")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MusicDJGrantSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(musicDJGrantBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicDJGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"music_dj_grant\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicDJGrantPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from musicDJGrant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_dj_grant")
+	}
+
+	if len(musicDJGrantAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MusicDJGrant) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMusicDJGrant(ctx, exec, o.GuildID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MusicDJGrantSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MusicDJGrantSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicDJGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"music_dj_grant\".* FROM \"music_dj_grant\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicDJGrantPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MusicDJGrantSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MusicDJGrantExists checks if the MusicDJGrant row exists.
+func MusicDJGrantExists(ctx context.Context, exec boil.ContextExecutor, guildID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"music_dj_grant\" where \"guild_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if music_dj_grant exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MusicDJGrant row exists.
+func (o *MusicDJGrant) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MusicDJGrantExists(ctx, exec, o.GuildID, o.UserID)
+}
@@ -0,0 +1,1193 @@
+// Code generated by SQLBoiler 4.14.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MusicPolicy is an object representing the database table.
+type MusicPolicy struct {
+	GuildID                string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	MaxSongDurationSeconds null.Int    `boil:"max_song_duration_seconds" json:"max_song_duration_seconds,omitempty" toml:"max_song_duration_seconds" yaml:"max_song_duration_seconds,omitempty"`
+	MaxQueuedSongsPerUser  null.Int    `boil:"max_queued_songs_per_user" json:"max_queued_songs_per_user,omitempty" toml:"max_queued_songs_per_user" yaml:"max_queued_songs_per_user,omitempty"`
+	MaxPlaylistSize        null.Int    `boil:"max_playlist_size" json:"max_playlist_size,omitempty" toml:"max_playlist_size" yaml:"max_playlist_size,omitempty"`
+	AllowLiveStreams       bool        `boil:"allow_live_streams" json:"allow_live_streams" toml:"allow_live_streams" yaml:"allow_live_streams"`
+	MaxAgeLimit            null.Int    `boil:"max_age_limit" json:"max_age_limit,omitempty" toml:"max_age_limit" yaml:"max_age_limit,omitempty"`
+	AllowedExtractors      null.String `boil:"allowed_extractors" json:"allowed_extractors,omitempty" toml:"allowed_extractors" yaml:"allowed_extractors,omitempty"`
+	AllowedDomains         null.String `boil:"allowed_domains" json:"allowed_domains,omitempty" toml:"allowed_domains" yaml:"allowed_domains,omitempty"`
+	MaxStreamSeconds       null.Int    `boil:"max_stream_seconds" json:"max_stream_seconds,omitempty" toml:"max_stream_seconds" yaml:"max_stream_seconds,omitempty"`
+	DJRoleID               null.String `boil:"dj_role_id" json:"dj_role_id,omitempty" toml:"dj_role_id" yaml:"dj_role_id,omitempty"`
+	QueueLocked            bool        `boil:"queue_locked" json:"queue_locked" toml:"queue_locked" yaml:"queue_locked"`
+	DJOnlyListeners        null.Int    `boil:"dj_only_listeners" json:"dj_only_listeners,omitempty" toml:"dj_only_listeners" yaml:"dj_only_listeners,omitempty"`
+
+	R *musicPolicyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L musicPolicyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MusicPolicyColumns = struct {
+	GuildID                string
+	MaxSongDurationSeconds string
+	MaxQueuedSongsPerUser  string
+	MaxPlaylistSize        string
+	AllowLiveStreams       string
+	MaxAgeLimit            string
+	AllowedExtractors      string
+	AllowedDomains         string
+	MaxStreamSeconds       string
+	DJRoleID               string
+	QueueLocked            string
+	DJOnlyListeners        string
+}{
+	GuildID:                "guild_id",
+	MaxSongDurationSeconds: "max_song_duration_seconds",
+	MaxQueuedSongsPerUser:  "max_queued_songs_per_user",
+	MaxPlaylistSize:        "max_playlist_size",
+	AllowLiveStreams:       "allow_live_streams",
+	MaxAgeLimit:            "max_age_limit",
+	AllowedExtractors:      "allowed_extractors",
+	AllowedDomains:         "allowed_domains",
+	MaxStreamSeconds:       "max_stream_seconds",
+	DJRoleID:               "dj_role_id",
+	QueueLocked:            "queue_locked",
+	DJOnlyListeners:        "dj_only_listeners",
+}
+
+var MusicPolicyTableColumns = struct {
+	GuildID                string
+	MaxSongDurationSeconds string
+	MaxQueuedSongsPerUser  string
+	MaxPlaylistSize        string
+	AllowLiveStreams       string
+	MaxAgeLimit            string
+	AllowedExtractors      string
+	AllowedDomains         string
+	MaxStreamSeconds       string
+	DJRoleID               string
+	QueueLocked            string
+	DJOnlyListeners        string
+}{
+	GuildID:                "music_policy.guild_id",
+	MaxSongDurationSeconds: "music_policy.max_song_duration_seconds",
+	MaxQueuedSongsPerUser:  "music_policy.max_queued_songs_per_user",
+	MaxPlaylistSize:        "music_policy.max_playlist_size",
+	AllowLiveStreams:       "music_policy.allow_live_streams",
+	MaxAgeLimit:            "music_policy.max_age_limit",
+	AllowedExtractors:      "music_policy.allowed_extractors",
+	AllowedDomains:         "music_policy.allowed_domains",
+	MaxStreamSeconds:       "music_policy.max_stream_seconds",
+	DJRoleID:               "music_policy.dj_role_id",
+	QueueLocked:            "music_policy.queue_locked",
+	DJOnlyListeners:        "music_policy.dj_only_listeners",
+}
+
+// Generated where
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var MusicPolicyWhere = struct {
+	GuildID                whereHelperstring
+	MaxSongDurationSeconds whereHelpernull_Int
+	MaxQueuedSongsPerUser  whereHelpernull_Int
+	MaxPlaylistSize        whereHelpernull_Int
+	AllowLiveStreams       whereHelperbool
+	MaxAgeLimit            whereHelpernull_Int
+	AllowedExtractors      whereHelpernull_String
+	AllowedDomains         whereHelpernull_String
+	MaxStreamSeconds       whereHelpernull_Int
+	DJRoleID               whereHelpernull_String
+	QueueLocked            whereHelperbool
+	DJOnlyListeners        whereHelpernull_Int
+}{
+	GuildID:                whereHelperstring{field: "\"music_policy\".\"guild_id\""},
+	MaxSongDurationSeconds: whereHelpernull_Int{field: "\"music_policy\".\"max_song_duration_seconds\""},
+	MaxQueuedSongsPerUser:  whereHelpernull_Int{field: "\"music_policy\".\"max_queued_songs_per_user\""},
+	MaxPlaylistSize:        whereHelpernull_Int{field: "\"music_policy\".\"max_playlist_size\""},
+	AllowLiveStreams:       whereHelperbool{field: "\"music_policy\".\"allow_live_streams\""},
+	MaxAgeLimit:            whereHelpernull_Int{field: "\"music_policy\".\"max_age_limit\""},
+	AllowedExtractors:      whereHelpernull_String{field: "\"music_policy\".\"allowed_extractors\""},
+	AllowedDomains:         whereHelpernull_String{field: "\"music_policy\".\"allowed_domains\""},
+	MaxStreamSeconds:       whereHelpernull_Int{field: "\"music_policy\".\"max_stream_seconds\""},
+	DJRoleID:               whereHelpernull_String{field: "\"music_policy\".\"dj_role_id\""},
+	QueueLocked:            whereHelperbool{field: "\"music_policy\".\"queue_locked\""},
+	DJOnlyListeners:        whereHelpernull_Int{field: "\"music_policy\".\"dj_only_listeners\""},
+}
+
+// MusicPolicyRels is where relationship names are stored.
+var MusicPolicyRels = struct {
+	Guild string
+}{
+	Guild: "Guild",
+}
+
+// musicPolicyR is where relationships are stored.
+type musicPolicyR struct {
+	Guild *DiscordServer `boil:"Guild" json:"Guild" toml:"Guild" yaml:"Guild"`
+}
+
+// NewStruct creates a new relationship struct
+func (*musicPolicyR) NewStruct() *musicPolicyR {
+	return &musicPolicyR{}
+}
+
+func (r *musicPolicyR) GetGuild() *DiscordServer {
+	if r == nil {
+		return nil
+	}
+	return r.Guild
+}
+
+// musicPolicyL is where Load methods for each relationship are stored.
+type musicPolicyL struct{}
+
+var (
+	musicPolicyAllColumns            = []string{"guild_id", "max_song_duration_seconds", "max_queued_songs_per_user", "max_playlist_size", "allow_live_streams", "max_age_limit", "allowed_extractors", "allowed_domains", "max_stream_seconds", "dj_role_id", "queue_locked", "dj_only_listeners"}
+	musicPolicyColumnsWithoutDefault = []string{"guild_id"}
+	musicPolicyColumnsWithDefault    = []string{"max_song_duration_seconds", "max_queued_songs_per_user", "max_playlist_size", "allow_live_streams", "max_age_limit", "allowed_extractors", "allowed_domains", "max_stream_seconds", "dj_role_id", "queue_locked", "dj_only_listeners"}
+	musicPolicyPrimaryKeyColumns     = []string{"guild_id"}
+	musicPolicyGeneratedColumns      = []string{}
+)
+
+type (
+	// MusicPolicySlice is an alias for a slice of pointers to MusicPolicy.
+	// This should almost always be used instead of []MusicPolicy.
+	MusicPolicySlice []*MusicPolicy
+	// MusicPolicyHook is the signature for custom MusicPolicy hook methods
+	MusicPolicyHook func(context.Context, boil.ContextExecutor, *MusicPolicy) error
+
+	musicPolicyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	musicPolicyType                 = reflect.TypeOf(&MusicPolicy{})
+	musicPolicyMapping              = queries.MakeStructMapping(musicPolicyType)
+	musicPolicyPrimaryKeyMapping, _ = queries.BindMapping(musicPolicyType, musicPolicyMapping, musicPolicyPrimaryKeyColumns)
+	musicPolicyInsertCacheMut       sync.RWMutex
+	musicPolicyInsertCache          = make(map[string]insertCache)
+	musicPolicyUpdateCacheMut       sync.RWMutex
+	musicPolicyUpdateCache          = make(map[string]updateCache)
+	musicPolicyUpsertCacheMut       sync.RWMutex
+	musicPolicyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var musicPolicyAfterSelectHooks []MusicPolicyHook
+
+var musicPolicyBeforeInsertHooks []MusicPolicyHook
+var musicPolicyAfterInsertHooks []MusicPolicyHook
+
+var musicPolicyBeforeUpdateHooks []MusicPolicyHook
+var musicPolicyAfterUpdateHooks []MusicPolicyHook
+
+var musicPolicyBeforeDeleteHooks []MusicPolicyHook
+var musicPolicyAfterDeleteHooks []MusicPolicyHook
+
+var musicPolicyBeforeUpsertHooks []MusicPolicyHook
+var musicPolicyAfterUpsertHooks []MusicPolicyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MusicPolicy) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MusicPolicy) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MusicPolicy) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MusicPolicy) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MusicPolicy) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MusicPolicy) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MusicPolicy) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MusicPolicy) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MusicPolicy) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range musicPolicyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMusicPolicyHook registers your hook function for all future operations.
+func AddMusicPolicyHook(hookPoint boil.HookPoint, musicPolicyHook MusicPolicyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		musicPolicyAfterSelectHooks = append(musicPolicyAfterSelectHooks, musicPolicyHook)
+	case boil.BeforeInsertHook:
+		musicPolicyBeforeInsertHooks = append(musicPolicyBeforeInsertHooks, musicPolicyHook)
+	case boil.AfterInsertHook:
+		musicPolicyAfterInsertHooks = append(musicPolicyAfterInsertHooks, musicPolicyHook)
+	case boil.BeforeUpdateHook:
+		musicPolicyBeforeUpdateHooks = append(musicPolicyBeforeUpdateHooks, musicPolicyHook)
+	case boil.AfterUpdateHook:
+		musicPolicyAfterUpdateHooks = append(musicPolicyAfterUpdateHooks, musicPolicyHook)
+	case boil.BeforeDeleteHook:
+		musicPolicyBeforeDeleteHooks = append(musicPolicyBeforeDeleteHooks, musicPolicyHook)
+	case boil.AfterDeleteHook:
+		musicPolicyAfterDeleteHooks = append(musicPolicyAfterDeleteHooks, musicPolicyHook)
+	case boil.BeforeUpsertHook:
+		musicPolicyBeforeUpsertHooks = append(musicPolicyBeforeUpsertHooks, musicPolicyHook)
+	case boil.AfterUpsertHook:
+		musicPolicyAfterUpsertHooks = append(musicPolicyAfterUpsertHooks, musicPolicyHook)
+	}
+}
+
+// One returns a single musicPolicy record from the query.
+func (q musicPolicyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MusicPolicy, error) {
+	o := &MusicPolicy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for music_policy")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MusicPolicy records from the query.
+func (q musicPolicyQuery) All(ctx context.Context, exec boil.ContextExecutor) (MusicPolicySlice, error) {
+	var o []*MusicPolicy
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MusicPolicy slice")
+	}
+
+	if len(musicPolicyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MusicPolicy records in the query.
+func (q musicPolicyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count music_policy rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q musicPolicyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if music_policy exists")
+	}
+
+	return count > 0, nil
+}
+
+// Guild pointed to by the foreign key.
+func (o *MusicPolicy) Guild(mods ...qm.QueryMod) discordServerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"guild_id\" = ?", o.GuildID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DiscordServers(queryMods...)
+}
+
+// LoadGuild allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (musicPolicyL) LoadGuild(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMusicPolicy interface{}, mods queries.Applicator) error {
+	var slice []*MusicPolicy
+	var object *MusicPolicy
+
+	if singular {
+		var ok bool
+		object, ok = maybeMusicPolicy.(*MusicPolicy)
+		if !ok {
+			object = new(MusicPolicy)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMusicPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMusicPolicy))
+			}
+		}
+	} else {
+		s, ok := maybeMusicPolicy.(*[]*MusicPolicy)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMusicPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMusicPolicy))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &musicPolicyR{}
+		}
+		args = append(args, object.GuildID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &musicPolicyR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`discord_server`),
+		qm.WhereIn(`discord_server.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DiscordServer")
+	}
+
+	var resultSlice []*DiscordServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DiscordServer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for discord_server")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for discord_server")
+	}
+
+	if len(discordServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Guild = foreign
+		if foreign.R == nil {
+			foreign.R = &discordServerR{}
+		}
+		foreign.R.GuildMusicPolicy = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuildID == foreign.GuildID {
+				local.R.Guild = foreign
+				if foreign.R == nil {
+					foreign.R = &discordServerR{}
+				}
+				foreign.R.GuildMusicPolicy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuild of the musicPolicy to the related item.
+// Sets o.R.Guild to related.
+// Adds o to related.R.GuildMusicPolicy.
+func (o *MusicPolicy) SetGuild(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DiscordServer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"music_policy\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+		strmangle.WhereClause("\"", "\"", 2, musicPolicyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.GuildID, o.GuildID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GuildID = related.GuildID
+	if o.R == nil {
+		o.R = &musicPolicyR{
+			Guild: related,
+		}
+	} else {
+		o.R.Guild = related
+	}
+
+	if related.R == nil {
+		related.R = &discordServerR{
+			GuildMusicPolicy: o,
+		}
+	} else {
+		related.R.GuildMusicPolicy = o
+	}
+
+	return nil
+}
+
+// MusicPolicies retrieves all the records using an executor.
+func MusicPolicies(mods ...qm.QueryMod) musicPolicyQuery {
+	mods = append(mods, qm.From("\"music_policy\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"music_policy\".*"})
+	}
+
+	return musicPolicyQuery{q}
+}
+
+// FindMusicPolicy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMusicPolicy(ctx context.Context, exec boil.ContextExecutor, guildID string, selectCols ...string) (*MusicPolicy, error) {
+	musicPolicyObj := &MusicPolicy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"music_policy\" where \"guild_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, guildID)
+
+	err := q.Bind(ctx, exec, musicPolicyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from music_policy")
+	}
+
+	if err = musicPolicyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return musicPolicyObj, err
+	}
+
+	return musicPolicyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MusicPolicy) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_policy provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicPolicyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	musicPolicyInsertCacheMut.RLock()
+	cache, cached := musicPolicyInsertCache[key]
+	musicPolicyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			musicPolicyAllColumns,
+			musicPolicyColumnsWithDefault,
+			musicPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(musicPolicyType, musicPolicyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(musicPolicyType, musicPolicyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"music_policy\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"music_policy\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into music_policy")
+	}
+
+	if !cached {
+		musicPolicyInsertCacheMut.Lock()
+		musicPolicyInsertCache[key] = cache
+		musicPolicyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MusicPolicy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MusicPolicy) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	musicPolicyUpdateCacheMut.RLock()
+	cache, cached := musicPolicyUpdateCache[key]
+	musicPolicyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			musicPolicyAllColumns,
+			musicPolicyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update music_policy, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"music_policy\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, musicPolicyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(musicPolicyType, musicPolicyMapping, append(wl, musicPolicyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update music_policy row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for music_policy")
+	}
+
+	if !cached {
+		musicPolicyUpdateCacheMut.Lock()
+		musicPolicyUpdateCache[key] = cache
+		musicPolicyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q musicPolicyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for music_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for music_policy")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MusicPolicySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"music_policy\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, musicPolicyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in musicPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all musicPolicy")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MusicPolicy) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no music_policy provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(musicPolicyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	musicPolicyUpsertCacheMut.RLock()
+	cache, cached := musicPolicyUpsertCache[key]
+	musicPolicyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			musicPolicyAllColumns,
+			musicPolicyColumnsWithDefault,
+			musicPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			musicPolicyAllColumns,
+			musicPolicyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert music_policy, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(musicPolicyPrimaryKeyColumns))
+			copy(conflict, musicPolicyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"music_policy\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(musicPolicyType, musicPolicyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(musicPolicyType, musicPolicyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert music_policy")
+	}
+
+	if !cached {
+		musicPolicyUpsertCacheMut.Lock()
+		musicPolicyUpsertCache[key] = cache
+		musicPolicyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MusicPolicy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MusicPolicy) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MusicPolicy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), musicPolicyPrimaryKeyMapping)
+	sql := "DELETE FROM \"music_policy\" WHERE \"guild_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from music_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for music_policy")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q musicPolicyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no musicPolicyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from music_policy")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_policy")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MusicPolicySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(musicPolicyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"music_policy\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicPolicyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from musicPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for music_policy")
+	}
+
+	if len(musicPolicyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MusicPolicy) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMusicPolicy(ctx, exec, o.GuildID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MusicPolicySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MusicPolicySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), musicPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"music_policy\".* FROM \"music_policy\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, musicPolicyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MusicPolicySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MusicPolicyExists checks if the MusicPolicy row exists.
+func MusicPolicyExists(ctx context.Context, exec boil.ContextExecutor, guildID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"music_policy\" where \"guild_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, guildID)
+	}
+	row := exec.QueryRowContext(ctx, sql, guildID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if music_policy exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MusicPolicy row exists.
+func (o *MusicPolicy) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MusicPolicyExists(ctx, exec, o.GuildID)
+}
@@ -37,6 +37,7 @@ type RolePermission struct {
 	PlaySongs             bool   `boil:"play_songs" json:"play_songs" toml:"play_songs" yaml:"play_songs"`
 	PlayLists             bool   `boil:"play_lists" json:"play_lists" toml:"play_lists" yaml:"play_lists"`
 	SkipSongs             bool   `boil:"skip_songs" json:"skip_songs" toml:"skip_songs" yaml:"skip_songs"`
+	IgnoreMusicPolicy     bool   `boil:"ignore_music_policy" json:"ignore_music_policy" toml:"ignore_music_policy" yaml:"ignore_music_policy"`
 
 	R *rolePermissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L rolePermissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -57,6 +58,7 @@ var RolePermissionColumns = struct {
 	PlaySongs             string
 	PlayLists             string
 	SkipSongs             string
+	IgnoreMusicPolicy     string
 }{
 	ID:                    "id",
 	GuildID:               "guild_id",
@@ -72,6 +74,7 @@ var RolePermissionColumns = struct {
 	PlaySongs:             "play_songs",
 	PlayLists:             "play_lists",
 	SkipSongs:             "skip_songs",
+	IgnoreMusicPolicy:     "ignore_music_policy",
 }
 
 var RolePermissionTableColumns = struct {
@@ -89,6 +92,7 @@ var RolePermissionTableColumns = struct {
 	PlaySongs             string
 	PlayLists             string
 	SkipSongs             string
+	IgnoreMusicPolicy     string
 }{
 	ID:                    "role_permission.id",
 	GuildID:               "role_permission.guild_id",
@@ -104,6 +108,7 @@ var RolePermissionTableColumns = struct {
 	PlaySongs:             "role_permission.play_songs",
 	PlayLists:             "role_permission.play_lists",
 	SkipSongs:             "role_permission.skip_songs",
+	IgnoreMusicPolicy:     "role_permission.ignore_music_policy",
 }
 
 // Generated where
@@ -123,6 +128,7 @@ var RolePermissionWhere = struct {
 	PlaySongs             whereHelperbool
 	PlayLists             whereHelperbool
 	SkipSongs             whereHelperbool
+	IgnoreMusicPolicy     whereHelperbool
 }{
 	ID:                    whereHelperint64{field: "\"role_permission\".\"id\""},
 	GuildID:               whereHelperstring{field: "\"role_permission\".\"guild_id\""},
@@ -138,6 +144,7 @@ var RolePermissionWhere = struct {
 	PlaySongs:             whereHelperbool{field: "\"role_permission\".\"play_songs\""},
 	PlayLists:             whereHelperbool{field: "\"role_permission\".\"play_lists\""},
 	SkipSongs:             whereHelperbool{field: "\"role_permission\".\"skip_songs\""},
+	IgnoreMusicPolicy:     whereHelperbool{field: "\"role_permission\".\"ignore_music_policy\""},
 }
 
 // RolePermissionRels is where relationship names are stored.
@@ -157,9 +164,9 @@ func (*rolePermissionR) NewStruct() *rolePermissionR {
 type rolePermissionL struct{}
 
 var (
-	rolePermissionAllColumns            = []string{"id", "guild_id", "role_id", "post_links", "moderation_mute_member", "roll_dice", "flip_coin", "random_image", "use_custom_commands", "manage_custom_commands", "ignore_command_throttle", "play_songs", "play_lists", "skip_songs"}
+	rolePermissionAllColumns            = []string{"id", "guild_id", "role_id", "post_links", "moderation_mute_member", "roll_dice", "flip_coin", "random_image", "use_custom_commands", "manage_custom_commands", "ignore_command_throttle", "play_songs", "play_lists", "skip_songs", "ignore_music_policy"}
 	rolePermissionColumnsWithoutDefault = []string{"guild_id", "role_id"}
-	rolePermissionColumnsWithDefault    = []string{"id", "post_links", "moderation_mute_member", "roll_dice", "flip_coin", "random_image", "use_custom_commands", "manage_custom_commands", "ignore_command_throttle", "play_songs", "play_lists", "skip_songs"}
+	rolePermissionColumnsWithDefault    = []string{"id", "post_links", "moderation_mute_member", "roll_dice", "flip_coin", "random_image", "use_custom_commands", "manage_custom_commands", "ignore_command_throttle", "play_songs", "play_lists", "skip_songs", "ignore_music_policy"}
 	rolePermissionPrimaryKeyColumns     = []string{"id"}
 	rolePermissionGeneratedColumns      = []string{}
 )
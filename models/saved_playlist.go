@@ -0,0 +1,1335 @@
+// Code generated by SQLBoiler 4.14.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SavedPlaylist is an object representing the database table.
+type SavedPlaylist struct {
+	ID              int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GuildID         string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	OwnerUserID     null.String `boil:"owner_user_id" json:"owner_user_id,omitempty" toml:"owner_user_id" yaml:"owner_user_id,omitempty"`
+	Name            string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedByUserID string      `boil:"created_by_user_id" json:"created_by_user_id" toml:"created_by_user_id" yaml:"created_by_user_id"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *savedPlaylistR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L savedPlaylistL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SavedPlaylistColumns = struct {
+	ID              string
+	GuildID         string
+	OwnerUserID     string
+	Name            string
+	CreatedByUserID string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	GuildID:         "guild_id",
+	OwnerUserID:     "owner_user_id",
+	Name:            "name",
+	CreatedByUserID: "created_by_user_id",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var SavedPlaylistTableColumns = struct {
+	ID              string
+	GuildID         string
+	OwnerUserID     string
+	Name            string
+	CreatedByUserID string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "saved_playlist.id",
+	GuildID:         "saved_playlist.guild_id",
+	OwnerUserID:     "saved_playlist.owner_user_id",
+	Name:            "saved_playlist.name",
+	CreatedByUserID: "saved_playlist.created_by_user_id",
+	CreatedAt:       "saved_playlist.created_at",
+	UpdatedAt:       "saved_playlist.updated_at",
+}
+
+// Generated where
+
+var SavedPlaylistWhere = struct {
+	ID              whereHelperint64
+	GuildID         whereHelperstring
+	OwnerUserID     whereHelpernull_String
+	Name            whereHelperstring
+	CreatedByUserID whereHelperstring
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"saved_playlist\".\"id\""},
+	GuildID:         whereHelperstring{field: "\"saved_playlist\".\"guild_id\""},
+	OwnerUserID:     whereHelpernull_String{field: "\"saved_playlist\".\"owner_user_id\""},
+	Name:            whereHelperstring{field: "\"saved_playlist\".\"name\""},
+	CreatedByUserID: whereHelperstring{field: "\"saved_playlist\".\"created_by_user_id\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"saved_playlist\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"saved_playlist\".\"updated_at\""},
+}
+
+// SavedPlaylistRels is where relationship names are stored.
+var SavedPlaylistRels = struct {
+	Guild              string
+	SavedPlaylistSongs string
+}{
+	Guild:              "Guild",
+	SavedPlaylistSongs: "SavedPlaylistSongs",
+}
+
+// savedPlaylistR is where relationships are stored.
+type savedPlaylistR struct {
+	Guild              *DiscordServer         `boil:"Guild" json:"Guild" toml:"Guild" yaml:"Guild"`
+	SavedPlaylistSongs SavedPlaylistSongSlice `boil:"SavedPlaylistSongs" json:"SavedPlaylistSongs" toml:"SavedPlaylistSongs" yaml:"SavedPlaylistSongs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*savedPlaylistR) NewStruct() *savedPlaylistR {
+	return &savedPlaylistR{}
+}
+
+func (r *savedPlaylistR) GetGuild() *DiscordServer {
+	if r == nil {
+		return nil
+	}
+	return r.Guild
+}
+
+func (r *savedPlaylistR) GetSavedPlaylistSongs() SavedPlaylistSongSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SavedPlaylistSongs
+}
+
+// savedPlaylistL is where Load methods for each relationship are stored.
+type savedPlaylistL struct{}
+
+var (
+	savedPlaylistAllColumns            = []string{"id", "guild_id", "owner_user_id", "name", "created_by_user_id", "created_at", "updated_at"}
+	savedPlaylistColumnsWithoutDefault = []string{"guild_id", "name", "created_by_user_id"}
+	savedPlaylistColumnsWithDefault    = []string{"id", "owner_user_id", "created_at", "updated_at"}
+	savedPlaylistPrimaryKeyColumns     = []string{"id"}
+	savedPlaylistGeneratedColumns      = []string{}
+)
+
+type (
+	// SavedPlaylistSlice is an alias for a slice of pointers to SavedPlaylist.
+	// This should almost always be used instead of []SavedPlaylist.
+	SavedPlaylistSlice []*SavedPlaylist
+	// SavedPlaylistHook is the signature for custom SavedPlaylist hook methods
+	SavedPlaylistHook func(context.Context, boil.ContextExecutor, *SavedPlaylist) error
+
+	savedPlaylistQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	savedPlaylistType                 = reflect.TypeOf(&SavedPlaylist{})
+	savedPlaylistMapping              = queries.MakeStructMapping(savedPlaylistType)
+	savedPlaylistPrimaryKeyMapping, _ = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, savedPlaylistPrimaryKeyColumns)
+	savedPlaylistInsertCacheMut       sync.RWMutex
+	savedPlaylistInsertCache          = make(map[string]insertCache)
+	savedPlaylistUpdateCacheMut       sync.RWMutex
+	savedPlaylistUpdateCache          = make(map[string]updateCache)
+	savedPlaylistUpsertCacheMut       sync.RWMutex
+	savedPlaylistUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var savedPlaylistAfterSelectHooks []SavedPlaylistHook
+
+var savedPlaylistBeforeInsertHooks []SavedPlaylistHook
+var savedPlaylistAfterInsertHooks []SavedPlaylistHook
+
+var savedPlaylistBeforeUpdateHooks []SavedPlaylistHook
+var savedPlaylistAfterUpdateHooks []SavedPlaylistHook
+
+var savedPlaylistBeforeDeleteHooks []SavedPlaylistHook
+var savedPlaylistAfterDeleteHooks []SavedPlaylistHook
+
+var savedPlaylistBeforeUpsertHooks []SavedPlaylistHook
+var savedPlaylistAfterUpsertHooks []SavedPlaylistHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SavedPlaylist) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SavedPlaylist) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SavedPlaylist) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SavedPlaylist) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SavedPlaylist) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SavedPlaylist) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SavedPlaylist) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SavedPlaylist) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SavedPlaylist) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSavedPlaylistHook registers your hook function for all future operations.
+func AddSavedPlaylistHook(hookPoint boil.HookPoint, savedPlaylistHook SavedPlaylistHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		savedPlaylistAfterSelectHooks = append(savedPlaylistAfterSelectHooks, savedPlaylistHook)
+	case boil.BeforeInsertHook:
+		savedPlaylistBeforeInsertHooks = append(savedPlaylistBeforeInsertHooks, savedPlaylistHook)
+	case boil.AfterInsertHook:
+		savedPlaylistAfterInsertHooks = append(savedPlaylistAfterInsertHooks, savedPlaylistHook)
+	case boil.BeforeUpdateHook:
+		savedPlaylistBeforeUpdateHooks = append(savedPlaylistBeforeUpdateHooks, savedPlaylistHook)
+	case boil.AfterUpdateHook:
+		savedPlaylistAfterUpdateHooks = append(savedPlaylistAfterUpdateHooks, savedPlaylistHook)
+	case boil.BeforeDeleteHook:
+		savedPlaylistBeforeDeleteHooks = append(savedPlaylistBeforeDeleteHooks, savedPlaylistHook)
+	case boil.AfterDeleteHook:
+		savedPlaylistAfterDeleteHooks = append(savedPlaylistAfterDeleteHooks, savedPlaylistHook)
+	case boil.BeforeUpsertHook:
+		savedPlaylistBeforeUpsertHooks = append(savedPlaylistBeforeUpsertHooks, savedPlaylistHook)
+	case boil.AfterUpsertHook:
+		savedPlaylistAfterUpsertHooks = append(savedPlaylistAfterUpsertHooks, savedPlaylistHook)
+	}
+}
+
+// One returns a single savedPlaylist record from the query.
+func (q savedPlaylistQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SavedPlaylist, error) {
+	o := &SavedPlaylist{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for saved_playlist")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SavedPlaylist records from the query.
+func (q savedPlaylistQuery) All(ctx context.Context, exec boil.ContextExecutor) (SavedPlaylistSlice, error) {
+	var o []*SavedPlaylist
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SavedPlaylist slice")
+	}
+
+	if len(savedPlaylistAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SavedPlaylist records in the query.
+func (q savedPlaylistQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count saved_playlist rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q savedPlaylistQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if saved_playlist exists")
+	}
+
+	return count > 0, nil
+}
+
+// Guild pointed to by the foreign key.
+func (o *SavedPlaylist) Guild(mods ...qm.QueryMod) discordServerQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"guild_id\" = ?", o.GuildID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DiscordServers(queryMods...)
+}
+
+// SavedPlaylistSongs retrieves all the saved_playlist_song's SavedPlaylistSongs with an executor.
+func (o *SavedPlaylist) SavedPlaylistSongs(mods ...qm.QueryMod) savedPlaylistSongQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"saved_playlist_song\".\"saved_playlist_id\"=?", o.ID),
+	)
+
+	return SavedPlaylistSongs(queryMods...)
+}
+
+// LoadGuild allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (savedPlaylistL) LoadGuild(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSavedPlaylist interface{}, mods queries.Applicator) error {
+	var slice []*SavedPlaylist
+	var object *SavedPlaylist
+
+	if singular {
+		var ok bool
+		object, ok = maybeSavedPlaylist.(*SavedPlaylist)
+		if !ok {
+			object = new(SavedPlaylist)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSavedPlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSavedPlaylist))
+			}
+		}
+	} else {
+		s, ok := maybeSavedPlaylist.(*[]*SavedPlaylist)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSavedPlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSavedPlaylist))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &savedPlaylistR{}
+		}
+		args = append(args, object.GuildID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &savedPlaylistR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GuildID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GuildID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`discord_server`),
+		qm.WhereIn(`discord_server.guild_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DiscordServer")
+	}
+
+	var resultSlice []*DiscordServer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DiscordServer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for discord_server")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for discord_server")
+	}
+
+	if len(discordServerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Guild = foreign
+		if foreign.R == nil {
+			foreign.R = &discordServerR{}
+		}
+		foreign.R.GuildSavedPlaylists = append(foreign.R.GuildSavedPlaylists, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GuildID == foreign.GuildID {
+				local.R.Guild = foreign
+				if foreign.R == nil {
+					foreign.R = &discordServerR{}
+				}
+				foreign.R.GuildSavedPlaylists = append(foreign.R.GuildSavedPlaylists, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSavedPlaylistSongs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (savedPlaylistL) LoadSavedPlaylistSongs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSavedPlaylist interface{}, mods queries.Applicator) error {
+	var slice []*SavedPlaylist
+	var object *SavedPlaylist
+
+	if singular {
+		var ok bool
+		object, ok = maybeSavedPlaylist.(*SavedPlaylist)
+		if !ok {
+			object = new(SavedPlaylist)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSavedPlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSavedPlaylist))
+			}
+		}
+	} else {
+		s, ok := maybeSavedPlaylist.(*[]*SavedPlaylist)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSavedPlaylist)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSavedPlaylist))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &savedPlaylistR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &savedPlaylistR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`saved_playlist_song`),
+		qm.WhereIn(`saved_playlist_song.saved_playlist_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load saved_playlist_song")
+	}
+
+	var resultSlice []*SavedPlaylistSong
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice saved_playlist_song")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on saved_playlist_song")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saved_playlist_song")
+	}
+
+	if len(savedPlaylistSongAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SavedPlaylistSongs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &savedPlaylistSongR{}
+			}
+			foreign.R.SavedPlaylist = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SavedPlaylistID {
+				local.R.SavedPlaylistSongs = append(local.R.SavedPlaylistSongs, foreign)
+				if foreign.R == nil {
+					foreign.R = &savedPlaylistSongR{}
+				}
+				foreign.R.SavedPlaylist = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGuild of the savedPlaylist to the related item.
+// Sets o.R.Guild to related.
+// Adds o to related.R.GuildSavedPlaylists.
+func (o *SavedPlaylist) SetGuild(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DiscordServer) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"saved_playlist\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"guild_id"}),
+		strmangle.WhereClause("\"", "\"", 2, savedPlaylistPrimaryKeyColumns),
+	)
+	values := []interface{}{related.GuildID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GuildID = related.GuildID
+	if o.R == nil {
+		o.R = &savedPlaylistR{
+			Guild: related,
+		}
+	} else {
+		o.R.Guild = related
+	}
+
+	if related.R == nil {
+		related.R = &discordServerR{
+			GuildSavedPlaylists: SavedPlaylistSlice{o},
+		}
+	} else {
+		related.R.GuildSavedPlaylists = append(related.R.GuildSavedPlaylists, o)
+	}
+
+	return nil
+}
+
+// AddSavedPlaylistSongs adds the given related objects to the existing relationships
+// of the saved_playlist, optionally inserting them as new records.
+// Appends related to o.R.SavedPlaylistSongs.
+// Sets related.R.SavedPlaylist appropriately.
+func (o *SavedPlaylist) AddSavedPlaylistSongs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SavedPlaylistSong) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SavedPlaylistID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"saved_playlist_id"}),
+				strmangle.WhereClause("\"", "\"", 2, savedPlaylistSongPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SavedPlaylistID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &savedPlaylistR{
+			SavedPlaylistSongs: related,
+		}
+	} else {
+		o.R.SavedPlaylistSongs = append(o.R.SavedPlaylistSongs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &savedPlaylistSongR{
+				SavedPlaylist: o,
+			}
+		} else {
+			rel.R.SavedPlaylist = o
+		}
+	}
+	return nil
+}
+
+// SavedPlaylists retrieves all the records using an executor.
+func SavedPlaylists(mods ...qm.QueryMod) savedPlaylistQuery {
+	mods = append(mods, qm.From("\"saved_playlist\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"saved_playlist\".*"})
+	}
+
+	return savedPlaylistQuery{q}
+}
+
+// FindSavedPlaylist retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSavedPlaylist(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SavedPlaylist, error) {
+	savedPlaylistObj := &SavedPlaylist{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"saved_playlist\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, savedPlaylistObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from saved_playlist")
+	}
+
+	if err = savedPlaylistObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return savedPlaylistObj, err
+	}
+
+	return savedPlaylistObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SavedPlaylist) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no saved_playlist provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(savedPlaylistColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	savedPlaylistInsertCacheMut.RLock()
+	cache, cached := savedPlaylistInsertCache[key]
+	savedPlaylistInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			savedPlaylistAllColumns,
+			savedPlaylistColumnsWithDefault,
+			savedPlaylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"saved_playlist\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"saved_playlist\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into saved_playlist")
+	}
+
+	if !cached {
+		savedPlaylistInsertCacheMut.Lock()
+		savedPlaylistInsertCache[key] = cache
+		savedPlaylistInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SavedPlaylist.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SavedPlaylist) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	savedPlaylistUpdateCacheMut.RLock()
+	cache, cached := savedPlaylistUpdateCache[key]
+	savedPlaylistUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			savedPlaylistAllColumns,
+			savedPlaylistPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update saved_playlist, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"saved_playlist\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, savedPlaylistPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, append(wl, savedPlaylistPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update saved_playlist row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for saved_playlist")
+	}
+
+	if !cached {
+		savedPlaylistUpdateCacheMut.Lock()
+		savedPlaylistUpdateCache[key] = cache
+		savedPlaylistUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q savedPlaylistQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for saved_playlist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for saved_playlist")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SavedPlaylistSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"saved_playlist\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, savedPlaylistPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in savedPlaylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all savedPlaylist")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SavedPlaylist) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no saved_playlist provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(savedPlaylistColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	savedPlaylistUpsertCacheMut.RLock()
+	cache, cached := savedPlaylistUpsertCache[key]
+	savedPlaylistUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			savedPlaylistAllColumns,
+			savedPlaylistColumnsWithDefault,
+			savedPlaylistColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			savedPlaylistAllColumns,
+			savedPlaylistPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert saved_playlist, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(savedPlaylistPrimaryKeyColumns))
+			copy(conflict, savedPlaylistPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"saved_playlist\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(savedPlaylistType, savedPlaylistMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert saved_playlist")
+	}
+
+	if !cached {
+		savedPlaylistUpsertCacheMut.Lock()
+		savedPlaylistUpsertCache[key] = cache
+		savedPlaylistUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SavedPlaylist record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SavedPlaylist) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SavedPlaylist provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), savedPlaylistPrimaryKeyMapping)
+	sql := "DELETE FROM \"saved_playlist\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from saved_playlist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for saved_playlist")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q savedPlaylistQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no savedPlaylistQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from saved_playlist")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saved_playlist")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SavedPlaylistSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(savedPlaylistBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"saved_playlist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, savedPlaylistPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from savedPlaylist slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saved_playlist")
+	}
+
+	if len(savedPlaylistAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SavedPlaylist) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSavedPlaylist(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SavedPlaylistSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SavedPlaylistSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"saved_playlist\".* FROM \"saved_playlist\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, savedPlaylistPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SavedPlaylistSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SavedPlaylistExists checks if the SavedPlaylist row exists.
+func SavedPlaylistExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"saved_playlist\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if saved_playlist exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SavedPlaylist row exists.
+func (o *SavedPlaylist) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SavedPlaylistExists(ctx, exec, o.ID)
+}
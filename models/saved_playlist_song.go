@@ -0,0 +1,1300 @@
+// Code generated by SQLBoiler 4.14.1 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// SavedPlaylistSong is an object representing the database table.
+type SavedPlaylistSong struct {
+	ID              int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SavedPlaylistID int64     `boil:"saved_playlist_id" json:"saved_playlist_id" toml:"saved_playlist_id" yaml:"saved_playlist_id"`
+	SongID          string    `boil:"song_id" json:"song_id" toml:"song_id" yaml:"song_id"`
+	Position        int       `boil:"position" json:"position" toml:"position" yaml:"position"`
+	AddedByUserID   string    `boil:"added_by_user_id" json:"added_by_user_id" toml:"added_by_user_id" yaml:"added_by_user_id"`
+	AddedAt         time.Time `boil:"added_at" json:"added_at" toml:"added_at" yaml:"added_at"`
+
+	R *savedPlaylistSongR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L savedPlaylistSongL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var SavedPlaylistSongColumns = struct {
+	ID              string
+	SavedPlaylistID string
+	SongID          string
+	Position        string
+	AddedByUserID   string
+	AddedAt         string
+}{
+	ID:              "id",
+	SavedPlaylistID: "saved_playlist_id",
+	SongID:          "song_id",
+	Position:        "position",
+	AddedByUserID:   "added_by_user_id",
+	AddedAt:         "added_at",
+}
+
+var SavedPlaylistSongTableColumns = struct {
+	ID              string
+	SavedPlaylistID string
+	SongID          string
+	Position        string
+	AddedByUserID   string
+	AddedAt         string
+}{
+	ID:              "saved_playlist_song.id",
+	SavedPlaylistID: "saved_playlist_song.saved_playlist_id",
+	SongID:          "saved_playlist_song.song_id",
+	Position:        "saved_playlist_song.position",
+	AddedByUserID:   "saved_playlist_song.added_by_user_id",
+	AddedAt:         "saved_playlist_song.added_at",
+}
+
+// Generated where
+
+var SavedPlaylistSongWhere = struct {
+	ID              whereHelperint64
+	SavedPlaylistID whereHelperint64
+	SongID          whereHelperstring
+	Position        whereHelperint
+	AddedByUserID   whereHelperstring
+	AddedAt         whereHelpertime_Time
+}{
+	ID:              whereHelperint64{field: "\"saved_playlist_song\".\"id\""},
+	SavedPlaylistID: whereHelperint64{field: "\"saved_playlist_song\".\"saved_playlist_id\""},
+	SongID:          whereHelperstring{field: "\"saved_playlist_song\".\"song_id\""},
+	Position:        whereHelperint{field: "\"saved_playlist_song\".\"position\""},
+	AddedByUserID:   whereHelperstring{field: "\"saved_playlist_song\".\"added_by_user_id\""},
+	AddedAt:         whereHelpertime_Time{field: "\"saved_playlist_song\".\"added_at\""},
+}
+
+// SavedPlaylistSongRels is where relationship names are stored.
+var SavedPlaylistSongRels = struct {
+	SavedPlaylist string
+	Song          string
+}{
+	SavedPlaylist: "SavedPlaylist",
+	Song:          "Song",
+}
+
+// savedPlaylistSongR is where relationships are stored.
+type savedPlaylistSongR struct {
+	SavedPlaylist *SavedPlaylist `boil:"SavedPlaylist" json:"SavedPlaylist" toml:"SavedPlaylist" yaml:"SavedPlaylist"`
+	Song          *Song          `boil:"Song" json:"Song" toml:"Song" yaml:"Song"`
+}
+
+// NewStruct creates a new relationship struct
+func (*savedPlaylistSongR) NewStruct() *savedPlaylistSongR {
+	return &savedPlaylistSongR{}
+}
+
+func (r *savedPlaylistSongR) GetSavedPlaylist() *SavedPlaylist {
+	if r == nil {
+		return nil
+	}
+	return r.SavedPlaylist
+}
+
+func (r *savedPlaylistSongR) GetSong() *Song {
+	if r == nil {
+		return nil
+	}
+	return r.Song
+}
+
+// savedPlaylistSongL is where Load methods for each relationship are stored.
+type savedPlaylistSongL struct{}
+
+var (
+	savedPlaylistSongAllColumns            = []string{"id", "saved_playlist_id", "song_id", "position", "added_by_user_id", "added_at"}
+	savedPlaylistSongColumnsWithoutDefault = []string{"saved_playlist_id", "song_id", "position", "added_by_user_id"}
+	savedPlaylistSongColumnsWithDefault    = []string{"id", "added_at"}
+	savedPlaylistSongPrimaryKeyColumns     = []string{"id"}
+	savedPlaylistSongGeneratedColumns      = []string{}
+)
+
+type (
+	// SavedPlaylistSongSlice is an alias for a slice of pointers to SavedPlaylistSong.
+	// This should almost always be used instead of []SavedPlaylistSong.
+	SavedPlaylistSongSlice []*SavedPlaylistSong
+	// SavedPlaylistSongHook is the signature for custom SavedPlaylistSong hook methods
+	SavedPlaylistSongHook func(context.Context, boil.ContextExecutor, *SavedPlaylistSong) error
+
+	savedPlaylistSongQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	savedPlaylistSongType                 = reflect.TypeOf(&SavedPlaylistSong{})
+	savedPlaylistSongMapping              = queries.MakeStructMapping(savedPlaylistSongType)
+	savedPlaylistSongPrimaryKeyMapping, _ = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, savedPlaylistSongPrimaryKeyColumns)
+	savedPlaylistSongInsertCacheMut       sync.RWMutex
+	savedPlaylistSongInsertCache          = make(map[string]insertCache)
+	savedPlaylistSongUpdateCacheMut       sync.RWMutex
+	savedPlaylistSongUpdateCache          = make(map[string]updateCache)
+	savedPlaylistSongUpsertCacheMut       sync.RWMutex
+	savedPlaylistSongUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var savedPlaylistSongAfterSelectHooks []SavedPlaylistSongHook
+
+var savedPlaylistSongBeforeInsertHooks []SavedPlaylistSongHook
+var savedPlaylistSongAfterInsertHooks []SavedPlaylistSongHook
+
+var savedPlaylistSongBeforeUpdateHooks []SavedPlaylistSongHook
+var savedPlaylistSongAfterUpdateHooks []SavedPlaylistSongHook
+
+var savedPlaylistSongBeforeDeleteHooks []SavedPlaylistSongHook
+var savedPlaylistSongAfterDeleteHooks []SavedPlaylistSongHook
+
+var savedPlaylistSongBeforeUpsertHooks []SavedPlaylistSongHook
+var savedPlaylistSongAfterUpsertHooks []SavedPlaylistSongHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *SavedPlaylistSong) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *SavedPlaylistSong) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *SavedPlaylistSong) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *SavedPlaylistSong) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *SavedPlaylistSong) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *SavedPlaylistSong) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *SavedPlaylistSong) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *SavedPlaylistSong) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *SavedPlaylistSong) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range savedPlaylistSongAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSavedPlaylistSongHook registers your hook function for all future operations.
+func AddSavedPlaylistSongHook(hookPoint boil.HookPoint, savedPlaylistSongHook SavedPlaylistSongHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		savedPlaylistSongAfterSelectHooks = append(savedPlaylistSongAfterSelectHooks, savedPlaylistSongHook)
+	case boil.BeforeInsertHook:
+		savedPlaylistSongBeforeInsertHooks = append(savedPlaylistSongBeforeInsertHooks, savedPlaylistSongHook)
+	case boil.AfterInsertHook:
+		savedPlaylistSongAfterInsertHooks = append(savedPlaylistSongAfterInsertHooks, savedPlaylistSongHook)
+	case boil.BeforeUpdateHook:
+		savedPlaylistSongBeforeUpdateHooks = append(savedPlaylistSongBeforeUpdateHooks, savedPlaylistSongHook)
+	case boil.AfterUpdateHook:
+		savedPlaylistSongAfterUpdateHooks = append(savedPlaylistSongAfterUpdateHooks, savedPlaylistSongHook)
+	case boil.BeforeDeleteHook:
+		savedPlaylistSongBeforeDeleteHooks = append(savedPlaylistSongBeforeDeleteHooks, savedPlaylistSongHook)
+	case boil.AfterDeleteHook:
+		savedPlaylistSongAfterDeleteHooks = append(savedPlaylistSongAfterDeleteHooks, savedPlaylistSongHook)
+	case boil.BeforeUpsertHook:
+		savedPlaylistSongBeforeUpsertHooks = append(savedPlaylistSongBeforeUpsertHooks, savedPlaylistSongHook)
+	case boil.AfterUpsertHook:
+		savedPlaylistSongAfterUpsertHooks = append(savedPlaylistSongAfterUpsertHooks, savedPlaylistSongHook)
+	}
+}
+
+// One returns a single savedPlaylistSong record from the query.
+func (q savedPlaylistSongQuery) One(ctx context.Context, exec boil.ContextExecutor) (*SavedPlaylistSong, error) {
+	o := &SavedPlaylistSong{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for saved_playlist_song")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all SavedPlaylistSong records from the query.
+func (q savedPlaylistSongQuery) All(ctx context.Context, exec boil.ContextExecutor) (SavedPlaylistSongSlice, error) {
+	var o []*SavedPlaylistSong
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to SavedPlaylistSong slice")
+	}
+
+	if len(savedPlaylistSongAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all SavedPlaylistSong records in the query.
+func (q savedPlaylistSongQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count saved_playlist_song rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q savedPlaylistSongQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if saved_playlist_song exists")
+	}
+
+	return count > 0, nil
+}
+
+// SavedPlaylist pointed to by the foreign key.
+func (o *SavedPlaylistSong) SavedPlaylist(mods ...qm.QueryMod) savedPlaylistQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SavedPlaylistID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SavedPlaylists(queryMods...)
+}
+
+// Song pointed to by the foreign key.
+func (o *SavedPlaylistSong) Song(mods ...qm.QueryMod) songQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SongID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Songs(queryMods...)
+}
+
+// LoadSavedPlaylist allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (savedPlaylistSongL) LoadSavedPlaylist(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSavedPlaylistSong interface{}, mods queries.Applicator) error {
+	var slice []*SavedPlaylistSong
+	var object *SavedPlaylistSong
+
+	if singular {
+		var ok bool
+		object, ok = maybeSavedPlaylistSong.(*SavedPlaylistSong)
+		if !ok {
+			object = new(SavedPlaylistSong)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSavedPlaylistSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSavedPlaylistSong))
+			}
+		}
+	} else {
+		s, ok := maybeSavedPlaylistSong.(*[]*SavedPlaylistSong)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSavedPlaylistSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSavedPlaylistSong))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &savedPlaylistSongR{}
+		}
+		args = append(args, object.SavedPlaylistID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &savedPlaylistSongR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SavedPlaylistID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SavedPlaylistID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`saved_playlist`),
+		qm.WhereIn(`saved_playlist.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SavedPlaylist")
+	}
+
+	var resultSlice []*SavedPlaylist
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SavedPlaylist")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for saved_playlist")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saved_playlist")
+	}
+
+	if len(savedPlaylistAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SavedPlaylist = foreign
+		if foreign.R == nil {
+			foreign.R = &savedPlaylistR{}
+		}
+		foreign.R.SavedPlaylistSongs = append(foreign.R.SavedPlaylistSongs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SavedPlaylistID == foreign.ID {
+				local.R.SavedPlaylist = foreign
+				if foreign.R == nil {
+					foreign.R = &savedPlaylistR{}
+				}
+				foreign.R.SavedPlaylistSongs = append(foreign.R.SavedPlaylistSongs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSong allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (savedPlaylistSongL) LoadSong(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSavedPlaylistSong interface{}, mods queries.Applicator) error {
+	var slice []*SavedPlaylistSong
+	var object *SavedPlaylistSong
+
+	if singular {
+		var ok bool
+		object, ok = maybeSavedPlaylistSong.(*SavedPlaylistSong)
+		if !ok {
+			object = new(SavedPlaylistSong)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSavedPlaylistSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSavedPlaylistSong))
+			}
+		}
+	} else {
+		s, ok := maybeSavedPlaylistSong.(*[]*SavedPlaylistSong)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSavedPlaylistSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSavedPlaylistSong))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &savedPlaylistSongR{}
+		}
+		args = append(args, object.SongID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &savedPlaylistSongR{}
+			}
+
+			for _, a := range args {
+				if a == obj.SongID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.SongID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`song`),
+		qm.WhereIn(`song.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Song")
+	}
+
+	var resultSlice []*Song
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Song")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for song")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for song")
+	}
+
+	if len(songAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Song = foreign
+		if foreign.R == nil {
+			foreign.R = &songR{}
+		}
+		foreign.R.SavedPlaylistSongs = append(foreign.R.SavedPlaylistSongs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SongID == foreign.ID {
+				local.R.Song = foreign
+				if foreign.R == nil {
+					foreign.R = &songR{}
+				}
+				foreign.R.SavedPlaylistSongs = append(foreign.R.SavedPlaylistSongs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSavedPlaylist of the savedPlaylistSong to the related item.
+// Sets o.R.SavedPlaylist to related.
+// Adds o to related.R.SavedPlaylistSongs.
+func (o *SavedPlaylistSong) SetSavedPlaylist(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SavedPlaylist) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"saved_playlist_id"}),
+		strmangle.WhereClause("\"", "\"", 2, savedPlaylistSongPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SavedPlaylistID = related.ID
+	if o.R == nil {
+		o.R = &savedPlaylistSongR{
+			SavedPlaylist: related,
+		}
+	} else {
+		o.R.SavedPlaylist = related
+	}
+
+	if related.R == nil {
+		related.R = &savedPlaylistR{
+			SavedPlaylistSongs: SavedPlaylistSongSlice{o},
+		}
+	} else {
+		related.R.SavedPlaylistSongs = append(related.R.SavedPlaylistSongs, o)
+	}
+
+	return nil
+}
+
+// SetSong of the savedPlaylistSong to the related item.
+// Sets o.R.Song to related.
+// Adds o to related.R.SavedPlaylistSongs.
+func (o *SavedPlaylistSong) SetSong(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Song) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"song_id"}),
+		strmangle.WhereClause("\"", "\"", 2, savedPlaylistSongPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SongID = related.ID
+	if o.R == nil {
+		o.R = &savedPlaylistSongR{
+			Song: related,
+		}
+	} else {
+		o.R.Song = related
+	}
+
+	if related.R == nil {
+		related.R = &songR{
+			SavedPlaylistSongs: SavedPlaylistSongSlice{o},
+		}
+	} else {
+		related.R.SavedPlaylistSongs = append(related.R.SavedPlaylistSongs, o)
+	}
+
+	return nil
+}
+
+// SavedPlaylistSongs retrieves all the records using an executor.
+func SavedPlaylistSongs(mods ...qm.QueryMod) savedPlaylistSongQuery {
+	mods = append(mods, qm.From("\"saved_playlist_song\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"saved_playlist_song\".*"})
+	}
+
+	return savedPlaylistSongQuery{q}
+}
+
+// FindSavedPlaylistSong retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindSavedPlaylistSong(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*SavedPlaylistSong, error) {
+	savedPlaylistSongObj := &SavedPlaylistSong{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"saved_playlist_song\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, savedPlaylistSongObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from saved_playlist_song")
+	}
+
+	if err = savedPlaylistSongObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return savedPlaylistSongObj, err
+	}
+
+	return savedPlaylistSongObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *SavedPlaylistSong) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no saved_playlist_song provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(savedPlaylistSongColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	savedPlaylistSongInsertCacheMut.RLock()
+	cache, cached := savedPlaylistSongInsertCache[key]
+	savedPlaylistSongInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			savedPlaylistSongAllColumns,
+			savedPlaylistSongColumnsWithDefault,
+			savedPlaylistSongColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"saved_playlist_song\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"saved_playlist_song\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into saved_playlist_song")
+	}
+
+	if !cached {
+		savedPlaylistSongInsertCacheMut.Lock()
+		savedPlaylistSongInsertCache[key] = cache
+		savedPlaylistSongInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the SavedPlaylistSong.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *SavedPlaylistSong) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	savedPlaylistSongUpdateCacheMut.RLock()
+	cache, cached := savedPlaylistSongUpdateCache[key]
+	savedPlaylistSongUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			savedPlaylistSongAllColumns,
+			savedPlaylistSongPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update saved_playlist_song, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, savedPlaylistSongPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, append(wl, savedPlaylistSongPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update saved_playlist_song row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for saved_playlist_song")
+	}
+
+	if !cached {
+		savedPlaylistSongUpdateCacheMut.Lock()
+		savedPlaylistSongUpdateCache[key] = cache
+		savedPlaylistSongUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q savedPlaylistSongQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for saved_playlist_song")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for saved_playlist_song")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o SavedPlaylistSongSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistSongPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, savedPlaylistSongPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in savedPlaylistSong slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all savedPlaylistSong")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *SavedPlaylistSong) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no saved_playlist_song provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(savedPlaylistSongColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	savedPlaylistSongUpsertCacheMut.RLock()
+	cache, cached := savedPlaylistSongUpsertCache[key]
+	savedPlaylistSongUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			savedPlaylistSongAllColumns,
+			savedPlaylistSongColumnsWithDefault,
+			savedPlaylistSongColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			savedPlaylistSongAllColumns,
+			savedPlaylistSongPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert saved_playlist_song, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(savedPlaylistSongPrimaryKeyColumns))
+			copy(conflict, savedPlaylistSongPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"saved_playlist_song\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(savedPlaylistSongType, savedPlaylistSongMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert saved_playlist_song")
+	}
+
+	if !cached {
+		savedPlaylistSongUpsertCacheMut.Lock()
+		savedPlaylistSongUpsertCache[key] = cache
+		savedPlaylistSongUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single SavedPlaylistSong record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *SavedPlaylistSong) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no SavedPlaylistSong provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), savedPlaylistSongPrimaryKeyMapping)
+	sql := "DELETE FROM \"saved_playlist_song\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from saved_playlist_song")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for saved_playlist_song")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q savedPlaylistSongQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no savedPlaylistSongQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from saved_playlist_song")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saved_playlist_song")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o SavedPlaylistSongSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(savedPlaylistSongBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistSongPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"saved_playlist_song\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, savedPlaylistSongPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from savedPlaylistSong slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for saved_playlist_song")
+	}
+
+	if len(savedPlaylistSongAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *SavedPlaylistSong) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindSavedPlaylistSong(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *SavedPlaylistSongSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := SavedPlaylistSongSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), savedPlaylistSongPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"saved_playlist_song\".* FROM \"saved_playlist_song\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, savedPlaylistSongPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in SavedPlaylistSongSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// SavedPlaylistSongExists checks if the SavedPlaylistSong row exists.
+func SavedPlaylistSongExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"saved_playlist_song\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if saved_playlist_song exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the SavedPlaylistSong row exists.
+func (o *SavedPlaylistSong) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return SavedPlaylistSongExists(ctx, exec, o.ID)
+}
@@ -24,17 +24,22 @@ import (
 
 // Song is an object representing the database table.
 type Song struct {
-	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Platform          null.String `boil:"platform" json:"platform,omitempty" toml:"platform" yaml:"platform,omitempty"`
-	SongName          string      `boil:"song_name" json:"song_name" toml:"song_name" yaml:"song_name"`
-	Description       null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
-	URL               string      `boil:"url" json:"url" toml:"url" yaml:"url"`
-	DurationInSeconds null.Int    `boil:"duration_in_seconds" json:"duration_in_seconds,omitempty" toml:"duration_in_seconds" yaml:"duration_in_seconds,omitempty"`
-	IsStream          bool        `boil:"is_stream" json:"is_stream" toml:"is_stream" yaml:"is_stream"`
-	ThumbnailURL      null.String `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
-	Artist            null.String `boil:"artist" json:"artist,omitempty" toml:"artist" yaml:"artist,omitempty"`
-	Album             null.String `boil:"album" json:"album,omitempty" toml:"album" yaml:"album,omitempty"`
-	Track             null.String `boil:"track" json:"track,omitempty" toml:"track" yaml:"track,omitempty"`
+	ID                string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Platform          null.String  `boil:"platform" json:"platform,omitempty" toml:"platform" yaml:"platform,omitempty"`
+	SongName          string       `boil:"song_name" json:"song_name" toml:"song_name" yaml:"song_name"`
+	Description       null.String  `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	URL               string       `boil:"url" json:"url" toml:"url" yaml:"url"`
+	DurationInSeconds null.Int     `boil:"duration_in_seconds" json:"duration_in_seconds,omitempty" toml:"duration_in_seconds" yaml:"duration_in_seconds,omitempty"`
+	IsStream          bool         `boil:"is_stream" json:"is_stream" toml:"is_stream" yaml:"is_stream"`
+	ThumbnailURL      null.String  `boil:"thumbnail_url" json:"thumbnail_url,omitempty" toml:"thumbnail_url" yaml:"thumbnail_url,omitempty"`
+	Artist            null.String  `boil:"artist" json:"artist,omitempty" toml:"artist" yaml:"artist,omitempty"`
+	Album             null.String  `boil:"album" json:"album,omitempty" toml:"album" yaml:"album,omitempty"`
+	Track             null.String  `boil:"track" json:"track,omitempty" toml:"track" yaml:"track,omitempty"`
+	ChannelID         null.String  `boil:"channel_id" json:"channel_id,omitempty" toml:"channel_id" yaml:"channel_id,omitempty"`
+	Uploader          null.String  `boil:"uploader" json:"uploader,omitempty" toml:"uploader" yaml:"uploader,omitempty"`
+	LibraryModifiedAt null.Time    `boil:"library_modified_at" json:"library_modified_at,omitempty" toml:"library_modified_at" yaml:"library_modified_at,omitempty"`
+	LoudnessLufs      null.Float32 `boil:"loudness_lufs" json:"loudness_lufs,omitempty" toml:"loudness_lufs" yaml:"loudness_lufs,omitempty"`
+	LoudnessGainDB    null.Float32 `boil:"loudness_gain_db" json:"loudness_gain_db,omitempty" toml:"loudness_gain_db" yaml:"loudness_gain_db,omitempty"`
 
 	R *songR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L songL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -52,6 +57,11 @@ var SongColumns = struct {
 	Artist            string
 	Album             string
 	Track             string
+	ChannelID         string
+	Uploader          string
+	LibraryModifiedAt string
+	LoudnessLufs      string
+	LoudnessGainDB    string
 }{
 	ID:                "id",
 	Platform:          "platform",
@@ -64,6 +74,11 @@ var SongColumns = struct {
 	Artist:            "artist",
 	Album:             "album",
 	Track:             "track",
+	ChannelID:         "channel_id",
+	Uploader:          "uploader",
+	LibraryModifiedAt: "library_modified_at",
+	LoudnessLufs:      "loudness_lufs",
+	LoudnessGainDB:    "loudness_gain_db",
 }
 
 var SongTableColumns = struct {
@@ -78,6 +93,11 @@ var SongTableColumns = struct {
 	Artist            string
 	Album             string
 	Track             string
+	ChannelID         string
+	Uploader          string
+	LibraryModifiedAt string
+	LoudnessLufs      string
+	LoudnessGainDB    string
 }{
 	ID:                "song.id",
 	Platform:          "song.platform",
@@ -90,38 +110,43 @@ var SongTableColumns = struct {
 	Artist:            "song.artist",
 	Album:             "song.album",
 	Track:             "song.track",
+	ChannelID:         "song.channel_id",
+	Uploader:          "song.uploader",
+	LibraryModifiedAt: "song.library_modified_at",
+	LoudnessLufs:      "song.loudness_lufs",
+	LoudnessGainDB:    "song.loudness_gain_db",
 }
 
 // Generated where
 
-type whereHelpernull_Int struct{ field string }
+type whereHelpernull_Float32 struct{ field string }
 
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) EQ(x null.Float32) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) NEQ(x null.Float32) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) LT(x null.Float32) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) LTE(x null.Float32) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) GT(x null.Float32) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+func (w whereHelpernull_Float32) GTE(x null.Float32) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Float32) IN(slice []float32) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+func (w whereHelpernull_Float32) NIN(slice []float32) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -129,8 +154,8 @@ func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Float32) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float32) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var SongWhere = struct {
 	ID                whereHelperstring
@@ -144,6 +169,11 @@ var SongWhere = struct {
 	Artist            whereHelpernull_String
 	Album             whereHelpernull_String
 	Track             whereHelpernull_String
+	ChannelID         whereHelpernull_String
+	Uploader          whereHelpernull_String
+	LibraryModifiedAt whereHelpernull_Time
+	LoudnessLufs      whereHelpernull_Float32
+	LoudnessGainDB    whereHelpernull_Float32
 }{
 	ID:                whereHelperstring{field: "\"song\".\"id\""},
 	Platform:          whereHelpernull_String{field: "\"song\".\"platform\""},
@@ -156,18 +186,26 @@ var SongWhere = struct {
 	Artist:            whereHelpernull_String{field: "\"song\".\"artist\""},
 	Album:             whereHelpernull_String{field: "\"song\".\"album\""},
 	Track:             whereHelpernull_String{field: "\"song\".\"track\""},
+	ChannelID:         whereHelpernull_String{field: "\"song\".\"channel_id\""},
+	Uploader:          whereHelpernull_String{field: "\"song\".\"uploader\""},
+	LibraryModifiedAt: whereHelpernull_Time{field: "\"song\".\"library_modified_at\""},
+	LoudnessLufs:      whereHelpernull_Float32{field: "\"song\".\"loudness_lufs\""},
+	LoudnessGainDB:    whereHelpernull_Float32{field: "\"song\".\"loudness_gain_db\""},
 }
 
 // SongRels is where relationship names are stored.
 var SongRels = struct {
-	SongRequests string
+	SavedPlaylistSongs string
+	SongRequests       string
 }{
-	SongRequests: "SongRequests",
+	SavedPlaylistSongs: "SavedPlaylistSongs",
+	SongRequests:       "SongRequests",
 }
 
 // songR is where relationships are stored.
 type songR struct {
-	SongRequests SongRequestSlice `boil:"SongRequests" json:"SongRequests" toml:"SongRequests" yaml:"SongRequests"`
+	SavedPlaylistSongs SavedPlaylistSongSlice `boil:"SavedPlaylistSongs" json:"SavedPlaylistSongs" toml:"SavedPlaylistSongs" yaml:"SavedPlaylistSongs"`
+	SongRequests       SongRequestSlice       `boil:"SongRequests" json:"SongRequests" toml:"SongRequests" yaml:"SongRequests"`
 }
 
 // NewStruct creates a new relationship struct
@@ -175,6 +213,13 @@ func (*songR) NewStruct() *songR {
 	return &songR{}
 }
 
+func (r *songR) GetSavedPlaylistSongs() SavedPlaylistSongSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SavedPlaylistSongs
+}
+
 func (r *songR) GetSongRequests() SongRequestSlice {
 	if r == nil {
 		return nil
@@ -186,9 +231,9 @@ func (r *songR) GetSongRequests() SongRequestSlice {
 type songL struct{}
 
 var (
-	songAllColumns            = []string{"id", "platform", "song_name", "description", "url", "duration_in_seconds", "is_stream", "thumbnail_url", "artist", "album", "track"}
+	songAllColumns            = []string{"id", "platform", "song_name", "description", "url", "duration_in_seconds", "is_stream", "thumbnail_url", "artist", "album", "track", "channel_id", "uploader", "library_modified_at", "loudness_lufs", "loudness_gain_db"}
 	songColumnsWithoutDefault = []string{"id", "song_name", "url", "is_stream"}
-	songColumnsWithDefault    = []string{"platform", "description", "duration_in_seconds", "thumbnail_url", "artist", "album", "track"}
+	songColumnsWithDefault    = []string{"platform", "description", "duration_in_seconds", "thumbnail_url", "artist", "album", "track", "channel_id", "uploader", "library_modified_at", "loudness_lufs", "loudness_gain_db"}
 	songPrimaryKeyColumns     = []string{"id"}
 	songGeneratedColumns      = []string{}
 )
@@ -471,6 +516,20 @@ func (q songQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// SavedPlaylistSongs retrieves all the saved_playlist_song's SavedPlaylistSongs with an executor.
+func (o *Song) SavedPlaylistSongs(mods ...qm.QueryMod) savedPlaylistSongQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"saved_playlist_song\".\"song_id\"=?", o.ID),
+	)
+
+	return SavedPlaylistSongs(queryMods...)
+}
+
 // SongRequests retrieves all the song_request's SongRequests with an executor.
 func (o *Song) SongRequests(mods ...qm.QueryMod) songRequestQuery {
 	var queryMods []qm.QueryMod
@@ -485,6 +544,120 @@ func (o *Song) SongRequests(mods ...qm.QueryMod) songRequestQuery {
 	return SongRequests(queryMods...)
 }
 
+// LoadSavedPlaylistSongs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (songL) LoadSavedPlaylistSongs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSong interface{}, mods queries.Applicator) error {
+	var slice []*Song
+	var object *Song
+
+	if singular {
+		var ok bool
+		object, ok = maybeSong.(*Song)
+		if !ok {
+			object = new(Song)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSong))
+			}
+		}
+	} else {
+		s, ok := maybeSong.(*[]*Song)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSong)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSong))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &songR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &songR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`saved_playlist_song`),
+		qm.WhereIn(`saved_playlist_song.song_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load saved_playlist_song")
+	}
+
+	var resultSlice []*SavedPlaylistSong
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice saved_playlist_song")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on saved_playlist_song")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saved_playlist_song")
+	}
+
+	if len(savedPlaylistSongAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SavedPlaylistSongs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &savedPlaylistSongR{}
+			}
+			foreign.R.Song = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SongID {
+				local.R.SavedPlaylistSongs = append(local.R.SavedPlaylistSongs, foreign)
+				if foreign.R == nil {
+					foreign.R = &savedPlaylistSongR{}
+				}
+				foreign.R.Song = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSongRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (songL) LoadSongRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSong interface{}, mods queries.Applicator) error {
@@ -599,6 +772,59 @@ func (songL) LoadSongRequests(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// AddSavedPlaylistSongs adds the given related objects to the existing relationships
+// of the song, optionally inserting them as new records.
+// Appends related to o.R.SavedPlaylistSongs.
+// Sets related.R.Song appropriately.
+func (o *Song) AddSavedPlaylistSongs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SavedPlaylistSong) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SongID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"saved_playlist_song\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"song_id"}),
+				strmangle.WhereClause("\"", "\"", 2, savedPlaylistSongPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SongID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &songR{
+			SavedPlaylistSongs: related,
+		}
+	} else {
+		o.R.SavedPlaylistSongs = append(o.R.SavedPlaylistSongs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &savedPlaylistSongR{
+				Song: o,
+			}
+		} else {
+			rel.R.Song = o
+		}
+	}
+	return nil
+}
+
 // AddSongRequests adds the given related objects to the existing relationships
 // of the song, optionally inserting them as new records.
 // Appends related to o.R.SongRequests.
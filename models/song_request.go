@@ -24,93 +24,128 @@ import (
 
 // SongRequest is an object representing the database table.
 type SongRequest struct {
-	ID                int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	SongID            null.String `boil:"song_id" json:"song_id,omitempty" toml:"song_id" yaml:"song_id,omitempty"`
-	SongName          string      `boil:"song_name" json:"song_name" toml:"song_name" yaml:"song_name"`
-	RequestedByUserID string      `boil:"requested_by_user_id" json:"requested_by_user_id" toml:"requested_by_user_id" yaml:"requested_by_user_id"`
-	UsernameAtTime    string      `boil:"username_at_time" json:"username_at_time" toml:"username_at_time" yaml:"username_at_time"`
-	GuildID           string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
-	GuildNameAtTime   string      `boil:"guild_name_at_time" json:"guild_name_at_time" toml:"guild_name_at_time" yaml:"guild_name_at_time"`
-	RequestedAt       time.Time   `boil:"requested_at" json:"requested_at" toml:"requested_at" yaml:"requested_at"`
-	PlayedAt          null.Time   `boil:"played_at" json:"played_at,omitempty" toml:"played_at" yaml:"played_at,omitempty"`
-	Played            bool        `boil:"played" json:"played" toml:"played" yaml:"played"`
+	ID                 int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	SongID             null.String `boil:"song_id" json:"song_id,omitempty" toml:"song_id" yaml:"song_id,omitempty"`
+	SongName           string      `boil:"song_name" json:"song_name" toml:"song_name" yaml:"song_name"`
+	RequestedByUserID  string      `boil:"requested_by_user_id" json:"requested_by_user_id" toml:"requested_by_user_id" yaml:"requested_by_user_id"`
+	UsernameAtTime     string      `boil:"username_at_time" json:"username_at_time" toml:"username_at_time" yaml:"username_at_time"`
+	GuildID            string      `boil:"guild_id" json:"guild_id" toml:"guild_id" yaml:"guild_id"`
+	GuildNameAtTime    string      `boil:"guild_name_at_time" json:"guild_name_at_time" toml:"guild_name_at_time" yaml:"guild_name_at_time"`
+	RequestedAt        time.Time   `boil:"requested_at" json:"requested_at" toml:"requested_at" yaml:"requested_at"`
+	PlayedAt           null.Time   `boil:"played_at" json:"played_at,omitempty" toml:"played_at" yaml:"played_at,omitempty"`
+	Played             bool        `boil:"played" json:"played" toml:"played" yaml:"played"`
+	Position           int64       `boil:"position" json:"position" toml:"position" yaml:"position"`
+	StartOffsetSeconds int         `boil:"start_offset_seconds" json:"start_offset_seconds" toml:"start_offset_seconds" yaml:"start_offset_seconds"`
+	Autoplay           bool        `boil:"autoplay" json:"autoplay" toml:"autoplay" yaml:"autoplay"`
+	Skipped            bool        `boil:"skipped" json:"skipped" toml:"skipped" yaml:"skipped"`
+	PlayedSeconds      null.Int    `boil:"played_seconds" json:"played_seconds,omitempty" toml:"played_seconds" yaml:"played_seconds,omitempty"`
 
 	R *songRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L songRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SongRequestColumns = struct {
-	ID                string
-	SongID            string
-	SongName          string
-	RequestedByUserID string
-	UsernameAtTime    string
-	GuildID           string
-	GuildNameAtTime   string
-	RequestedAt       string
-	PlayedAt          string
-	Played            string
+	ID                 string
+	SongID             string
+	SongName           string
+	RequestedByUserID  string
+	UsernameAtTime     string
+	GuildID            string
+	GuildNameAtTime    string
+	RequestedAt        string
+	PlayedAt           string
+	Played             string
+	Position           string
+	StartOffsetSeconds string
+	Autoplay           string
+	Skipped            string
+	PlayedSeconds      string
 }{
-	ID:                "id",
-	SongID:            "song_id",
-	SongName:          "song_name",
-	RequestedByUserID: "requested_by_user_id",
-	UsernameAtTime:    "username_at_time",
-	GuildID:           "guild_id",
-	GuildNameAtTime:   "guild_name_at_time",
-	RequestedAt:       "requested_at",
-	PlayedAt:          "played_at",
-	Played:            "played",
+	ID:                 "id",
+	SongID:             "song_id",
+	SongName:           "song_name",
+	RequestedByUserID:  "requested_by_user_id",
+	UsernameAtTime:     "username_at_time",
+	GuildID:            "guild_id",
+	GuildNameAtTime:    "guild_name_at_time",
+	RequestedAt:        "requested_at",
+	PlayedAt:           "played_at",
+	Played:             "played",
+	Position:           "position",
+	StartOffsetSeconds: "start_offset_seconds",
+	Autoplay:           "autoplay",
+	Skipped:            "skipped",
+	PlayedSeconds:      "played_seconds",
 }
 
 var SongRequestTableColumns = struct {
-	ID                string
-	SongID            string
-	SongName          string
-	RequestedByUserID string
-	UsernameAtTime    string
-	GuildID           string
-	GuildNameAtTime   string
-	RequestedAt       string
-	PlayedAt          string
-	Played            string
+	ID                 string
+	SongID             string
+	SongName           string
+	RequestedByUserID  string
+	UsernameAtTime     string
+	GuildID            string
+	GuildNameAtTime    string
+	RequestedAt        string
+	PlayedAt           string
+	Played             string
+	Position           string
+	StartOffsetSeconds string
+	Autoplay           string
+	Skipped            string
+	PlayedSeconds      string
 }{
-	ID:                "song_request.id",
-	SongID:            "song_request.song_id",
-	SongName:          "song_request.song_name",
-	RequestedByUserID: "song_request.requested_by_user_id",
-	UsernameAtTime:    "song_request.username_at_time",
-	GuildID:           "song_request.guild_id",
-	GuildNameAtTime:   "song_request.guild_name_at_time",
-	RequestedAt:       "song_request.requested_at",
-	PlayedAt:          "song_request.played_at",
-	Played:            "song_request.played",
+	ID:                 "song_request.id",
+	SongID:             "song_request.song_id",
+	SongName:           "song_request.song_name",
+	RequestedByUserID:  "song_request.requested_by_user_id",
+	UsernameAtTime:     "song_request.username_at_time",
+	GuildID:            "song_request.guild_id",
+	GuildNameAtTime:    "song_request.guild_name_at_time",
+	RequestedAt:        "song_request.requested_at",
+	PlayedAt:           "song_request.played_at",
+	Played:             "song_request.played",
+	Position:           "song_request.position",
+	StartOffsetSeconds: "song_request.start_offset_seconds",
+	Autoplay:           "song_request.autoplay",
+	Skipped:            "song_request.skipped",
+	PlayedSeconds:      "song_request.played_seconds",
 }
 
 // Generated where
 
 var SongRequestWhere = struct {
-	ID                whereHelperint64
-	SongID            whereHelpernull_String
-	SongName          whereHelperstring
-	RequestedByUserID whereHelperstring
-	UsernameAtTime    whereHelperstring
-	GuildID           whereHelperstring
-	GuildNameAtTime   whereHelperstring
-	RequestedAt       whereHelpertime_Time
-	PlayedAt          whereHelpernull_Time
-	Played            whereHelperbool
+	ID                 whereHelperint64
+	SongID             whereHelpernull_String
+	SongName           whereHelperstring
+	RequestedByUserID  whereHelperstring
+	UsernameAtTime     whereHelperstring
+	GuildID            whereHelperstring
+	GuildNameAtTime    whereHelperstring
+	RequestedAt        whereHelpertime_Time
+	PlayedAt           whereHelpernull_Time
+	Played             whereHelperbool
+	Position           whereHelperint64
+	StartOffsetSeconds whereHelperint
+	Autoplay           whereHelperbool
+	Skipped            whereHelperbool
+	PlayedSeconds      whereHelpernull_Int
 }{
-	ID:                whereHelperint64{field: "\"song_request\".\"id\""},
-	SongID:            whereHelpernull_String{field: "\"song_request\".\"song_id\""},
-	SongName:          whereHelperstring{field: "\"song_request\".\"song_name\""},
-	RequestedByUserID: whereHelperstring{field: "\"song_request\".\"requested_by_user_id\""},
-	UsernameAtTime:    whereHelperstring{field: "\"song_request\".\"username_at_time\""},
-	GuildID:           whereHelperstring{field: "\"song_request\".\"guild_id\""},
-	GuildNameAtTime:   whereHelperstring{field: "\"song_request\".\"guild_name_at_time\""},
-	RequestedAt:       whereHelpertime_Time{field: "\"song_request\".\"requested_at\""},
-	PlayedAt:          whereHelpernull_Time{field: "\"song_request\".\"played_at\""},
-	Played:            whereHelperbool{field: "\"song_request\".\"played\""},
+	ID:                 whereHelperint64{field: "\"song_request\".\"id\""},
+	SongID:             whereHelpernull_String{field: "\"song_request\".\"song_id\""},
+	SongName:           whereHelperstring{field: "\"song_request\".\"song_name\""},
+	RequestedByUserID:  whereHelperstring{field: "\"song_request\".\"requested_by_user_id\""},
+	UsernameAtTime:     whereHelperstring{field: "\"song_request\".\"username_at_time\""},
+	GuildID:            whereHelperstring{field: "\"song_request\".\"guild_id\""},
+	GuildNameAtTime:    whereHelperstring{field: "\"song_request\".\"guild_name_at_time\""},
+	RequestedAt:        whereHelpertime_Time{field: "\"song_request\".\"requested_at\""},
+	PlayedAt:           whereHelpernull_Time{field: "\"song_request\".\"played_at\""},
+	Played:             whereHelperbool{field: "\"song_request\".\"played\""},
+	Position:           whereHelperint64{field: "\"song_request\".\"position\""},
+	StartOffsetSeconds: whereHelperint{field: "\"song_request\".\"start_offset_seconds\""},
+	Autoplay:           whereHelperbool{field: "\"song_request\".\"autoplay\""},
+	Skipped:            whereHelperbool{field: "\"song_request\".\"skipped\""},
+	PlayedSeconds:      whereHelpernull_Int{field: "\"song_request\".\"played_seconds\""},
 }
 
 // SongRequestRels is where relationship names are stored.
@@ -151,9 +186,9 @@ func (r *songRequestR) GetSong() *Song {
 type songRequestL struct{}
 
 var (
-	songRequestAllColumns            = []string{"id", "song_id", "song_name", "requested_by_user_id", "username_at_time", "guild_id", "guild_name_at_time", "requested_at", "played_at", "played"}
+	songRequestAllColumns            = []string{"id", "song_id", "song_name", "requested_by_user_id", "username_at_time", "guild_id", "guild_name_at_time", "requested_at", "played_at", "played", "position", "start_offset_seconds", "autoplay", "skipped", "played_seconds"}
 	songRequestColumnsWithoutDefault = []string{"song_name", "requested_by_user_id", "username_at_time", "guild_id", "guild_name_at_time"}
-	songRequestColumnsWithDefault    = []string{"id", "song_id", "requested_at", "played_at", "played"}
+	songRequestColumnsWithDefault    = []string{"id", "song_id", "requested_at", "played_at", "played", "position", "start_offset_seconds", "autoplay", "skipped", "played_seconds"}
 	songRequestPrimaryKeyColumns     = []string{"id"}
 	songRequestGeneratedColumns      = []string{}
 )
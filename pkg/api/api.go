@@ -40,6 +40,34 @@ func songRequestModelToProto(songRequestModel *models.SongRequest) *thalassav1.S
 		Played:            songRequestModel.Played,
 		PlayedAt:          timestamppb.New(songRequestModel.PlayedAt.Time),
 		Id:                songRequestModel.ID,
+		Position:          songRequestModel.Position,
+		Autoplay:          songRequestModel.Autoplay,
+	}
+}
+
+func loopModeToProto(mode music.LoopMode) thalassav1.LoopMode {
+	switch mode {
+	case music.LoopTrack:
+		return thalassav1.LoopMode_LOOP_MODE_TRACK
+	case music.LoopQueue:
+		return thalassav1.LoopMode_LOOP_MODE_QUEUE
+	default:
+		return thalassav1.LoopMode_LOOP_MODE_OFF
+	}
+}
+
+func statsPeriodFromProto(period thalassav1.StatsPeriod) discord.StatsPeriod {
+	switch period {
+	case thalassav1.StatsPeriod_STATS_PERIOD_DAY:
+		return discord.StatsDay
+	case thalassav1.StatsPeriod_STATS_PERIOD_WEEK:
+		return discord.StatsWeek
+	case thalassav1.StatsPeriod_STATS_PERIOD_MONTH:
+		return discord.StatsMonth
+	case thalassav1.StatsPeriod_STATS_PERIOD_YEAR:
+		return discord.StatsYear
+	default:
+		return discord.StatsAllTime
 	}
 }
 
@@ -59,6 +87,29 @@ func songModelToProto(songModel *models.Song) *thalassav1.Song {
 	}
 }
 
+func savedPlaylistModelToProto(playlistModel *models.SavedPlaylist, songCount int) *thalassav1.SavedPlaylist {
+	return &thalassav1.SavedPlaylist{
+		Id:              playlistModel.ID,
+		Name:            playlistModel.Name,
+		OwnerUserId:     playlistModel.OwnerUserID.String,
+		Shared:          discord.SavedPlaylistShared(playlistModel),
+		CreatedByUserId: playlistModel.CreatedByUserID,
+		CreatedAt:       timestamppb.New(playlistModel.CreatedAt),
+		UpdatedAt:       timestamppb.New(playlistModel.UpdatedAt),
+		SongCount:       int32(songCount),
+	}
+}
+
+func savedPlaylistSongModelToProto(playlistSongModel *models.SavedPlaylistSong, position int) *thalassav1.SavedPlaylistSong {
+	return &thalassav1.SavedPlaylistSong{
+		Id:            playlistSongModel.ID,
+		Position:      int32(position),
+		Song:          songModelToProto(playlistSongModel.R.Song),
+		AddedByUserId: playlistSongModel.AddedByUserID,
+		AddedAt:       timestamppb.New(playlistSongModel.AddedAt),
+	}
+}
+
 func New(shardInstance *discord.ShardInstance, host, port string) *Instance {
 	return &Instance{
 		ShardInstance: shardInstance,
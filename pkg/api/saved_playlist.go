@@ -0,0 +1,239 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	connect_go "github.com/bufbuild/connect-go"
+	"github.com/rs/zerolog/log"
+
+	thalassav1 "thalassa_discord/gen/go/thalassa/v1"
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+func (inst *Instance) serverInstance(guildID string) (*discord.ServerInstance, error) {
+	inst.ShardInstance.RLock()
+	guild, exists := inst.ShardInstance.ServerInstances[guildID]
+	inst.ShardInstance.RUnlock()
+	if !exists {
+		return nil, connect_go.NewError(connect_go.CodeNotFound, errors.New("guild not found"))
+	}
+	return guild, nil
+}
+
+func savedPlaylistError(err error) error {
+	switch {
+	case errors.Is(err, discord.ErrSavedPlaylistNotFound), errors.Is(err, discord.ErrSavedPlaylistSongNotFound):
+		return connect_go.NewError(connect_go.CodeNotFound, err)
+	case errors.Is(err, discord.ErrSavedPlaylistExists):
+		return connect_go.NewError(connect_go.CodeAlreadyExists, err)
+	case errors.Is(err, discord.ErrSavedPlaylistName):
+		return connect_go.NewError(connect_go.CodeInvalidArgument, err)
+	case errors.Is(err, discord.ErrSavedPlaylistFull):
+		return connect_go.NewError(connect_go.CodeResourceExhausted, err)
+	default:
+		log.Error().Err(err).Msg("Error with saved playlist")
+		return connect_go.NewError(connect_go.CodeInternal, err)
+	}
+}
+
+// editableSavedPlaylist returns the playlist if the user can change it.
+func editableSavedPlaylist(ctx context.Context, guild *discord.ServerInstance, id int64, userID string) (
+	*models.SavedPlaylist, error,
+) {
+	if userID == "" {
+		return nil, connect_go.NewError(connect_go.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	playlist, err := guild.GetSavedPlaylist(ctx, id)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	if !discord.UserCanSeeSavedPlaylist(userID, playlist) {
+		return nil, savedPlaylistError(discord.ErrSavedPlaylistNotFound)
+	}
+	if !guild.UserCanEditSavedPlaylist(userID, playlist) {
+		return nil, connect_go.NewError(connect_go.CodePermissionDenied,
+			errors.New("only the person who created a server playlist and DJs can change it"))
+	}
+	return playlist, nil
+}
+
+// savedPlaylistProto returns the playlist with its song count.
+func savedPlaylistProto(ctx context.Context, guild *discord.ServerInstance, playlist *models.SavedPlaylist) (
+	*thalassav1.SavedPlaylist, error,
+) {
+	counts, err := guild.SavedPlaylistSongCounts(ctx, models.SavedPlaylistSlice{playlist})
+	if err != nil {
+		return nil, err
+	}
+	return savedPlaylistModelToProto(playlist, counts[playlist.ID]), nil
+}
+
+func (inst *Instance) GetSavedPlaylists(ctx context.Context, request *connect_go.Request[thalassav1.GetSavedPlaylistsRequest]) (*connect_go.Response[thalassav1.GetSavedPlaylistsResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlists, err := guild.SavedPlaylists(ctx, request.Msg.GetUserId())
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	counts, err := guild.SavedPlaylistSongCounts(ctx, playlists)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	response := &thalassav1.GetSavedPlaylistsResponse{}
+	for _, playlist := range playlists {
+		response.SavedPlaylists = append(response.SavedPlaylists, savedPlaylistModelToProto(playlist, counts[playlist.ID]))
+	}
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) GetSavedPlaylist(ctx context.Context, request *connect_go.Request[thalassav1.GetSavedPlaylistRequest]) (*connect_go.Response[thalassav1.GetSavedPlaylistResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := guild.GetSavedPlaylist(ctx, request.Msg.GetId())
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	// Personal playlists are private, so other users are told they don't exist.
+	if !discord.UserCanSeeSavedPlaylist(request.Msg.GetUserId(), playlist) {
+		return nil, savedPlaylistError(discord.ErrSavedPlaylistNotFound)
+	}
+	playlistSongs, err := guild.SavedPlaylistSongs(ctx, playlist)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	response := &thalassav1.GetSavedPlaylistResponse{
+		SavedPlaylist: savedPlaylistModelToProto(playlist, len(playlistSongs)),
+	}
+	for index, playlistSong := range playlistSongs {
+		response.Songs = append(response.Songs, savedPlaylistSongModelToProto(playlistSong, index+1))
+	}
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) CreateSavedPlaylist(ctx context.Context, request *connect_go.Request[thalassav1.CreateSavedPlaylistRequest]) (*connect_go.Response[thalassav1.CreateSavedPlaylistResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	if request.Msg.GetUserId() == "" {
+		return nil, connect_go.NewError(connect_go.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	if request.Msg.GetShared() && !guild.MemberIsDJ(request.Msg.GetUserId()) {
+		return nil, connect_go.NewError(connect_go.CodePermissionDenied,
+			errors.New("only DJs can create playlists for the whole server"))
+	}
+	playlist, err := guild.CreateSavedPlaylist(ctx, request.Msg.GetName(), request.Msg.GetUserId(),
+		request.Msg.GetShared())
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	response := &thalassav1.CreateSavedPlaylistResponse{
+		SavedPlaylist: savedPlaylistModelToProto(playlist, 0),
+	}
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) RenameSavedPlaylist(ctx context.Context, request *connect_go.Request[thalassav1.RenameSavedPlaylistRequest]) (*connect_go.Response[thalassav1.RenameSavedPlaylistResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := editableSavedPlaylist(ctx, guild, request.Msg.GetId(), request.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	err = guild.RenameSavedPlaylist(ctx, playlist, request.Msg.GetName())
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	playlistProto, err := savedPlaylistProto(ctx, guild, playlist)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	return connect_go.NewResponse(&thalassav1.RenameSavedPlaylistResponse{SavedPlaylist: playlistProto}), nil
+}
+
+func (inst *Instance) DeleteSavedPlaylist(ctx context.Context, request *connect_go.Request[thalassav1.DeleteSavedPlaylistRequest]) (*connect_go.Response[thalassav1.DeleteSavedPlaylistResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := editableSavedPlaylist(ctx, guild, request.Msg.GetId(), request.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	err = guild.DeleteSavedPlaylist(ctx, playlist)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	return connect_go.NewResponse(&thalassav1.DeleteSavedPlaylistResponse{}), nil
+}
+
+func (inst *Instance) AddSavedPlaylistSong(ctx context.Context, request *connect_go.Request[thalassav1.AddSavedPlaylistSongRequest]) (*connect_go.Response[thalassav1.AddSavedPlaylistSongResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := editableSavedPlaylist(ctx, guild, request.Msg.GetId(), request.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	songInfo, err := music.GetSongInfo(ctx, request.Msg.GetUrl())
+	if err != nil {
+		return nil, connect_go.NewError(connect_go.CodeInvalidArgument, err)
+	}
+	err = guild.CheckSavedPlaylistSong(ctx, request.Msg.GetUserId(), songInfo)
+	var violation *discord.MusicPolicyViolation
+	if errors.As(err, &violation) {
+		return nil, connect_go.NewError(connect_go.CodePermissionDenied, errors.New(violation.Reason))
+	}
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	if songInfo.Thumbnail == "" && len(songInfo.Thumbnails) > 0 {
+		songInfo.Thumbnail = songInfo.Thumbnails[len(songInfo.Thumbnails)-1].URL
+	}
+	song, err := guild.SaveSong(ctx, songInfo)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	playlistSong, err := guild.AddSavedPlaylistSong(ctx, playlist, song, request.Msg.GetUserId())
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	counts, err := guild.SavedPlaylistSongCounts(ctx, models.SavedPlaylistSlice{playlist})
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	response := &thalassav1.AddSavedPlaylistSongResponse{
+		Song: savedPlaylistSongModelToProto(playlistSong, counts[playlist.ID]),
+	}
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) RemoveSavedPlaylistSong(ctx context.Context, request *connect_go.Request[thalassav1.RemoveSavedPlaylistSongRequest]) (*connect_go.Response[thalassav1.RemoveSavedPlaylistSongResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	playlist, err := editableSavedPlaylist(ctx, guild, request.Msg.GetId(), request.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	position := int(request.Msg.GetPosition())
+	removed, err := guild.RemoveSavedPlaylistSong(ctx, playlist, position)
+	if err != nil {
+		return nil, savedPlaylistError(err)
+	}
+	response := &thalassav1.RemoveSavedPlaylistSongResponse{
+		Song: savedPlaylistSongModelToProto(removed, position),
+	}
+	return connect_go.NewResponse(response), nil
+}
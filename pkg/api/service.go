@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	connect_go "github.com/bufbuild/connect-go"
@@ -11,6 +12,7 @@ import (
 
 	thalassav1 "thalassa_discord/gen/go/thalassa/v1"
 	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
 )
 
 func (inst *Instance) GetSongRequests(ctx context.Context, request *connect_go.Request[thalassav1.GetSongRequestsRequest]) (*connect_go.Response[thalassav1.GetSongRequestsResponse], error) {
@@ -19,13 +21,22 @@ func (inst *Instance) GetSongRequests(ctx context.Context, request *connect_go.R
 		limit = int(request.Msg.GetLimit())
 	}
 
-	orderBy := "requested_at asc"
+	// Default to the order the songs will be played in.
+	orderMods := []qm.QueryMod{qm.OrderBy("position asc"), qm.OrderBy("id asc")}
+	inst.ShardInstance.RLock()
+	guild, exists := inst.ShardInstance.ServerInstances[request.Msg.GetGuildId()]
+	inst.ShardInstance.RUnlock()
+	fair := exists && guild.FairQueueEnabled()
 	if request.Msg.GetOrderBy() != "" {
+		fair = false
 		direction := "asc"
 		if request.Msg.GetOrderDesc() {
 			direction = "desc"
 		}
-		orderBy = fmt.Sprintf("%s %s", request.Msg.GetOrderBy(), direction)
+		orderMods = []qm.QueryMod{
+			qm.OrderBy(fmt.Sprintf("%s %s", request.Msg.GetOrderBy(), direction)),
+			qm.OrderBy("id asc"),
+		}
 	}
 
 	offset := 0
@@ -33,19 +44,28 @@ func (inst *Instance) GetSongRequests(ctx context.Context, request *connect_go.R
 		offset = int(request.Msg.GetOffset())
 	}
 
-	songRequestsModel, errGetModels := models.SongRequests(
+	mods := []qm.QueryMod{
 		qm.Where("guild_id = ?", request.Msg.GetGuildId()),
-		qm.OrderBy(orderBy),
-		qm.OrderBy("id asc"),
-		qm.Limit(limit),
-		qm.Offset(offset),
 		qm.And("played = ?", false),
 		qm.Load(models.SongRequestRels.Song),
-	).All(ctx, inst.ShardInstance.Db)
+	}
+	// Fair queue order depends on the whole queue, so it's sorted and paged after loading it.
+	if !fair {
+		mods = append(mods, qm.Limit(limit), qm.Offset(offset))
+	}
+	mods = append(mods, orderMods...)
+	songRequestsModel, errGetModels := models.SongRequests(mods...).All(ctx, inst.ShardInstance.Db)
 	if errGetModels != nil {
 		log.Error().Err(errGetModels).Msgf("Error getting song requests")
 		return nil, connect_go.NewError(connect_go.CodeInternal, errGetModels)
 	}
+	if fair {
+		songRequestsModel, errGetModels = fairSongRequestsPage(ctx, guild, songRequestsModel, offset, limit)
+		if errGetModels != nil {
+			log.Error().Err(errGetModels).Msgf("Error ordering song requests")
+			return nil, connect_go.NewError(connect_go.CodeInternal, errGetModels)
+		}
+	}
 	var songRequestsProto []*thalassav1.SongRequest
 	for _, srModel := range songRequestsModel {
 		songRequestProto := songRequestModelToProto(srModel)
@@ -70,6 +90,41 @@ func (inst *Instance) GetSongRequests(ctx context.Context, request *connect_go.R
 	return connect_go.NewResponse(response), nil
 }
 
+// fairSongRequestsPage puts the song requests in fair queue order, with the song that is playing first, and returns
+// the requested page.
+func fairSongRequestsPage(ctx context.Context, guild *discord.ServerInstance, songRequests models.SongRequestSlice,
+	offset, limit int,
+) (models.SongRequestSlice, error) {
+	var current *models.SongRequest
+	guild.MusicData.RLock()
+	if guild.MusicData.SongPlaying && guild.MusicData.CurrentSongRequest != nil {
+		current = guild.MusicData.CurrentSongRequest
+	}
+	guild.MusicData.RUnlock()
+	ordered := make(models.SongRequestSlice, 0, len(songRequests))
+	queue := make(models.SongRequestSlice, 0, len(songRequests))
+	for _, songRequest := range songRequests {
+		if current != nil && songRequest.ID == current.ID {
+			ordered = append(ordered, songRequest)
+			continue
+		}
+		queue = append(queue, songRequest)
+	}
+	err := guild.OrderSongQueue(ctx, queue)
+	if err != nil {
+		return nil, err
+	}
+	ordered = append(ordered, queue...)
+	if offset >= len(ordered) {
+		return nil, nil
+	}
+	ordered = ordered[offset:]
+	if len(ordered) > limit {
+		ordered = ordered[:limit]
+	}
+	return ordered, nil
+}
+
 func (inst *Instance) GetCurrentSongPlaying(ctx context.Context, request *connect_go.Request[thalassav1.GetCurrentSongPlayingRequest]) (*connect_go.Response[thalassav1.GetCurrentSongPlayingResponse], error) {
 	inst.ShardInstance.RLock()
 	guild, exists := inst.ShardInstance.ServerInstances[request.Msg.GetGuildId()]
@@ -83,11 +138,122 @@ func (inst *Instance) GetCurrentSongPlaying(ctx context.Context, request *connec
 	songRequestProto := songRequestModelToProto(guild.MusicData.CurrentSongRequest)
 	songRequestProto.Song = songModelToProto(guild.MusicData.CurrentSong)
 	response := &thalassav1.GetCurrentSongPlayingResponse{
-		RequestedAt: songRequestProto.RequestedAt,
-		StartedAt:   timestamppb.New(guild.MusicData.SongStarted),
-		Song:        songRequestProto.Song,
-		RequestedBy: songRequestProto.UsernameAtTime,
-		SongRequest: songRequestProto,
+		RequestedAt:      songRequestProto.RequestedAt,
+		StartedAt:        timestamppb.New(guild.MusicData.SongStarted),
+		Song:             songRequestProto.Song,
+		RequestedBy:      songRequestProto.UsernameAtTime,
+		SongRequest:      songRequestProto,
+		Paused:           guild.SongPaused(),
+		PausedDurationMs: guild.SongPausedDuration().Milliseconds(),
+		PositionMs:       guild.SongPosition().Milliseconds(),
+	}
+	remaining, remainingKnown := guild.CurrentSongTimeLeft()
+	response.RemainingMs = remaining.Milliseconds()
+	response.RemainingUnknown = !remainingKnown
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) GetMusicSettings(ctx context.Context, request *connect_go.Request[thalassav1.GetMusicSettingsRequest]) (*connect_go.Response[thalassav1.GetMusicSettingsResponse], error) {
+	inst.ShardInstance.RLock()
+	guild, exists := inst.ShardInstance.ServerInstances[request.Msg.GetGuildId()]
+	inst.ShardInstance.RUnlock()
+	if !exists {
+		return nil, connect_go.NewError(connect_go.CodeNotFound, errors.New("guild not found"))
+	}
+	guild.RLock()
+	response := &thalassav1.GetMusicSettingsResponse{
+		Volume:    guild.Configuration.MusicVolume,
+		Autoplay:  guild.Configuration.MusicAutoplayEnabled,
+		FairQueue: guild.Configuration.MusicFairQueue,
+	}
+	guild.RUnlock()
+	response.LoopMode = loopModeToProto(guild.LoopMode())
+	response.Filter = string(guild.MusicFilter())
+	policy := guild.MusicPolicy()
+	response.DjRoleId = policy.DJRoleID.String
+	response.QueueLocked = policy.QueueLocked
+	response.DjOnlyListeners = int32(policy.DJOnlyListeners.Int)
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) GetMusicAccess(ctx context.Context, request *connect_go.Request[thalassav1.GetMusicAccessRequest]) (*connect_go.Response[thalassav1.GetMusicAccessResponse], error) {
+	guild, err := inst.serverInstance(request.Msg.GetGuildId())
+	if err != nil {
+		return nil, err
+	}
+	if request.Msg.GetUserId() == "" {
+		return nil, connect_go.NewError(connect_go.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	userID := request.Msg.GetUserId()
+	response := &thalassav1.GetMusicAccessResponse{
+		Dj:       guild.MemberIsDJ(userID),
+		CanQueue: true,
+	}
+	if expires, granted := guild.DJGrantExpires(userID); granted {
+		response.DjExpiresAt = timestamppb.New(expires)
+	}
+	errAccess := guild.CheckQueueAccess(userID)
+	var violation *discord.MusicPolicyViolation
+	if errors.As(errAccess, &violation) {
+		response.CanQueue = false
+		response.Reason = violation.Reason
+	} else if errAccess != nil {
+		return nil, connect_go.NewError(connect_go.CodeInternal, errAccess)
+	}
+	return connect_go.NewResponse(response), nil
+}
+
+func (inst *Instance) GetMusicStats(ctx context.Context, request *connect_go.Request[thalassav1.GetMusicStatsRequest]) (*connect_go.Response[thalassav1.GetMusicStatsResponse], error) {
+	inst.ShardInstance.RLock()
+	guild, exists := inst.ShardInstance.ServerInstances[request.Msg.GetGuildId()]
+	inst.ShardInstance.RUnlock()
+	if !exists {
+		return nil, connect_go.NewError(connect_go.CodeNotFound, errors.New("guild not found"))
+	}
+	limit := 10
+	if request.Msg.GetLimit() > 0 && request.Msg.GetLimit() <= 100 {
+		limit = int(request.Msg.GetLimit())
+	}
+	period := statsPeriodFromProto(request.Msg.GetPeriod())
+	stats, err := guild.GetMusicStats(ctx, period, request.Msg.GetUserId())
+	if err != nil {
+		log.Error().Err(err).Msgf("Error getting music stats")
+		return nil, connect_go.NewError(connect_go.CodeInternal, err)
+	}
+	topSongs, err := guild.TopSongs(ctx, period, request.Msg.GetUserId(), limit)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error getting top songs")
+		return nil, connect_go.NewError(connect_go.CodeInternal, err)
+	}
+	topRequesters, err := guild.TopRequesters(ctx, period, limit)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error getting top requesters")
+		return nil, connect_go.NewError(connect_go.CodeInternal, err)
+	}
+	response := &thalassav1.GetMusicStatsResponse{
+		Played:        int32(stats.Played),
+		Skipped:       int32(stats.Skipped),
+		Autoplayed:    int32(stats.Autoplayed),
+		Songs:         int32(stats.Songs),
+		Requesters:    int32(stats.Requesters),
+		PlayedSeconds: stats.PlayedSeconds,
+	}
+	for _, songStats := range topSongs {
+		response.TopSongs = append(response.TopSongs, &thalassav1.SongStats{
+			Song:       songModelToProto(songStats.Song),
+			Plays:      int32(songStats.Plays),
+			Skips:      int32(songStats.Skips),
+			Requesters: int32(songStats.Requesters),
+		})
+	}
+	for _, requester := range topRequesters {
+		response.TopRequesters = append(response.TopRequesters, &thalassav1.RequesterStats{
+			UserId:        requester.UserID,
+			Username:      requester.Username,
+			Requests:      int32(requester.Requests),
+			Skips:         int32(requester.Skips),
+			PlayedSeconds: requester.PlayedSeconds,
+		})
 	}
 	return connect_go.NewResponse(response), nil
 }
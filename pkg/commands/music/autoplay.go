@@ -0,0 +1,63 @@
+package music
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func autoplay(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		status := "Off"
+		if instance.AutoplayEnabled() {
+			status = "On"
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Autoplay", status, false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send autoplay message.")
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to set autoplay.", "Only DJs can change autoplay.", musicChatChannelID.String)
+		return
+	}
+	var enabled bool
+	switch strings.ToLower(args[0]) {
+	case "on", "enable", "enabled", "true":
+		enabled = true
+	case "off", "disable", "disabled", "false":
+		enabled = false
+	default:
+		instance.SendErrorEmbed("Unable to set autoplay.", "Usage: !autoplay on or !autoplay off", musicChatChannelID.String)
+		return
+	}
+	err := instance.SetAutoplayEnabled(instance.Ctx, enabled)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set autoplay.")
+		instance.SendErrorEmbed("Unable to set autoplay.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	description := "Autoplay is off. The bot will stop when the queue is empty."
+	if enabled {
+		description = "When the queue is empty the bot will play songs this server has requested before."
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Autoplay updated", description, false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send autoplay message.")
+	if enabled {
+		instance.MusicData.RLock()
+		currentlyPlaying := instance.MusicData.SongPlaying
+		instance.MusicData.RUnlock()
+		if !currentlyPlaying {
+			instance.TriggerNextSong <- struct{}{}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/null/v8"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const (
+	blockUsage   = "Usage: !block <song|channel|keyword> <link, ID or keyword>. Leave out the link to block the current song or its channel."
+	unblockUsage = "Usage: !unblock <song|channel|keyword> <link, ID or keyword>. Use !blocklist to see what's blocked."
+)
+
+// resolveBlocklistValue works out what to block from the arguments. Songs and channels can be given as a link, an ID,
+// or left out to use the current song. Channels can also be given as a channel link.
+func resolveBlocklistValue(instance *discord.ServerInstance, entryType discord.BlocklistEntryType, args []string,
+) (string, null.String, error) {
+	if entryType == discord.BlocklistKeyword {
+		keyword := strings.TrimSpace(strings.Join(args, " "))
+		if keyword == "" {
+			return "", null.String{}, errors.New("you must give a keyword to block")
+		}
+		return keyword, null.String{}, nil
+	}
+
+	if len(args) == 0 {
+		instance.MusicData.RLock()
+		defer instance.MusicData.RUnlock()
+		if !instance.MusicData.SongPlaying || instance.MusicData.CurrentSong == nil {
+			return "", null.String{}, errors.New("no song is playing")
+		}
+		song := instance.MusicData.CurrentSong
+		if entryType == discord.BlocklistSong {
+			return song.ID, null.StringFrom(song.SongName), nil
+		}
+		if !song.ChannelID.Valid {
+			return "", null.String{}, errors.New("the channel that uploaded the current song isn't known")
+		}
+		return song.ChannelID.String, song.Uploader, nil
+	}
+
+	value := args[0]
+	if entryType == discord.BlocklistChannel {
+		if _, channelID, found := strings.Cut(value, "/channel/"); found {
+			channelID, _, _ = strings.Cut(channelID, "/")
+			return channelID, null.String{}, nil
+		}
+	}
+	if !strings.Contains(value, "://") {
+		return value, null.String{}, nil
+	}
+	songInfo, err := music.GetSongInfo(instance.Ctx, value)
+	if err != nil {
+		return "", null.String{}, err
+	}
+	if entryType == discord.BlocklistSong {
+		return songInfo.ID, null.StringFrom(songInfo.Title), nil
+	}
+	if songInfo.ChannelID == "" {
+		return "", null.String{}, errors.New("the channel that uploaded that song isn't known")
+	}
+	uploader := songInfo.Uploader
+	if uploader == "" {
+		uploader = songInfo.Channel
+	}
+	return songInfo.ChannelID, null.NewString(uploader, uploader != ""), nil
+}
+
+func blockSongs(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to block songs.", blockUsage, musicChatChannelID.String)
+		return
+	}
+	entryType, err := discord.ParseBlocklistEntryType(args[0])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to block songs.", blockUsage, musicChatChannelID.String)
+		return
+	}
+	value, label, err := resolveBlocklistValue(instance, entryType, args[1:])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to block songs.", err.Error(), musicChatChannelID.String)
+		return
+	}
+	removed, err := instance.BlockSongs(instance.Ctx, entryType, value, label, message.Author.ID)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to block songs.")
+		instance.SendErrorEmbed("Unable to block songs.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	blocked := value
+	if label.Valid {
+		blocked = fmt.Sprintf("%s (%s)", label.String, value)
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		AddField(fmt.Sprintf("Blocked %s", entryType), blocked, false).
+		AddField("Songs removed from the queue", strconv.Itoa(removed), false).
+		AddField("Blocked By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send songs blocked message.")
+}
+
+func unblockSongs(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) < 2 {
+		instance.SendErrorEmbed("Unable to unblock songs.", unblockUsage, musicChatChannelID.String)
+		return
+	}
+	entryType, err := discord.ParseBlocklistEntryType(args[0])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to unblock songs.", unblockUsage, musicChatChannelID.String)
+		return
+	}
+	value, _, err := resolveBlocklistValue(instance, entryType, args[1:])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to unblock songs.", err.Error(), musicChatChannelID.String)
+		return
+	}
+	err = instance.UnblockSongs(instance.Ctx, entryType, value)
+	if err != nil {
+		if errors.Is(err, discord.ErrBlocklistEntryNotFound) {
+			instance.SendErrorEmbed("Unable to unblock songs.", fmt.Sprintf("That %s isn't blocked.", entryType),
+				musicChatChannelID.String)
+			return
+		}
+		instance.Log.Error().Err(err).Msg("Unable to unblock songs.")
+		instance.SendErrorEmbed("Unable to unblock songs.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		AddField(fmt.Sprintf("Unblocked %s", entryType), value, false).
+		AddField("Unblocked By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send songs unblocked message.")
+}
+
+func showBlocklist(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	blocklist, err := instance.GetBlocklist(instance.Ctx)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get blocklist.")
+		instance.SendErrorEmbed("Unable to show blocklist.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embed := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Blocklist").
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png")
+	if len(blocklist) == 0 {
+		embed.SetDescription("Nothing is blocked.")
+	}
+	for index, entry := range blocklist {
+		// Embeds can only hold 25 fields.
+		if index == 24 && len(blocklist) > 25 {
+			embed.AddField("More", fmt.Sprintf("%d more entries not shown.", len(blocklist)-24), false)
+			break
+		}
+		blocked := entry.Value
+		if entry.Label.Valid {
+			blocked = fmt.Sprintf("%s (%s)", entry.Label.String, entry.Value)
+		}
+		embed.AddField(discord.BlocklistEntryType(entry.EntryType).FriendlyName(), blocked, false)
+	}
+	instance.SendEmbedMessage(embed.MessageEmbed, musicChatChannelID.String, "Unable to send blocklist message.")
+}
@@ -0,0 +1,114 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const audioCacheUsage = "Usage: !audiocache to see cache stats, !audiocache purge to empty the cache, or " +
+	"!audiocache remove <link> to remove one song. Leave out the link to remove the current song."
+
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func showAudioCache(instance *discord.ServerInstance, musicChatChannelID string) {
+	stats := instance.AudioCache.Stats()
+	maxSize := "No limit"
+	if stats.MaxSizeBytes > 0 {
+		maxSize = formatBytes(stats.MaxSizeBytes)
+	}
+	hitRate := "None yet"
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		hitRate = fmt.Sprintf("%.0f%% (%d of %d plays)", float64(stats.Hits)/float64(lookups)*100, stats.Hits, lookups)
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Audio cache").
+		AddField("Songs cached", strconv.Itoa(stats.Entries), true).
+		AddField("Size", formatBytes(stats.SizeBytes), true).
+		AddField("Max size", maxSize, true).
+		AddField("Songs downloading", strconv.Itoa(stats.Downloading), true).
+		AddField("Played from the cache", hitRate, true).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send audio cache message.")
+}
+
+func audioCache(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if instance.AudioCache == nil {
+		instance.SendErrorEmbed("Audio cache is off.", "Set AudioCacheDirectory in the bot configuration to turn it on.",
+			musicChatChannelID.String)
+		return
+	}
+	// The cache is shared by every server the bot is in.
+	if !instance.UserIsBotOwner(message) {
+		instance.SendErrorEmbed("Unable to use the audio cache.", "Only the bot's owners can manage the audio cache.",
+			musicChatChannelID.String)
+		return
+	}
+	if len(args) == 0 {
+		showAudioCache(instance, musicChatChannelID.String)
+		return
+	}
+	switch strings.ToLower(args[0]) {
+	case "purge", "clear":
+		removed, freed := instance.AudioCache.Purge()
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+			AddField("Purged the audio cache", fmt.Sprintf("Removed %d songs and freed %s.", removed, formatBytes(freed)),
+				false).
+			AddField("Purged By", message.Author.Username, false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send audio cache purged message.")
+	case "remove":
+		var songID, songName string
+		if len(args) > 1 {
+			songInfo, err := music.GetSongInfo(instance.Ctx, args[1])
+			if err != nil {
+				instance.SendErrorEmbed("Unable to remove song from the audio cache.", err.Error(),
+					musicChatChannelID.String)
+				return
+			}
+			songID, songName = songInfo.ID, songInfo.Title
+		} else {
+			instance.MusicData.RLock()
+			if instance.MusicData.SongPlaying && instance.MusicData.CurrentSong != nil {
+				songID, songName = instance.MusicData.CurrentSong.ID, instance.MusicData.CurrentSong.SongName
+			}
+			instance.MusicData.RUnlock()
+			if songID == "" {
+				instance.SendErrorEmbed("Unable to remove song from the audio cache.", "No song is playing.",
+					musicChatChannelID.String)
+				return
+			}
+		}
+		if !instance.AudioCache.Remove(songID) {
+			instance.SendErrorEmbed("Unable to remove song from the audio cache.", "That song isn't cached.",
+				musicChatChannelID.String)
+			return
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+			AddField("Removed song from the audio cache", songName, false).
+			AddField("Removed By", message.Author.Username, false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send audio cache removed message.")
+	default:
+		instance.SendErrorEmbed("Unable to change the audio cache.", audioCacheUsage, musicChatChannelID.String)
+	}
+}
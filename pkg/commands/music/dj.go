@@ -0,0 +1,182 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/null/v8"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const djUsage = "Usage: !dj role <@role|off>, !dj lock <on|off>, !dj listeners <number|off>, " +
+	"!dj grant <@user> <time>, or !dj revoke <@user>. Example: !dj grant @someone 2:00:00"
+
+// roleIDFromMention returns the role ID from a role mention like <@&123>, or the argument itself if it's an ID.
+func roleIDFromMention(mention string) (string, bool) {
+	roleID := strings.TrimSuffix(strings.TrimPrefix(mention, "<@&"), ">")
+	if _, err := strconv.ParseUint(roleID, 10, 64); err != nil {
+		return "", false
+	}
+	return roleID, true
+}
+
+func showDJSettings(instance *discord.ServerInstance, musicChatChannelID string) {
+	policy := instance.MusicPolicy()
+	djRole := "None"
+	if policy.DJRoleID.Valid {
+		djRole = fmt.Sprintf("<@&%s>", policy.DJRoleID.String)
+	}
+	queueLock := "Unlocked"
+	if policy.QueueLocked {
+		queueLock = "Locked, only DJs can add songs"
+	}
+	djOnly := "No limit"
+	if policy.DJOnlyListeners.Valid {
+		djOnly = fmt.Sprintf("Only DJs can add songs while more than %d people are listening",
+			policy.DJOnlyListeners.Int)
+	}
+	grants := "None"
+	if djGrants := instance.DJGrants(); len(djGrants) > 0 {
+		lines := make([]string, len(djGrants))
+		for index, grant := range djGrants {
+			lines[index] = fmt.Sprintf("<@%s> until <t:%d:t>", grant.UserID, grant.ExpiresAt.Unix())
+		}
+		grants = strings.Join(lines, "\n")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("DJ settings").
+		AddField("DJ role", djRole, false).
+		AddField("Queue", queueLock, false).
+		AddField("Listeners", djOnly, false).
+		AddField("Temporary DJs", grants, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send DJ settings message.")
+}
+
+func djSettings(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		showDJSettings(instance, musicChatChannelID.String)
+		return
+	}
+	setting := strings.ToLower(args[0])
+	// DJs can lock the queue for events, but only admins decide who the DJs are.
+	if setting == "lock" {
+		if !instance.UserIsDJ(message) {
+			instance.SendErrorEmbed("Unable to lock the queue.", "Only DJs can lock the queue.",
+				musicChatChannelID.String)
+			return
+		}
+	} else if !instance.UserIsAdmin(message) {
+		instance.SendErrorEmbed("Unable to change DJ settings.", "Only admins can change who the DJs are.",
+			musicChatChannelID.String)
+		return
+	}
+	if len(args) < 2 {
+		instance.SendErrorEmbed("Unable to change DJ settings.", djUsage, musicChatChannelID.String)
+		return
+	}
+	value := args[1]
+	var err error
+	var result string
+	switch setting {
+	case "role":
+		var roleID null.String
+		if !strings.EqualFold(value, "off") {
+			id, ok := roleIDFromMention(value)
+			if !ok {
+				instance.SendErrorEmbed("Unable to change DJ settings.", djUsage, musicChatChannelID.String)
+				return
+			}
+			roleID = null.StringFrom(id)
+		}
+		err = instance.UpdateMusicPolicy(instance.Ctx, func(policy *models.MusicPolicy) { policy.DJRoleID = roleID })
+		result = "Nobody gets DJ from a role."
+		if roleID.Valid {
+			result = fmt.Sprintf("Members of <@&%s> are DJs.", roleID.String)
+		}
+	case "lock":
+		var locked bool
+		switch strings.ToLower(value) {
+		case "on", "enable", "enabled", "true":
+			locked = true
+		case "off", "disable", "disabled", "false":
+			locked = false
+		default:
+			instance.SendErrorEmbed("Unable to lock the queue.", "Usage: !dj lock on or !dj lock off",
+				musicChatChannelID.String)
+			return
+		}
+		err = instance.UpdateMusicPolicy(instance.Ctx, func(policy *models.MusicPolicy) { policy.QueueLocked = locked })
+		result = "The queue is unlocked."
+		if locked {
+			result = "The queue is locked, so only DJs can add songs."
+		}
+	case "listeners":
+		var limit null.Int
+		if !strings.EqualFold(value, "off") {
+			listeners, errListeners := strconv.Atoi(value)
+			if errListeners != nil || listeners < 1 {
+				instance.SendErrorEmbed("Unable to change DJ settings.", djUsage, musicChatChannelID.String)
+				return
+			}
+			limit = null.IntFrom(listeners)
+		}
+		err = instance.UpdateMusicPolicy(instance.Ctx, func(policy *models.MusicPolicy) {
+			policy.DJOnlyListeners = limit
+		})
+		result = "Anyone can add songs however many people are listening."
+		if limit.Valid {
+			result = fmt.Sprintf("Only DJs can add songs while more than %d people are listening.", limit.Int)
+		}
+	case "grant", "revoke":
+		userID, ok := discord.GetDiscordUserIDFromString(value)
+		if !ok {
+			instance.SendErrorEmbed("Unable to change DJ settings.", djUsage, musicChatChannelID.String)
+			return
+		}
+		if setting == "revoke" {
+			err = instance.RevokeDJ(instance.Ctx, userID)
+			if errors.Is(err, discord.ErrNoDJGrant) {
+				instance.SendErrorEmbed("Unable to revoke DJ.", fmt.Sprintf("<@%s> isn't a temporary DJ.", userID),
+					musicChatChannelID.String)
+				return
+			}
+			result = fmt.Sprintf("<@%s> is no longer a temporary DJ.", userID)
+			break
+		}
+		if len(args) < 3 {
+			instance.SendErrorEmbed("Unable to grant DJ.", djUsage, musicChatChannelID.String)
+			return
+		}
+		duration, errTimestamp := music.ParseTimestamp(args[2])
+		if errTimestamp != nil || duration <= 0 {
+			instance.SendErrorEmbed("Unable to grant DJ.", djUsage, musicChatChannelID.String)
+			return
+		}
+		expires, errGrant := instance.GrantDJ(instance.Ctx, userID, message.Author.ID, duration)
+		err = errGrant
+		result = fmt.Sprintf("<@%s> is a DJ until <t:%d:t>.", userID, expires.Unix())
+	default:
+		instance.SendErrorEmbed("Unable to change DJ settings.", djUsage, musicChatChannelID.String)
+		return
+	}
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to change DJ settings.")
+		instance.SendErrorEmbed("Unable to change DJ settings.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("DJ settings updated", result, false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send DJ settings message.")
+}
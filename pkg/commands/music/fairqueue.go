@@ -0,0 +1,56 @@
+package music
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func fairQueue(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		status := "Off"
+		if instance.FairQueueEnabled() {
+			status = "On"
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Fair queue", status, false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send fair queue message.")
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to set fair queue.", "Only DJs can change fair queue.", musicChatChannelID.String)
+		return
+	}
+	var enabled bool
+	switch strings.ToLower(args[0]) {
+	case "on", "enable", "enabled", "true":
+		enabled = true
+	case "off", "disable", "disabled", "false":
+		enabled = false
+	default:
+		instance.SendErrorEmbed("Unable to set fair queue.", "Usage: !fairqueue on or !fairqueue off",
+			musicChatChannelID.String)
+		return
+	}
+	err := instance.SetFairQueueEnabled(instance.Ctx, enabled)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set fair queue.")
+		instance.SendErrorEmbed("Unable to set fair queue.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	description := "Songs play in the order they were queued."
+	if enabled {
+		description = "Requesters take turns, so everyone's next song plays before anyone gets a second turn."
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Fair queue updated", description, false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send fair queue message.")
+}
@@ -0,0 +1,79 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+func filterUsage() string {
+	presets := make([]string, len(music.FilterPresets))
+	for index, preset := range music.FilterPresets {
+		presets[index] = string(preset)
+	}
+	bands := make([]string, len(music.EqualizerBands))
+	for index, band := range music.EqualizerBands {
+		bands[index] = fmt.Sprintf("%d Hz", band)
+	}
+	return fmt.Sprintf("Usage: !filter <preset> [now]. Presets: %s, or off. The equalizer takes a gain from -%d to "+
+		"%d dB for %s, like !filter eq 3 0 0 -2 4. Add now to restart the current song with the filter instead of "+
+		"waiting for the next one.", strings.Join(presets, ", "), music.MaxEqualizerGain, music.MaxEqualizerGain,
+		strings.Join(bands, ", "))
+}
+
+func audioFilter(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Audio filter", instance.MusicFilter().FriendlyName(), false).
+			AddField("Presets", filterUsage(), false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send audio filter message.")
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to set audio filter.", "Only DJs can change the audio filter.",
+			musicChatChannelID.String)
+		return
+	}
+	now := strings.EqualFold(args[len(args)-1], "now")
+	if now {
+		args = args[:len(args)-1]
+	}
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to set audio filter.", filterUsage(), musicChatChannelID.String)
+		return
+	}
+	filter, err := music.ParseAudioFilter(args[0], args[1:])
+	if errors.Is(err, music.ErrInvalidAudioFilter) {
+		instance.SendErrorEmbed("Unable to set audio filter.", filterUsage(), musicChatChannelID.String)
+		return
+	}
+	err = instance.SetMusicFilter(instance.Ctx, filter, now)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set audio filter.")
+		instance.SendErrorEmbed("Unable to set audio filter.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	instance.MusicData.RLock()
+	restarted := now && instance.MusicData.SongPlaying && !instance.MusicData.IsStream
+	instance.MusicData.RUnlock()
+	when := "Starts with the next song."
+	if restarted {
+		when = "Applied to the current song."
+		instance.RefreshNowPlaying()
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Audio filter set", filter.FriendlyName(), false).
+		AddField("When", when, false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send audio filter message.")
+}
@@ -0,0 +1,91 @@
+package music
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const idleUsage = "Usage: !idle <time> [pause|leave], !idle off, or !idle keepunheard on|off. Example: !idle 5:00 leave"
+
+func idleSettingsEmbed(instance *discord.ServerInstance, title string) *discord.Embed {
+	timeout := instance.MusicIdleTimeout()
+	idleTime := "Off"
+	if timeout > 0 {
+		idleTime = discord.FormatQueueWait(timeout)
+	}
+	action := "Pause until someone joins"
+	if instance.MusicIdleAction() == discord.IdleLeave {
+		action = "Leave until someone joins"
+	}
+	unheard := "Marked played"
+	if instance.MusicKeepsUnheardSongs() {
+		unheard = "Kept in the queue"
+	}
+	return discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(title).
+		AddField("Idle time", idleTime, true).
+		AddField("When nobody is listening", action, true).
+		AddField("Songs nobody heard", unheard, true)
+}
+
+func musicIdle(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		instance.SendEmbedMessage(idleSettingsEmbed(instance, "Idle voice settings").MessageEmbed,
+			musicChatChannelID.String, "Unable to send idle settings message.")
+		return
+	}
+	var err error
+	switch strings.ToLower(args[0]) {
+	case "keepunheard":
+		if len(args) < 2 {
+			instance.SendErrorEmbed("Unable to set idle settings.", idleUsage, musicChatChannelID.String)
+			return
+		}
+		var keep bool
+		switch strings.ToLower(args[1]) {
+		case "on", "enable", "enabled", "true":
+			keep = true
+		case "off", "disable", "disabled", "false":
+			keep = false
+		default:
+			instance.SendErrorEmbed("Unable to set idle settings.", idleUsage, musicChatChannelID.String)
+			return
+		}
+		err = instance.SetMusicKeepsUnheardSongs(instance.Ctx, keep)
+	case "off":
+		err = instance.SetMusicIdle(instance.Ctx, 0, instance.MusicIdleAction())
+	default:
+		timeout, errTimestamp := music.ParseTimestamp(args[0])
+		if errTimestamp != nil || timeout <= 0 {
+			instance.SendErrorEmbed("Unable to set idle settings.", idleUsage, musicChatChannelID.String)
+			return
+		}
+		action := instance.MusicIdleAction()
+		if len(args) > 1 {
+			parsed, errAction := discord.ParseIdleAction(args[1])
+			if errors.Is(errAction, discord.ErrInvalidIdleAction) {
+				instance.SendErrorEmbed("Unable to set idle settings.", idleUsage, musicChatChannelID.String)
+				return
+			}
+			action = parsed
+		}
+		err = instance.SetMusicIdle(instance.Ctx, timeout, action)
+	}
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set idle settings.")
+		instance.SendErrorEmbed("Unable to set idle settings.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := idleSettingsEmbed(instance, "Idle voice settings updated").
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send idle settings message.")
+}
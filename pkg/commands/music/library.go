@@ -0,0 +1,123 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
+)
+
+const (
+	libraryUsage = "Usage: !library to see the music library, !library search <words> to find songs, or " +
+		"!library scan to look for new files. Play a song with !play local:<words>."
+	// librarySearchResults is how many songs !library search shows.
+	librarySearchResults = 10
+)
+
+func showLibrary(instance *discord.ServerInstance, musicChatChannelID string) {
+	songs, err := models.Songs(qm.Where("library_modified_at is not null")).Count(instance.Ctx, instance.Db)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to count music library songs.")
+		instance.SendErrorEmbed("Unable to show the music library.", "Database error.", musicChatChannelID)
+		return
+	}
+	lastScan := "Not scanned yet"
+	if scan := instance.Library.LastScan(); !scan.Finished.IsZero() {
+		lastScan = fmt.Sprintf("%s ago", discord.FormatQueueWait(time.Since(scan.Finished).Truncate(time.Second)))
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Music library").
+		AddField("Songs", strconv.FormatInt(songs, 10), true).
+		AddField("Last scanned", lastScan, true).
+		AddField("Playing songs", "!play local:<words> plays the best match. !playlist local:<words> queues "+
+			"every match, like a whole album.", false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send music library message.")
+}
+
+func searchLibrary(instance *discord.ServerInstance, query string, musicChatChannelID string) {
+	songs, err := instance.Library.Search(instance.Ctx, query, librarySearchResults)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to search the music library.")
+		instance.SendErrorEmbed("Unable to search the music library.", "Database error.", musicChatChannelID)
+		return
+	}
+	if len(songs) == 0 {
+		instance.SendErrorEmbed("No songs found.", fmt.Sprintf("Nothing in the music library matches %s.", query),
+			musicChatChannelID)
+		return
+	}
+	var results strings.Builder
+	for index, song := range songs {
+		results.WriteString(fmt.Sprintf("%d. %s", index+1, song.SongName))
+		if song.Artist.Valid {
+			results.WriteString(" - " + song.Artist.String)
+		}
+		if song.Album.Valid {
+			results.WriteString(fmt.Sprintf(" (%s)", song.Album.String))
+		}
+		if song.DurationInSeconds.Valid {
+			results.WriteString(" " + discord.FormatQueueWait(time.Duration(song.DurationInSeconds.Int)*time.Second))
+		}
+		results.WriteString("\n")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(fmt.Sprintf("Music library songs matching %s", query)).
+		SetDescription(results.String()).
+		AddField("Playing songs", fmt.Sprintf("!play local:%s plays the first song. !playlist local:%s queues "+
+			"every match.", query, query), false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send music library search message.")
+}
+
+func library(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if instance.Library == nil {
+		instance.SendErrorEmbed("Music library is off.", "Set LocalMusicDirectories in the bot configuration to "+
+			"turn it on.", musicChatChannelID.String)
+		return
+	}
+	if len(args) == 0 {
+		showLibrary(instance, musicChatChannelID.String)
+		return
+	}
+	switch strings.ToLower(args[0]) {
+	case "search", "find":
+		query := strings.TrimSpace(strings.Join(args[1:], " "))
+		if query == "" {
+			instance.SendErrorEmbed("Unable to search the music library.", libraryUsage, musicChatChannelID.String)
+			return
+		}
+		searchLibrary(instance, query, musicChatChannelID.String)
+	case "scan", "rescan":
+		if !instance.UserIsAdmin(message) {
+			instance.SendErrorEmbed("Unable to scan the music library.", "Only administrators can scan the "+
+				"music library.", musicChatChannelID.String)
+			return
+		}
+		scan, err := instance.Library.Scan(instance.Ctx)
+		if err != nil {
+			instance.Log.Error().Err(err).Msg("Unable to scan the music library.")
+			instance.SendErrorEmbed("Unable to scan the music library.", err.Error(), musicChatChannelID.String)
+			return
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+			SetTitle("Scanned the music library").
+			AddField("Songs", strconv.Itoa(scan.Songs), true).
+			AddField("Added", strconv.Itoa(scan.Added), true).
+			AddField("Updated", strconv.Itoa(scan.Updated), true).
+			AddField("Removed", strconv.Itoa(scan.Removed), true).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send music library scan message.")
+	default:
+		instance.SendErrorEmbed("Unable to use the music library.", libraryUsage, musicChatChannelID.String)
+	}
+}
@@ -0,0 +1,42 @@
+package music
+
+import (
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+func loop(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Loop mode", instance.LoopMode().FriendlyName(), false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send loop mode message.")
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to set loop mode.", "Only DJs can change the loop mode.", musicChatChannelID.String)
+		return
+	}
+	mode, err := music.ParseLoopMode(args[0])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to set loop mode.", "Usage: !loop off, !loop track or !loop queue",
+			musicChatChannelID.String)
+		return
+	}
+	err = instance.SetLoopMode(instance.Ctx, mode)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set loop mode.")
+		instance.SendErrorEmbed("Unable to set loop mode.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Loop mode set", mode.FriendlyName(), false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send loop mode message.")
+}
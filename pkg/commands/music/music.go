@@ -12,6 +12,27 @@ func RegisterCommands(s *discord.ShardInstance) {
 			Execute:             playSong,
 			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
 		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "search",
+			HelpText:            "Searches YouTube and lets you pick which result to play. Example: !search never gonna give you up",
+			Execute:             searchSongs,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "join",
+			HelpText:            "Brings the bot into your voice channel. Only DJs can move it while it's playing for others.",
+			Execute:             joinVoice,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "leave",
+			HelpText:            "Makes the bot leave voice. The queue is kept, and the current song picks up where it left off when the bot comes back. DJs only.",
+			Execute:             leaveVoice,
+			RequiredPermissions: nil,
+		})
 	s.RegisterCommand(
 		discord.Command{
 			Name:                "skip",
@@ -19,6 +40,55 @@ func RegisterCommands(s *discord.ShardInstance) {
 			Execute:             skipSong,
 			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
 		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "voteskip",
+			HelpText:            "Votes to skip the current playing song. Only listeners in the music voice channel can vote. The requester of the song skips it instantly.",
+			Execute:             voteSkip,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "voteskipthreshold",
+			HelpText:            "Sets the percentage of listeners that must vote to skip a song. Example: !voteskipthreshold 50",
+			Execute:             setVoteSkipThreshold,
+			RequiredPermissions: []discord.Permission{discord.PermissionAdministrator},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "pause",
+			HelpText:            "Pauses the current playing song.",
+			Execute:             pauseSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "resume",
+			HelpText:            "Resumes the current song if it is paused.",
+			Execute:             resumeSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "seek",
+			HelpText:            "Jumps to a position in the current song. Example: !seek 1:23",
+			Execute:             seekSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "forward",
+			HelpText:            "Skips forward in the current song, 10 seconds by default. Example: !forward 30s",
+			Execute:             forwardSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "rewind",
+			HelpText:            "Rewinds the current song, 10 seconds by default. Example: !rewind 30s",
+			Execute:             rewindSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionSkipSongs},
+		})
 	s.RegisterCommand(
 		discord.Command{
 			Name:                "skipall",
@@ -33,6 +103,13 @@ func RegisterCommands(s *discord.ShardInstance) {
 			Execute:             playList,
 			RequiredPermissions: []discord.Permission{discord.PermissionPlayLists},
 		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "cancelimport",
+			HelpText:            "Stops adding the playlists you're importing to the queue. Songs already added stay queued. DJs can add all to cancel everyone's imports. Example: !cancelimport",
+			Execute:             cancelImport,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlayLists},
+		})
 	s.RegisterCommand(
 		discord.Command{
 			Name:                "songcount",
@@ -54,4 +131,172 @@ func RegisterCommands(s *discord.ShardInstance) {
 			Execute:             songLeft,
 			RequiredPermissions: nil,
 		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "playnext",
+			HelpText:            "Takes a URL and adds the song to the front of the queue. DJs only.",
+			Execute:             playNext,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "remove",
+			HelpText:            "Removes the song at a position in the queue. You can remove your own songs, DJs can remove any song. Example: !remove 3",
+			Execute:             removeSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "move",
+			HelpText:            "Moves a song in the queue to a new position. DJs only. Example: !move 5 1",
+			Execute:             moveSong,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "shuffle",
+			HelpText:            "Shuffles the songs waiting in the queue. DJs only.",
+			Execute:             shuffleQueue,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "clear",
+			HelpText:            "Removes every song you requested from the queue with !clear mine. DJs can clear another user's songs with !clear @user.",
+			Execute:             clearSongs,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlaySongs},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "volume",
+			HelpText:            "Shows the music volume. DJs can set it from 0% to 200%. Example: !volume 80",
+			Execute:             volume,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "filter",
+			HelpText:            "Shows the audio filter. DJs can set a preset like bassboost, nightcore or eq. Example: !filter nightcore now",
+			Execute:             audioFilter,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "loop",
+			HelpText:            "Shows the loop mode. DJs can set it to off, track to repeat the current song, or queue to add finished songs back to the end of the queue. Example: !loop queue",
+			Execute:             loop,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "autoplay",
+			HelpText:            "Shows whether autoplay is on. DJs can turn it on to keep playing songs from this server's history when the queue is empty. Example: !autoplay on",
+			Execute:             autoplay,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "fairqueue",
+			HelpText:            "Shows whether fair queue is on. DJs can turn it on so requesters take turns instead of one person's playlist holding up the queue. Example: !fairqueue on",
+			Execute:             fairQueue,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "dj",
+			HelpText:            "Shows who the DJs are and when only DJs can add songs. Admins can set a DJ role, only let DJs add songs while more than a number of people are listening, or make someone a DJ for a while. DJs can lock the queue so only they can add songs. Example: !dj lock on",
+			Execute:             djSettings,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "voicemode",
+			HelpText:            "Shows which voice channel the bot plays in. Set it to follow to join whoever requests a song, with the music voice channel as the default, or fixed to only use the music voice channel. Example: !voicemode follow",
+			Execute:             voiceMode,
+			RequiredPermissions: []discord.Permission{discord.PermissionAdministrator},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "idle",
+			HelpText:            "Shows what the bot does when nobody is in its voice channel. Set how long it waits and whether it pauses or leaves until someone joins, or turn it off. keepunheard keeps songs nobody heard in the queue and waits for someone to join before playing more. Example: !idle 5:00 leave",
+			Execute:             musicIdle,
+			RequiredPermissions: []discord.Permission{discord.PermissionAdministrator},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "musicpolicy",
+			HelpText:            "Shows the music policy. Administrators can limit song length, songs queued per user, playlist size, live streams, age-restricted content and which sites songs come from. Example: !musicpolicy maxduration 10:00",
+			Execute:             musicPolicy,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "block",
+			HelpText:            "Blocks a song, every song from an uploader's channel, or every song with a keyword in its title, and removes matching songs from the queue. Leave out the link to block the current song or its channel. Example: !block channel https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+			Execute:             blockSongs,
+			RequiredPermissions: []discord.Permission{discord.PermissionModerationMuteMember},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "unblock",
+			HelpText:            "Removes a song, channel or keyword from the blocklist. Example: !unblock keyword nightcore",
+			Execute:             unblockSongs,
+			RequiredPermissions: []discord.Permission{discord.PermissionModerationMuteMember},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "blocklist",
+			HelpText:            "Shows the songs, channels and keywords that can't be requested.",
+			Execute:             showBlocklist,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "audiocache",
+			HelpText:            "Shows how many songs are cached on disk and how often songs are played from the cache. Only the bot's owners can use it, since the cache is shared by every server. They can empty the cache with !audiocache purge or remove one song with !audiocache remove <link>.",
+			Execute:             audioCache,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "library",
+			HelpText:            "Shows the local music library. Use !library search <words> to find songs by title, artist or album, and !play local:<words> to play them. Administrators can look for new files with !library scan.",
+			Execute:             library,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "playlists",
+			HelpText:            "Saves playlists to play again later. Use !playlists to see yours and the server's, !playlists create <name> to make one (add server to share it), !playlists add <name> <link> to add a song (leave out the link to add the current one), and !playlists play <name> to queue it (add shuffle to mix it up). Songs can be removed with !playlists remove <name> <number>, and playlists renamed or deleted with !playlists rename and !playlists delete.",
+			Execute:             savedPlaylists,
+			RequiredPermissions: []discord.Permission{discord.PermissionPlayLists},
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "history",
+			HelpText:            "Shows the songs played most recently, 10 by default. Example: !history 25",
+			Execute:             songHistory,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "topsongs",
+			HelpText:            "Shows the songs played most, with totals like hours of music played and the skip rate. Add day, week, month or year to only count that period. Example: !topsongs week",
+			Execute:             topSongs,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "toprequesters",
+			HelpText:            "Shows who requested the most songs. Add day, week, month or year to only count that period. Example: !toprequesters month",
+			Execute:             topRequesters,
+			RequiredPermissions: nil,
+		})
+	s.RegisterCommand(
+		discord.Command{
+			Name:                "mystats",
+			HelpText:            "Shows how many of your songs were played, how long they played for and how often they were skipped. Add day, week, month or year to only count that period.",
+			Execute:             myStats,
+			RequiredPermissions: nil,
+		})
 }
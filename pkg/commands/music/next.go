@@ -4,21 +4,12 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
-	"thalassa_discord/models"
 	"thalassa_discord/pkg/discord"
 )
 
 func next(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
-	nextSongs, errNext := models.SongRequests(
-		qm.Where("guild_id = ?", instance.GuildID),
-		qm.Where("played = false"),
-		qm.OrderBy("requested_at ASC"),
-		qm.OrderBy("id asc"),
-		qm.Load(models.SongRequestRels.Song),
-		qm.Limit(11),
-	).All(instance.Ctx, instance.Db)
+	nextSongs, errNext := instance.GetSongQueue(instance.Ctx, 10)
 	if errNext != nil {
 		instance.Log.Error().Err(errNext).Msg("Unable to query next songs in queue.")
 		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
@@ -29,29 +20,26 @@ func next(instance *discord.ServerInstance, message *discordgo.Message, args []s
 	}
 	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).MessageEmbed
 	embedmsg.Title = "Showing songs that are next in the music queue."
-	numberOfSongs := 0
-	if len(nextSongs) > 1 {
-		numberOfSongs = len(nextSongs) - 1
-	}
 	embedmsg.URL = "https://music.theboomer.club"
-	embedmsg.Description = fmt.Sprintf("Showing the next %d songs in the queue. View the live list at https://music.theboomer.club", numberOfSongs)
+	embedmsg.Description = fmt.Sprintf("Showing the next %d songs in the queue. View the live list at https://music.theboomer.club", len(nextSongs))
 	switch len(nextSongs) {
-	case 0, 1:
+	case 0:
 		embedmsg.Description = "There are no songs in the queue."
-	case 2:
+	case 1:
 		embedmsg.Description = "Showing the next song in the queue."
 	}
+	instance.MusicData.RLock()
+	if instance.MusicData.SongPlaying && instance.MusicData.CurrentSong != nil {
+		embedmsg.Fields = append(embedmsg.Fields, &discordgo.MessageEmbedField{
+			Name:   "Now Playing",
+			Value:  fmt.Sprintf("[%s](%s)", instance.MusicData.CurrentSongRequest.SongName, instance.MusicData.CurrentSong.URL),
+			Inline: false,
+		})
+	}
+	instance.MusicData.RUnlock()
 	for index, song := range nextSongs {
-		if index == 0 {
-			embedmsg.Fields = append(embedmsg.Fields, &discordgo.MessageEmbedField{
-				Name:   "Now Playing",
-				Value:  fmt.Sprintf("[%s](%s)", song.SongName, song.R.Song.URL),
-				Inline: false,
-			})
-			continue
-		}
 		embedmsg.Fields = append(embedmsg.Fields, &discordgo.MessageEmbedField{
-			Name:   fmt.Sprintf("%d.", index),
+			Name:   fmt.Sprintf("%d.", index+1),
 			Value:  fmt.Sprintf("[%s](%s)", song.SongName, song.R.Song.URL),
 			Inline: false,
 		})
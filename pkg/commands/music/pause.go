@@ -0,0 +1,55 @@
+package music
+
+import (
+	"errors"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func pauseSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	setSongPaused(instance, message, true)
+}
+
+func resumeSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	setSongPaused(instance, message, false)
+}
+
+func setSongPaused(instance *discord.ServerInstance, message *discordgo.Message, paused bool) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	errTitle := "Unable to resume song."
+	if paused {
+		errTitle = "Unable to pause song."
+	}
+	err := instance.SetSongPaused(paused)
+	if err != nil {
+		switch {
+		case errors.Is(err, discord.ErrNoSongPlaying):
+			instance.SendErrorEmbed(errTitle, "No song is currently playing.", musicChatChannelID.String)
+		case errors.Is(err, discord.ErrSongAlreadyPaused):
+			instance.SendErrorEmbed(errTitle, "The song is already paused.", musicChatChannelID.String)
+		case errors.Is(err, discord.ErrSongNotPaused):
+			instance.SendErrorEmbed(errTitle, "The song isn't paused.", musicChatChannelID.String)
+		default:
+			instance.Log.Error().Err(err).Msg(errTitle)
+			instance.SendErrorEmbed(errTitle, err.Error(), musicChatChannelID.String)
+		}
+		return
+	}
+	instance.MusicData.RLock()
+	songName := instance.MusicData.CurrentSongRequest.SongName
+	instance.MusicData.RUnlock()
+	title := "Resumed song"
+	if paused {
+		title = "Paused song"
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField(title, songName, false).
+		AddField("Requested By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send song paused message.")
+}
@@ -1,14 +1,15 @@
 package music
 
 import (
+	"errors"
 	"fmt"
-	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"thalassa_discord/models"
 	"thalassa_discord/pkg/discord"
 	"thalassa_discord/pkg/music"
-	"thalassa_discord/pkg/utils"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/volatiletech/null/v8"
@@ -16,6 +17,12 @@ import (
 )
 
 func playSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	queueSong(instance, message, args, false)
+}
+
+// queueSong parses the song in args and adds it to the queue, starting playback if nothing is playing. When playNext
+// is set the song is placed at the front of the queue instead of the back.
+func queueSong(instance *discord.ServerInstance, message *discordgo.Message, args []string, playNext bool) {
 	instance.RLock()
 	musicChatChannelID := instance.Configuration.MusicTextChannelID
 	instance.RUnlock()
@@ -38,17 +45,73 @@ func playSong(instance *discord.ServerInstance, message *discordgo.Message, args
 		MessageEmbed
 	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send message about song queue addition.")
 
-	songInfo, err := music.GetSongInfo(instance.Ctx, args[0])
+	link := args[0]
+	// Library searches can have spaces in them.
+	if strings.HasPrefix(link, "local:") {
+		link = strings.Join(args, " ")
+	}
+	queueSongLink(instance, message, musicChatChannelID.String, link, playNext)
+}
+
+// joinRequesterVoice makes sure the bot is in a voice channel before the author's request is queued, joining the
+// channel the request plays in if it can. It sends an error and returns false if there's no channel to join.
+func joinRequesterVoice(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID string) bool {
+	err := instance.JoinRequesterVoiceChannel(instance.Ctx, message.Author.ID)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, discord.ErrRequesterNotInVoice):
+		instance.SendErrorEmbed("Unable to play song.", "Join a voice channel first so I know where to play it.",
+			musicChatChannelID)
+	case errors.Is(err, discord.ErrNoMusicVoiceChannel):
+		instance.SendErrorEmbed("Unable to play song.", "No music voice channel is set.", musicChatChannelID)
+	default:
+		instance.Log.Error().Err(err).Msg("Unable to join voice channel.")
+		instance.SendErrorEmbed("Unable to play song.", "Unable to join the voice channel.", musicChatChannelID)
+	}
+	return false
+}
+
+// queueSongLink looks up the song at the link and adds it to the queue, starting playback if nothing is playing.
+func queueSongLink(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID, link string,
+	playNext bool,
+) {
+	songInfo, err := music.GetSongInfo(instance.Ctx, link)
 	if err != nil {
 		instance.Log.Error().Err(err).Msg("Unable to get song info.")
 		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
 			AddField("Error getting song information:", err.Error(), false).
 			MessageEmbed
-		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send song info error message.")
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send song info error message.")
+		return
+	}
+	errPolicy := instance.CheckSongPolicy(instance.Ctx, message, songInfo)
+	if errPolicy != nil {
+		sendPolicyError(instance, message, errPolicy, musicChatChannelID)
+		return
+	}
+	if !joinRequesterVoice(instance, message, musicChatChannelID) {
 		return
 	}
 
-	handleSongInfo(instance, message, musicChatChannelID.String, songInfo, true)
+	songRequest := handleSongInfo(instance, message, musicChatChannelID, songInfo, !playNext)
+	if songRequest == nil {
+		return
+	}
+	if playNext {
+		errMove := instance.MoveSongRequestToFront(instance.Ctx, songRequest)
+		if errMove != nil {
+			instance.Log.Error().Err(errMove).Msg("Unable to move song request to the front of the queue.")
+			instance.SendErrorEmbed("Unable to play song next.", "Database error.", musicChatChannelID)
+			return
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 28804).
+			AddField("Song will play next", fmt.Sprintf("[%s](%s)", songInfo.Title, songInfo.WebpageURL), false).
+			AddField("Requested By", message.Author.Username, false).
+			SetImage(songInfo.Thumbnail).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send play next message.")
+	}
 	instance.MusicData.RLock()
 	currentlyPlaying := instance.MusicData.SongPlaying
 	instance.MusicData.RUnlock()
@@ -57,43 +120,36 @@ func playSong(instance *discord.ServerInstance, message *discordgo.Message, args
 	}
 }
 
-func handleSongInfo(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID string, songInfo *music.Song, sendQueueMessage bool) {
+func handleSongInfo(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID string, songInfo *music.Song, sendQueueMessage bool) *models.SongRequest {
 	// fmt.Println("Handling song info.")
+	errBlocked := instance.CheckSongBlocklist(instance.Ctx, songInfo)
+	if errBlocked != nil {
+		sendPolicyError(instance, message, errBlocked, musicChatChannelID)
+		return nil
+	}
 	if songInfo.Thumbnail == "" && len(songInfo.Thumbnails) > 0 {
 		songInfo.Thumbnail = songInfo.Thumbnails[len(songInfo.Thumbnails)-1].URL
 	}
-	newSong := &models.Song{
-		ID:                songInfo.ID,
-		Platform:          null.StringFrom(songInfo.ExtractorKey),
-		SongName:          songInfo.Title,
-		Description:       null.StringFrom(songInfo.Description),
-		URL:               songInfo.WebpageURL,
-		DurationInSeconds: null.IntFrom(int(math.Round(songInfo.Duration))),
-		IsStream:          false,
-		ThumbnailURL:      null.StringFrom(songInfo.Thumbnail),
-		Artist:            utils.InterfaceToNullString(songInfo.Artist),
-		Album:             utils.InterfaceToNullString(songInfo.Album),
-		Track:             utils.InterfaceToNullString(songInfo.Track),
-	}
-	errUpsertSong := newSong.Upsert(instance.Ctx, instance.Db, true, []string{"id"}, boil.Infer(), boil.Infer())
+	newSong, errUpsertSong := instance.SaveSong(instance.Ctx, songInfo)
 	if errUpsertSong != nil {
 		instance.Log.Error().Err(errUpsertSong).Msg("Unable to upsert song.")
 		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
 			AddField("Unable to add song request.", "Database error.", false).
 			MessageEmbed
 		instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to upsert song.")
-		return
+		return nil
 	}
 	newSongRequest := &models.SongRequest{
-		SongID:            null.StringFrom(newSong.ID),
-		SongName:          songInfo.Title,
-		RequestedByUserID: message.Author.ID,
-		UsernameAtTime:    message.Author.Username,
-		GuildID:           message.GuildID,
-		GuildNameAtTime:   instance.GuildID,
-		RequestedAt:       time.Now().UTC(),
-		PlayedAt:          null.Time{},
-		Played:            false,
+		SongID:             null.StringFrom(newSong.ID),
+		SongName:           songInfo.Title,
+		RequestedByUserID:  message.Author.ID,
+		UsernameAtTime:     message.Author.Username,
+		GuildID:            message.GuildID,
+		GuildNameAtTime:    instance.GuildID,
+		RequestedAt:        time.Now().UTC(),
+		PlayedAt:           null.Time{},
+		Played:             false,
+		StartOffsetSeconds: int(songInfo.StartOffset().Seconds()),
 	}
 	errUpsertSongRequest := newSongRequest.Insert(instance.Ctx, instance.Db, boil.Infer())
 	if errUpsertSongRequest != nil {
@@ -104,16 +160,31 @@ func handleSongInfo(instance *discord.ServerInstance, message *discordgo.Message
 				MessageEmbed
 			instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to insert song request.")
 		}
-		return
+		return nil
 	}
+	// Measuring the song while it waits in the queue lets it play at an even loudness the first time.
+	instance.MeasureSongLoudness(newSong)
+	// A song someone asked for takes over from autoplay.
+	instance.StopAutoplay()
 	if sendQueueMessage {
-		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 28804).
+		embed := discord.NewEmbedInfer(instance.Session.State.User, 28804).
 			AddField("Song has been added to the queue", fmt.Sprintf("[%s](%s)",
 				songInfo.Title, songInfo.WebpageURL), false).
 			AddField("Requested By", message.Author.Username, false).
-			SetImage(songInfo.Thumbnail).
-			MessageEmbed
-		instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send song added to queue message.")
+			SetImage(songInfo.Thumbnail)
+		if newSong.IsStream {
+			embed.AddField("Duration", discord.FormatSongDuration(newSong), true)
+		}
+		queuePosition, wait, waitKnown, errPosition := instance.SongQueuePosition(instance.Ctx, newSongRequest)
+		if errPosition == nil {
+			waitText := discord.FormatQueueWait(wait)
+			if !waitKnown {
+				waitText = fmt.Sprintf("At least %s, a live stream plays first", waitText)
+			}
+			embed.AddField("Position in queue", strconv.Itoa(queuePosition), true).
+				AddField("Estimated time until playing", waitText, true)
+		}
+		instance.SendEmbedMessage(embed.MessageEmbed, musicChatChannelID, "Unable to send song added to queue message.")
 	}
 
 	s := *newSong
@@ -121,4 +192,19 @@ func handleSongInfo(instance *discord.ServerInstance, message *discordgo.Message
 	instance.SendSongQueueEvent(music.SongQueueEvent{
 		Song: &s, SongRequest: &sr, Type: music.SongAdded},
 	)
+	return newSongRequest
+}
+
+func sendPolicyError(instance *discord.ServerInstance, message *discordgo.Message, err error, musicChatChannelID string) {
+	var violation *discord.MusicPolicyViolation
+	if !errors.As(err, &violation) {
+		instance.Log.Error().Err(err).Msg("Unable to check music policy.")
+		instance.SendErrorEmbed("Unable to add song request.", "Database error.", musicChatChannelID)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
+		AddField("Song request not allowed.", violation.Reason, false).
+		AddField("Requested By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send music policy error message.")
 }
@@ -1,7 +1,9 @@
 package music
 
 import (
+	"context"
 	"strconv"
+	"strings"
 
 	"thalassa_discord/pkg/discord"
 	"thalassa_discord/pkg/music"
@@ -14,10 +16,6 @@ func playList(instance *discord.ServerInstance, message *discordgo.Message, args
 	musicChatChannelID := instance.Configuration.MusicTextChannelID
 	instance.RUnlock()
 
-	instance.MusicData.RLock()
-	ctx := instance.MusicData.SkipAllCtx
-	instance.MusicData.RUnlock()
-
 	err := instance.Session.ChannelMessageDelete(message.ChannelID, message.ID)
 	if err != nil {
 		instance.Log.Error().Err(err).Msg("Unable to delete message.")
@@ -30,61 +28,61 @@ func playList(instance *discord.ServerInstance, message *discordgo.Message, args
 		return
 	}
 
-	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.DARKER_GREY).
-		SetTitle("Attempting to parse a playlist").
-		SetDescription("This can take a few minutes depending on the size of the playlist.").
-		AddField("Requested by", message.Author.Username, false).
-		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
-		MessageEmbed
-	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send message about song queue addition.")
-
 	shufflePlaylist := false
-	if len(args) > 1 && (args[1] == "shuffle" || args[1] == "random") {
+	if last := args[len(args)-1]; len(args) > 1 && (last == "shuffle" || last == "random") {
 		shufflePlaylist = true
+		args = args[:len(args)-1]
+	}
+	link := args[0]
+	// Library searches can have spaces in them.
+	if strings.HasPrefix(link, "local:") {
+		link = strings.Join(args, " ")
 	}
 
-	playlistSongs, err := music.GetPlaylistInfo(instance.Ctx, args[0], shufflePlaylist)
-	if err != nil {
-		instance.Log.Error().Err(err).Msg("Unable to get playlist info.")
-		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
-			AddField("Error getting playlist information:", err.Error(), false).
-			MessageEmbed
-		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send playlist info error message.")
+	// How many songs the playlist has isn't known until they've all been found, so the import stops once there are
+	// too many.
+	errPolicy := instance.CheckPlaylistPolicy(message, 0)
+	if errPolicy != nil {
+		sendPolicyError(instance, message, errPolicy, musicChatChannelID.String)
 		return
 	}
 
-	done := make(chan struct{})
-	sendEmbedMessage := true
-	if len(playlistSongs) > 25 {
-		sendEmbedMessage = false
+	queuePlaylistSongs(instance, message, musicChatChannelID.String, link,
+		func(ctx context.Context, found func(*music.Song)) error {
+			return music.StreamPlaylistInfo(ctx, link, shufflePlaylist, found)
+		})
+}
+
+// queuePlaylistSongs adds the songs that list finds and are allowed to the queue, with a progress message that can
+// cancel the import, and starts playback as soon as the first ones are queued.
+func queuePlaylistSongs(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID,
+	title string, list func(ctx context.Context, found func(*music.Song)) error,
+) {
+	if !joinRequesterVoice(instance, message, musicChatChannelID) {
+		return
 	}
-	go func() {
-		for index, songInfo := range playlistSongs {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				handleSongInfo(instance, message, musicChatChannelID.String, songInfo, sendEmbedMessage)
-				if index == 0 {
-					close(done)
-				}
-			}
-		}
-	}()
-	<-done
-	// Send a single embed message when queueing more than 25 songs at a time.
-	if !sendEmbedMessage {
-		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 28804).
-			AddField("Number of playlist songs added to the queue", strconv.Itoa(len(playlistSongs)), false).
-			AddField("Requested By", message.Author.Username, false).
-			SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
-			MessageEmbed
-		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send song added to queue message.")
+	instance.ImportPlaylist(message, musicChatChannelID, title, list)
+}
+
+func cancelImport(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	all := len(args) > 0 && strings.EqualFold(args[0], "all")
+	if all && !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to cancel playlist imports.", "Only DJs can cancel everyone's imports.",
+			musicChatChannelID.String)
+		return
 	}
-	instance.MusicData.RLock()
-	currentlyPlaying := instance.MusicData.SongPlaying
-	instance.MusicData.RUnlock()
-	if !currentlyPlaying {
-		instance.TriggerNextSong <- struct{}{}
+	cancelled := instance.CancelPlaylistImports(message.Author.ID, all)
+	if cancelled == 0 {
+		instance.SendErrorEmbed("Unable to cancel playlist imports.", "No playlists are being imported.",
+			musicChatChannelID.String)
+		return
 	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Playlist imports cancelled", strconv.Itoa(cancelled), false).
+		AddField("Cancelled By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send cancel import message.")
 }
@@ -0,0 +1,154 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/null/v8"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const musicPolicyUsage = "Usage: !musicpolicy <setting> <value>. Settings: maxduration (e.g. 10:00), maxqueued, " +
+	"maxplaylist, livestreams (on/off), maxstream (e.g. 1:00:00), agelimit, extractors (e.g. youtube,soundcloud), domains (e.g. youtube.com). " +
+	"Use off to remove a limit."
+
+func formatPolicyLimit(limit null.Int) string {
+	if !limit.Valid {
+		return "No limit"
+	}
+	return strconv.Itoa(limit.Int)
+}
+
+func formatPolicyList(list null.String) string {
+	if !list.Valid {
+		return "Anything"
+	}
+	return list.String
+}
+
+func showMusicPolicy(instance *discord.ServerInstance, musicChatChannelID string) {
+	policy := instance.MusicPolicy()
+	maxDuration := "No limit"
+	if policy.MaxSongDurationSeconds.Valid {
+		maxDuration = discord.FormatQueueWait(time.Duration(policy.MaxSongDurationSeconds.Int) * time.Second)
+	}
+	liveStreams := "Allowed"
+	if !policy.AllowLiveStreams {
+		liveStreams = "Not allowed"
+	}
+	maxStream := "Until skipped"
+	if policy.MaxStreamSeconds.Valid {
+		maxStream = discord.FormatQueueWait(time.Duration(policy.MaxStreamSeconds.Int) * time.Second)
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Music policy").
+		AddField("Max song duration", maxDuration, true).
+		AddField("Max queued songs per user", formatPolicyLimit(policy.MaxQueuedSongsPerUser), true).
+		AddField("Max playlist size", formatPolicyLimit(policy.MaxPlaylistSize), true).
+		AddField("Live streams", liveStreams, true).
+		AddField("Max stream time", maxStream, true).
+		AddField("Max age limit", formatPolicyLimit(policy.MaxAgeLimit), true).
+		AddField("Allowed extractors", formatPolicyList(policy.AllowedExtractors), true).
+		AddField("Allowed domains", formatPolicyList(policy.AllowedDomains), true).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send music policy message.")
+}
+
+func parsePolicyLimit(value string) (null.Int, error) {
+	if strings.EqualFold(value, "off") {
+		return null.Int{}, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return null.Int{}, fmt.Errorf("%s isn't a number", value)
+	}
+	return null.IntFrom(limit), nil
+}
+
+func musicPolicy(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		showMusicPolicy(instance, musicChatChannelID.String)
+		return
+	}
+	if !instance.UserIsAdmin(message) {
+		instance.SendErrorEmbed("Unable to update music policy.", "Only administrators can change the music policy.",
+			musicChatChannelID.String)
+		return
+	}
+	if len(args) < 2 {
+		instance.SendErrorEmbed("Unable to update music policy.", musicPolicyUsage, musicChatChannelID.String)
+		return
+	}
+	value := strings.Join(args[1:], "")
+	var change func(policy *models.MusicPolicy)
+	var err error
+	switch strings.ToLower(args[0]) {
+	case "maxduration", "maxstream":
+		var limit null.Int
+		if !strings.EqualFold(value, "off") {
+			duration, errTimestamp := music.ParseTimestamp(value)
+			if errTimestamp != nil {
+				err = errTimestamp
+				break
+			}
+			limit = null.IntFrom(int(duration.Seconds()))
+		}
+		if strings.EqualFold(args[0], "maxduration") {
+			change = func(policy *models.MusicPolicy) { policy.MaxSongDurationSeconds = limit }
+		} else {
+			change = func(policy *models.MusicPolicy) { policy.MaxStreamSeconds = limit }
+		}
+	case "maxqueued":
+		var limit null.Int
+		limit, err = parsePolicyLimit(value)
+		change = func(policy *models.MusicPolicy) { policy.MaxQueuedSongsPerUser = limit }
+	case "maxplaylist":
+		var limit null.Int
+		limit, err = parsePolicyLimit(value)
+		change = func(policy *models.MusicPolicy) { policy.MaxPlaylistSize = limit }
+	case "agelimit":
+		var limit null.Int
+		limit, err = parsePolicyLimit(value)
+		change = func(policy *models.MusicPolicy) { policy.MaxAgeLimit = limit }
+	case "livestreams":
+		allow := strings.EqualFold(value, "on") || strings.EqualFold(value, "allow")
+		if !allow && !strings.EqualFold(value, "off") && !strings.EqualFold(value, "deny") {
+			err = fmt.Errorf("livestreams must be on or off")
+			break
+		}
+		change = func(policy *models.MusicPolicy) { policy.AllowLiveStreams = allow }
+	case "extractors", "domains":
+		list := null.StringFrom(value)
+		if strings.EqualFold(value, "off") {
+			list = null.String{}
+		}
+		if strings.EqualFold(args[0], "extractors") {
+			change = func(policy *models.MusicPolicy) { policy.AllowedExtractors = list }
+		} else {
+			change = func(policy *models.MusicPolicy) { policy.AllowedDomains = list }
+		}
+	default:
+		err = fmt.Errorf("unknown setting %s", args[0])
+	}
+	if err != nil {
+		instance.SendErrorEmbed("Unable to update music policy.", fmt.Sprintf("%s. %s", err.Error(), musicPolicyUsage),
+			musicChatChannelID.String)
+		return
+	}
+	err = instance.UpdateMusicPolicy(instance.Ctx, change)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to update music policy.")
+		instance.SendErrorEmbed("Unable to update music policy.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	showMusicPolicy(instance, musicChatChannelID.String)
+}
@@ -0,0 +1,163 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func playNext(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to play song next.", "Only DJs can add songs to the front of the queue.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	queueSong(instance, message, args, true)
+}
+
+func parseQueuePosition(arg string) (int, error) {
+	position, err := strconv.Atoi(strings.TrimSuffix(arg, "."))
+	if err != nil || position < 1 {
+		return 0, discord.ErrQueuePositionOutOfRange
+	}
+	return position, nil
+}
+
+func sendQueueError(instance *discord.ServerInstance, title string, err error) {
+	description := "Database error."
+	switch {
+	case errors.Is(err, discord.ErrQueuePositionOutOfRange):
+		description = "That position isn't in the queue. Use !next to see the queue."
+	case errors.Is(err, discord.ErrFairQueueReorder):
+		description = "Songs can't be moved while fair queue is on. Turn it off with !fairqueue off."
+	default:
+		instance.Log.Error().Err(err).Msg(title)
+	}
+	instance.SendErrorEmbed(title, description, instance.Configuration.MusicTextChannelID.String)
+}
+
+func removeSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to remove song.", "Usage: !remove <position>",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	position, err := parseQueuePosition(args[0])
+	if err != nil {
+		sendQueueError(instance, "Unable to remove song.", err)
+		return
+	}
+	songRequest, err := instance.GetQueuedSongRequest(instance.Ctx, position)
+	if err != nil {
+		sendQueueError(instance, "Unable to remove song.", err)
+		return
+	}
+	if songRequest.RequestedByUserID != message.Author.ID && !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to remove song.", "You can only remove songs you requested.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	err = instance.RemoveSongRequest(instance.Ctx, songRequest)
+	if err != nil {
+		sendQueueError(instance, "Unable to remove song.", err)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		AddField("Removed song from the queue", songRequest.SongName, false).
+		AddField("Removed By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, instance.Configuration.MusicTextChannelID.String, "Unable to send song removed message.")
+}
+
+func moveSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if len(args) < 2 {
+		instance.SendErrorEmbed("Unable to move song.", "Usage: !move <from> <to>",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	from, err := parseQueuePosition(args[0])
+	if err != nil {
+		sendQueueError(instance, "Unable to move song.", err)
+		return
+	}
+	to, err := parseQueuePosition(args[1])
+	if err != nil {
+		sendQueueError(instance, "Unable to move song.", err)
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to move song.", "Only DJs can reorder the queue.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	songRequest, err := instance.MoveSongRequest(instance.Ctx, from, to)
+	if err != nil {
+		sendQueueError(instance, "Unable to move song.", err)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField(fmt.Sprintf("Moved song from position %d to %d", from, to), songRequest.SongName, false).
+		AddField("Moved By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, instance.Configuration.MusicTextChannelID.String, "Unable to send song moved message.")
+}
+
+func shuffleQueue(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to shuffle the queue.", "Only DJs can shuffle the queue.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	numShuffled, err := instance.ShuffleSongQueue(instance.Ctx)
+	if err != nil {
+		sendQueueError(instance, "Unable to shuffle the queue.", err)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Shuffled the queue.", fmt.Sprintf("Number of songs shuffled: %d", numShuffled), false).
+		AddField("Shuffled By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, instance.Configuration.MusicTextChannelID.String, "Unable to send queue shuffled message.")
+}
+
+func clearSongs(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to clear songs.", "Usage: !clear mine or !clear @user",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	user := message.Author
+	if !strings.EqualFold(args[0], "mine") {
+		if len(message.Mentions) == 0 {
+			instance.SendErrorEmbed("Unable to clear songs.", "Usage: !clear mine or !clear @user",
+				instance.Configuration.MusicTextChannelID.String)
+			return
+		}
+		user = message.Mentions[0]
+	}
+	if user.ID != message.Author.ID && !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to clear songs.", "You can only clear songs you requested.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	numRemoved, err := instance.RemoveUserSongRequests(instance.Ctx, user.ID)
+	if err != nil {
+		sendQueueError(instance, "Unable to clear songs.", err)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		AddField(fmt.Sprintf("Cleared songs requested by %s.", user.Username),
+			fmt.Sprintf("Number removed: %d", numRemoved), false).
+		AddField("Cleared By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, instance.Configuration.MusicTextChannelID.String, "Unable to send songs cleared message.")
+}
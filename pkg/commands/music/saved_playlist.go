@@ -0,0 +1,299 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+const savedPlaylistUsage = "Usage: !playlists to see your playlists and the server's, then !playlists " +
+	"<create|show|play|add|remove|rename|delete> <name>. Examples: !playlists create chill, !playlists create " +
+	"chill server, !playlists add chill <link>, !playlists remove chill 3, !playlists rename chill relax, " +
+	"!playlists play chill shuffle. Leave out the link to add the current song."
+
+// sendSavedPlaylistError tells the user why a saved playlist couldn't be used.
+func sendSavedPlaylistError(instance *discord.ServerInstance, title string, err error, musicChatChannelID string) {
+	switch {
+	case errors.Is(err, discord.ErrSavedPlaylistNotFound):
+		instance.SendErrorEmbed(title, "You don't have a playlist with that name, and neither does the server. "+
+			"Use !playlists to see them.", musicChatChannelID)
+	case errors.Is(err, discord.ErrSavedPlaylistExists), errors.Is(err, discord.ErrSavedPlaylistName),
+		errors.Is(err, discord.ErrSavedPlaylistSongNotFound):
+		instance.SendErrorEmbed(title, err.Error()+".", musicChatChannelID)
+	case errors.Is(err, discord.ErrSavedPlaylistFull):
+		instance.SendErrorEmbed(title, fmt.Sprintf("Playlists can have up to %d songs.",
+			discord.MaxSavedPlaylistSongs), musicChatChannelID)
+	default:
+		instance.Log.Error().Err(err).Msg(title)
+		instance.SendErrorEmbed(title, "Database error.", musicChatChannelID)
+	}
+}
+
+func savedPlaylistOwner(playlist *models.SavedPlaylist) string {
+	if discord.SavedPlaylistShared(playlist) {
+		return "Server"
+	}
+	return fmt.Sprintf("<@%s>", playlist.OwnerUserID.String)
+}
+
+func showSavedPlaylists(instance *discord.ServerInstance, message *discordgo.Message, musicChatChannelID string) {
+	playlists, err := instance.SavedPlaylists(instance.Ctx, message.Author.ID)
+	if err != nil {
+		sendSavedPlaylistError(instance, "Unable to show playlists.", err, musicChatChannelID)
+		return
+	}
+	counts, err := instance.SavedPlaylistSongCounts(instance.Ctx, playlists)
+	if err != nil {
+		sendSavedPlaylistError(instance, "Unable to show playlists.", err, musicChatChannelID)
+		return
+	}
+	embed := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Saved playlists").
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png")
+	if len(playlists) == 0 {
+		embed.SetDescription("There are no saved playlists yet. Make one with !playlists create <name>.")
+	}
+	for index, playlist := range playlists {
+		// Embeds can only hold 25 fields.
+		if index == 24 && len(playlists) > 25 {
+			embed.AddField("More", fmt.Sprintf("%d more playlists not shown.", len(playlists)-24), false)
+			break
+		}
+		embed.AddField(playlist.Name, fmt.Sprintf("%s, %d songs", savedPlaylistOwner(playlist), counts[playlist.ID]),
+			true)
+	}
+	instance.SendEmbedMessage(embed.MessageEmbed, musicChatChannelID, "Unable to send saved playlists message.")
+}
+
+func showSavedPlaylist(instance *discord.ServerInstance, playlist *models.SavedPlaylist, musicChatChannelID string) {
+	playlistSongs, err := instance.SavedPlaylistSongs(instance.Ctx, playlist)
+	if err != nil {
+		sendSavedPlaylistError(instance, "Unable to show playlist.", err, musicChatChannelID)
+		return
+	}
+	var songs strings.Builder
+	var totalDuration time.Duration
+	for index, playlistSong := range playlistSongs {
+		song := playlistSong.R.Song
+		totalDuration += time.Duration(song.DurationInSeconds.Int) * time.Second
+		// Stay well under the embed description limit.
+		if songs.Len() > 3500 {
+			continue
+		}
+		songs.WriteString(fmt.Sprintf("%d. [%s](%s)", index+1, song.SongName, song.URL))
+		if duration := discord.FormatSongDuration(song); duration != "" {
+			songs.WriteString(" " + duration)
+		}
+		songs.WriteString("\n")
+	}
+	if len(playlistSongs) == 0 {
+		songs.WriteString("No songs yet. Add one with !playlists add " + playlist.Name + " <link>.")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(playlist.Name).
+		SetDescription(songs.String()).
+		AddField("Owner", savedPlaylistOwner(playlist), true).
+		AddField("Songs", strconv.Itoa(len(playlistSongs)), true).
+		AddField("Length", discord.FormatQueueWait(totalDuration), true).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send saved playlist message.")
+}
+
+func playSavedPlaylist(instance *discord.ServerInstance, message *discordgo.Message,
+	playlist *models.SavedPlaylist, shuffle bool, musicChatChannelID string,
+) {
+	playlistSongs, err := instance.SavedPlaylistSongs(instance.Ctx, playlist)
+	if err != nil {
+		sendSavedPlaylistError(instance, "Unable to play playlist.", err, musicChatChannelID)
+		return
+	}
+	if len(playlistSongs) == 0 {
+		instance.SendErrorEmbed("Unable to play playlist.", "The playlist has no songs.", musicChatChannelID)
+		return
+	}
+	songs := make([]*music.Song, 0, len(playlistSongs))
+	for _, playlistSong := range playlistSongs {
+		songs = append(songs, music.SongFromModel(playlistSong.R.Song))
+	}
+	if shuffle {
+		rand.Shuffle(len(songs), func(i, j int) {
+			songs[i], songs[j] = songs[j], songs[i]
+		})
+	}
+	errPolicy := instance.CheckPlaylistPolicy(message, len(songs))
+	if errPolicy != nil {
+		sendPolicyError(instance, message, errPolicy, musicChatChannelID)
+		return
+	}
+	queuePlaylistSongs(instance, message, musicChatChannelID, playlist.Name,
+		func(ctx context.Context, found func(*music.Song)) error {
+			for _, song := range songs {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				found(song)
+			}
+			return nil
+		})
+}
+
+// savedPlaylistSong finds the song to add to a playlist from the link in args, or the current song if there is none.
+func savedPlaylistSong(instance *discord.ServerInstance, message *discordgo.Message,
+	args []string,
+) (*models.Song, error) {
+	if len(args) == 0 {
+		instance.MusicData.RLock()
+		defer instance.MusicData.RUnlock()
+		if !instance.MusicData.SongPlaying || instance.MusicData.CurrentSong == nil {
+			return nil, errors.New("no song is playing")
+		}
+		return instance.MusicData.CurrentSong, nil
+	}
+	link := args[0]
+	// Library searches can have spaces in them.
+	if strings.HasPrefix(link, "local:") {
+		link = strings.Join(args, " ")
+	}
+	songInfo, err := music.GetSongInfo(instance.Ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	err = instance.CheckSavedPlaylistSong(instance.Ctx, message.Author.ID, songInfo)
+	if err != nil {
+		return nil, err
+	}
+	if songInfo.Thumbnail == "" && len(songInfo.Thumbnails) > 0 {
+		songInfo.Thumbnail = songInfo.Thumbnails[len(songInfo.Thumbnails)-1].URL
+	}
+	song, err := instance.SaveSong(instance.Ctx, songInfo)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to upsert song.")
+		return nil, errors.New("database error")
+	}
+	return song, nil
+}
+
+func savedPlaylists(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		showSavedPlaylists(instance, message, musicChatChannelID.String)
+		return
+	}
+	if len(args) < 2 {
+		instance.SendErrorEmbed("Unable to use playlists.", savedPlaylistUsage, musicChatChannelID.String)
+		return
+	}
+	action, name := strings.ToLower(args[0]), args[1]
+
+	if action == "create" || action == "new" {
+		shared := len(args) > 2 && (strings.EqualFold(args[2], "server") || strings.EqualFold(args[2], "shared"))
+		if shared && !instance.UserIsDJ(message) {
+			instance.SendErrorEmbed("Unable to create playlist.", "Only DJs can create playlists for the whole server.",
+				musicChatChannelID.String)
+			return
+		}
+		playlist, err := instance.CreateSavedPlaylist(instance.Ctx, name, message.Author.ID, shared)
+		if err != nil {
+			sendSavedPlaylistError(instance, "Unable to create playlist.", err, musicChatChannelID.String)
+			return
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+			AddField("Created playlist", playlist.Name, false).
+			AddField("Owner", savedPlaylistOwner(playlist), false).
+			AddField("Adding songs", fmt.Sprintf("!playlists add %s <link>, or leave out the link to add the "+
+				"current song.", playlist.Name), false).
+			SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send playlist created message.")
+		return
+	}
+
+	playlist, err := instance.FindSavedPlaylist(instance.Ctx, message.Author.ID, name)
+	if err != nil {
+		sendSavedPlaylistError(instance, "Unable to use playlist.", err, musicChatChannelID.String)
+		return
+	}
+	switch action {
+	case "show", "list":
+		showSavedPlaylist(instance, playlist, musicChatChannelID.String)
+		return
+	case "play", "queue":
+		shuffle := len(args) > 2 && (strings.EqualFold(args[2], "shuffle") || strings.EqualFold(args[2], "random"))
+		playSavedPlaylist(instance, message, playlist, shuffle, musicChatChannelID.String)
+		return
+	case "add", "remove", "rename", "delete":
+	default:
+		instance.SendErrorEmbed("Unable to use playlists.", savedPlaylistUsage, musicChatChannelID.String)
+		return
+	}
+
+	if !instance.CanEditSavedPlaylist(message, playlist) {
+		instance.SendErrorEmbed("Unable to change playlist.", "Only the person who created a server playlist and "+
+			"DJs can change it.", musicChatChannelID.String)
+		return
+	}
+	embed := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png")
+	switch action {
+	case "add":
+		song, errSong := savedPlaylistSong(instance, message, args[2:])
+		if errSong != nil {
+			instance.SendErrorEmbed("Unable to add song to playlist.", errSong.Error(), musicChatChannelID.String)
+			return
+		}
+		_, err = instance.AddSavedPlaylistSong(instance.Ctx, playlist, song, message.Author.ID)
+		if err != nil {
+			sendSavedPlaylistError(instance, "Unable to add song to playlist.", err, musicChatChannelID.String)
+			return
+		}
+		embed.AddField(fmt.Sprintf("Added song to %s", playlist.Name), fmt.Sprintf("[%s](%s)", song.SongName,
+			song.URL), false)
+	case "remove":
+		position := 0
+		if len(args) > 2 {
+			position, _ = strconv.Atoi(args[2])
+		}
+		removed, errRemove := instance.RemoveSavedPlaylistSong(instance.Ctx, playlist, position)
+		if errRemove != nil {
+			sendSavedPlaylistError(instance, "Unable to remove song from playlist.", errRemove,
+				musicChatChannelID.String)
+			return
+		}
+		embed.AddField(fmt.Sprintf("Removed song from %s", playlist.Name), fmt.Sprintf("[%s](%s)",
+			removed.R.Song.SongName, removed.R.Song.URL), false)
+	case "rename":
+		if len(args) < 3 {
+			instance.SendErrorEmbed("Unable to rename playlist.", savedPlaylistUsage, musicChatChannelID.String)
+			return
+		}
+		oldName := playlist.Name
+		err = instance.RenameSavedPlaylist(instance.Ctx, playlist, args[2])
+		if err != nil {
+			sendSavedPlaylistError(instance, "Unable to rename playlist.", err, musicChatChannelID.String)
+			return
+		}
+		embed.AddField("Renamed playlist", fmt.Sprintf("%s is now %s", oldName, playlist.Name), false)
+	case "delete":
+		err = instance.DeleteSavedPlaylist(instance.Ctx, playlist)
+		if err != nil {
+			sendSavedPlaylistError(instance, "Unable to delete playlist.", err, musicChatChannelID.String)
+			return
+		}
+		embed.AddField("Deleted playlist", playlist.Name, false)
+	}
+	embed.AddField("Changed By", message.Author.Username, false)
+	instance.SendEmbedMessage(embed.MessageEmbed, musicChatChannelID.String, "Unable to send playlist changed message.")
+}
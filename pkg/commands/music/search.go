@@ -0,0 +1,109 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+// searchResults is how many songs !search shows to pick from.
+const searchResults = 5
+
+// truncate shortens s to at most max characters, which is what Discord allows in menu labels.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
+
+func formatSearchResultDuration(songInfo *music.Song) string {
+	if songInfo.Live() {
+		return "🔴 LIVE"
+	}
+	if songInfo.Duration <= 0 {
+		return "Unknown length"
+	}
+	return discord.FormatQueueWait(time.Duration(songInfo.Duration * float64(time.Second)))
+}
+
+func searchSongs(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to search for songs.", "Usage: !search <words>", musicChatChannelID.String)
+		return
+	}
+	query := strings.Join(args, " ")
+	results, err := music.Search(instance.Ctx, query, searchResults)
+	if errors.Is(err, music.ErrNoSearchResults) {
+		instance.SendErrorEmbed("Unable to search for songs.", fmt.Sprintf("Nothing was found for %s.", query),
+			musicChatChannelID.String)
+		return
+	}
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to search for songs.")
+		instance.SendErrorEmbed("Unable to search for songs.", err.Error(), musicChatChannelID.String)
+		return
+	}
+
+	var list strings.Builder
+	options := make([]discordgo.SelectMenuOption, 0, len(results))
+	for index, songInfo := range results {
+		duration := formatSearchResultDuration(songInfo)
+		list.WriteString(fmt.Sprintf("%d. [%s](%s) `%s`", index+1, songInfo.Title, songInfo.WebpageURL, duration))
+		if songInfo.Uploader != "" {
+			list.WriteString(" by " + songInfo.Uploader)
+		}
+		list.WriteString("\n")
+		description := duration
+		if songInfo.Uploader != "" {
+			description = fmt.Sprintf("%s, %s", songInfo.Uploader, duration)
+		}
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       truncate(fmt.Sprintf("%d. %s", index+1, songInfo.Title), 100),
+			Description: truncate(description, 100),
+			Value:       strconv.Itoa(index),
+		})
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(fmt.Sprintf("Search results for %s", query)).
+		SetDescription(list.String()).
+		AddField("Pick a song", fmt.Sprintf("%s, pick a song from the menu or reply with its number.",
+			message.Author.Username), false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	searchMessage, err := instance.Session.ChannelMessageSendComplex(musicChatChannelID.String, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{embedmsg},
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    discord.SearchPickMenuID,
+					Placeholder: "Pick a song",
+					Options:     options,
+				},
+			}},
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "Cancel", Style: discordgo.SecondaryButton,
+					CustomID: discord.SearchCancelButtonID},
+			}},
+		},
+	})
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to send search results message.")
+		return
+	}
+	instance.AwaitSearchPick(message.Author.ID, musicChatChannelID.String, searchMessage.ID, len(results),
+		func(choice int) {
+			queueSongLink(instance, message, musicChatChannelID.String, results[choice].WebpageURL, false)
+		})
+}
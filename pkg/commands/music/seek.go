@@ -0,0 +1,95 @@
+package music
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+	"thalassa_discord/pkg/music"
+)
+
+func seekSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to seek.", "Usage: !seek <timestamp>. Example: !seek 1:23",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	position, err := music.ParseTimestamp(args[0])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to seek.", "Timestamps look like 1:23, 83 or 1m23s.",
+			instance.Configuration.MusicTextChannelID.String)
+		return
+	}
+	seekSongTo(instance, message, position, "Seeked to")
+}
+
+func forwardSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	offset, ok := parseSeekOffset(instance, args, "!forward")
+	if !ok {
+		return
+	}
+	seekSongTo(instance, message, instance.SongPosition()+offset, "Skipped forward to")
+}
+
+func rewindSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	offset, ok := parseSeekOffset(instance, args, "!rewind")
+	if !ok {
+		return
+	}
+	seekSongTo(instance, message, instance.SongPosition()-offset, "Rewound to")
+}
+
+// parseSeekOffset parses the amount to move by for forward and rewind, defaulting to 10 seconds.
+func parseSeekOffset(instance *discord.ServerInstance, args []string, command string) (time.Duration, bool) {
+	if len(args) == 0 {
+		return 10 * time.Second, true
+	}
+	offset, err := music.ParseTimestamp(args[0])
+	if err != nil {
+		instance.SendErrorEmbed("Unable to seek.", "Usage: "+command+" <time>. Example: "+command+" 30s",
+			instance.Configuration.MusicTextChannelID.String)
+		return 0, false
+	}
+	return offset, true
+}
+
+func seekSongTo(instance *discord.ServerInstance, message *discordgo.Message, position time.Duration, title string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if position < 0 {
+		position = 0
+	}
+	err := instance.SeekSong(position)
+	if err != nil {
+		switch {
+		case errors.Is(err, discord.ErrNoSongPlaying):
+			instance.SendErrorEmbed("Unable to seek.", "No song is currently playing.", musicChatChannelID.String)
+		case errors.Is(err, discord.ErrSeekPastEnd):
+			instance.SendErrorEmbed("Unable to seek.", "That is past the end of the song.", musicChatChannelID.String)
+		case errors.Is(err, discord.ErrSeekStream):
+			instance.SendErrorEmbed("Unable to seek.", "Live streams can't be seeked.", musicChatChannelID.String)
+		default:
+			instance.Log.Error().Err(err).Msg("Unable to seek song.")
+			instance.SendErrorEmbed("Unable to seek.", err.Error(), musicChatChannelID.String)
+		}
+		return
+	}
+	instance.MusicData.RLock()
+	songName := instance.MusicData.CurrentSongRequest.SongName
+	duration := time.Duration(instance.MusicData.SongDurationSeconds) * time.Second
+	instance.MusicData.RUnlock()
+	timestamp := discord.FormatQueueWait(position)
+	if duration > 0 {
+		timestamp = strings.Join([]string{timestamp, discord.FormatQueueWait(duration)}, " / ")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField(title+" "+timestamp, songName, false).
+		AddField("Requested By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send song seeked message.")
+}
@@ -2,22 +2,40 @@ package music
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"thalassa_discord/models"
 	"thalassa_discord/pkg/discord"
 	"thalassa_discord/pkg/music"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func skipSong(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	skipSongRequest(instance, message, 0)
+}
+
+// skipSongRequest skips the song that is playing. If songRequestID is set, the song is only skipped if it's still that
+// song request, so a vote for a song that already ended doesn't skip the next one.
+func skipSongRequest(instance *discord.ServerInstance, message *discordgo.Message, songRequestID int64) {
 	instance.RLock()
-	songPlaying := instance.MusicData.SongPlaying
 	musicChatChannelID := instance.Configuration.MusicTextChannelID
-	songRequestID := instance.MusicData.CurrentSongRequest.ID
-	songName := instance.MusicData.CurrentSong.SongName
-	cancelSongFunc := instance.MusicData.CtxCancel
 	instance.RUnlock()
+	instance.MusicData.RLock()
+	songPlaying := instance.MusicData.SongPlaying && instance.MusicData.CurrentSongRequest != nil
+	var songName string
+	var cancelSongFunc context.CancelFunc
+	if songPlaying {
+		songPlaying = songRequestID == 0 || instance.MusicData.CurrentSongRequest.ID == songRequestID
+		songRequestID = instance.MusicData.CurrentSongRequest.ID
+		songName = instance.MusicData.CurrentSongRequest.SongName
+		cancelSongFunc = instance.MusicData.CtxCancel
+	}
+	instance.MusicData.RUnlock()
 	if !songPlaying {
 		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xff9999).
 			AddField("Error skipping song.", "No song is currently playing.", false).
@@ -51,11 +69,16 @@ func skipAllSongs(instance *discord.ServerInstance, message *discordgo.Message,
 
 	skipAllCtx, skipAllCtxCancel := context.WithCancel(instance.Ctx)
 	instance.MusicData.Lock()
-	songRequestID := instance.MusicData.CurrentSongRequest.ID
+	var songRequestID int64
+	if instance.MusicData.CurrentSongRequest != nil {
+		songRequestID = instance.MusicData.CurrentSongRequest.ID
+	}
 	skipAllCtxCancelFunc := instance.MusicData.SkipAllCtxCancel
 	skipAllCtxCancelFunc()
 	instance.MusicData.SkipAllCtx = skipAllCtx
 	instance.MusicData.SkipAllCtxCancel = skipAllCtxCancel
+	// Keep the queue loop from adding the current song back.
+	instance.MusicData.SkippedAll = true
 	instance.MusicData.Unlock()
 
 	res, err := instance.Db.Exec(`update song_request set played = true where guild_id = $1 and played = false and id != $2`,
@@ -68,6 +91,8 @@ func skipAllSongs(instance *discord.ServerInstance, message *discordgo.Message,
 			MessageEmbed
 		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send skip song error")
 	}
+	// The next song was prefetched from the queue that was just skipped.
+	instance.DiscardPrefetch()
 	numDeleted, err := res.RowsAffected()
 	if err != nil {
 		instance.Log.Error().Err(err).Msg("Unable to read rows effected.")
@@ -84,3 +109,65 @@ func skipAllSongs(instance *discord.ServerInstance, message *discordgo.Message,
 		Song: nil, SongRequest: nil, Type: music.SongSkippedAll},
 	)
 }
+
+func voteSkip(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	result, err := instance.AddSkipVote(message.Author.ID)
+	if err != nil {
+		description := err.Error()
+		switch {
+		case errors.Is(err, discord.ErrNoSongPlaying):
+			description = "No song is currently playing."
+		case errors.Is(err, discord.ErrNotInVoiceChannel):
+			description = "You must be listening in the music voice channel to vote."
+		default:
+			instance.Log.Error().Err(err).Msg("Unable to add skip vote.")
+		}
+		instance.SendErrorEmbed("Unable to vote to skip.", description, musicChatChannelID.String)
+		return
+	}
+	if result.Skip {
+		skipSongRequest(instance, message, result.SongRequestID)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, 0xffd9d9).
+		AddField("Vote to skip", result.SongName, false).
+		AddField("Votes", fmt.Sprintf("%d/%d (%d listening)", result.Votes, result.Needed, result.Listeners), false).
+		AddField("Voted By", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send vote skip message.")
+}
+
+func setVoteSkipThreshold(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		instance.SendErrorEmbed("Unable to set vote skip threshold.", "Usage: !voteskipthreshold <percent>",
+			musicChatChannelID.String)
+		return
+	}
+	threshold, err := strconv.Atoi(strings.TrimSuffix(args[0], "%"))
+	if err != nil || threshold < 1 || threshold > 100 {
+		instance.SendErrorEmbed("Unable to set vote skip threshold.", "The threshold must be a percentage from 1 to 100.",
+			musicChatChannelID.String)
+		return
+	}
+	instance.Lock()
+	instance.Configuration.VoteSkipThresholdPercent = threshold
+	_, err = instance.Configuration.Update(instance.Ctx, instance.Db,
+		boil.Whitelist(models.DiscordServerColumns.VoteSkipThresholdPercent))
+	instance.Unlock()
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to update vote skip threshold.")
+		instance.SendErrorEmbed("Unable to set vote skip threshold.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Vote skip threshold updated", fmt.Sprintf("%d%% of listeners must vote to skip a song.", threshold), false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send vote skip threshold message.")
+}
@@ -5,22 +5,33 @@ import (
 	"github.com/bwmarrin/discordgo"
 	"github.com/dustin/go-humanize/english"
 	"thalassa_discord/pkg/discord"
-	"time"
 )
 
 func songLeft(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
 	instance.MusicData.RLock()
-	defer instance.MusicData.RUnlock()
+	songPlaying := instance.MusicData.SongPlaying
+	isStream := instance.MusicData.IsStream
+	instance.MusicData.RUnlock()
 
-	if !instance.MusicData.SongPlaying {
+	if !songPlaying {
 		_, err := instance.Session.ChannelMessageSend(message.ChannelID, "No song is currently playing.")
 		if err != nil {
 			instance.Log.Error().Err(err).Msg("Unable to send channel message.")
 		}
 		return
 	}
-	songEstimatedEnd := instance.MusicData.SongStarted.Add(time.Duration(instance.MusicData.SongDurationSeconds) * time.Second)
-	left := songEstimatedEnd.Sub(time.Now())
+	left, leftKnown := instance.CurrentSongTimeLeft()
+	if !leftKnown {
+		friendlyString := "The current song is a live stream. It will play until it's skipped."
+		if instance.SongPaused() {
+			friendlyString += " The stream is paused."
+		}
+		_, err := instance.Session.ChannelMessageSend(message.ChannelID, friendlyString)
+		if err != nil {
+			instance.Log.Error().Err(err).Msg("Unable to send channel message.")
+		}
+		return
+	}
 
 	// Convert to hours, minutes, seconds.
 	hours := int64(left.Hours())
@@ -42,6 +53,12 @@ func songLeft(instance *discord.ServerInstance, message *discordgo.Message, args
 	}
 
 	friendlyString := fmt.Sprintf("%s left in the current song.", english.WordSeries(wordSlice, "and"))
+	if isStream {
+		friendlyString = fmt.Sprintf("%s left before the live stream is stopped.", english.WordSeries(wordSlice, "and"))
+	}
+	if instance.SongPaused() {
+		friendlyString += " The song is paused."
+	}
 	_, err := instance.Session.ChannelMessageSend(message.ChannelID, friendlyString)
 	if err != nil {
 		instance.Log.Error().Err(err).Msg("Unable to send channel message.")
@@ -0,0 +1,211 @@
+package music
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+const (
+	// defaultHistorySongs is how many songs !history shows without a number.
+	defaultHistorySongs = 10
+	// maxHistorySongs is the most songs !history shows.
+	maxHistorySongs = 25
+	// topStatsEntries is how many songs or requesters the top lists show.
+	topStatsEntries = 10
+
+	statsPeriodUsage = "Pick a period of day, week, month, year or all."
+)
+
+func formatListeningTime(seconds int64) string {
+	hours := float64(seconds) / 3600
+	if hours < 1 {
+		return fmt.Sprintf("%d minutes", seconds/60)
+	}
+	return fmt.Sprintf("%.1f hours", hours)
+}
+
+func formatSkipRate(skips, plays int) string {
+	if plays == 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%.0f%%", float64(skips)/float64(plays)*100)
+}
+
+// parseStatsPeriodArg parses the optional period argument, sending an error message if it's not a period.
+func parseStatsPeriodArg(instance *discord.ServerInstance, title string, args []string, musicChatChannelID string) (
+	discord.StatsPeriod, bool,
+) {
+	if len(args) == 0 {
+		return discord.StatsAllTime, true
+	}
+	period, err := discord.ParseStatsPeriod(args[0])
+	if err != nil {
+		instance.SendErrorEmbed(title, statsPeriodUsage, musicChatChannelID)
+		return "", false
+	}
+	return period, true
+}
+
+func songHistory(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	limit := defaultHistorySongs
+	if len(args) > 0 {
+		number, err := strconv.Atoi(args[0])
+		if err != nil || number < 1 {
+			instance.SendErrorEmbed("Unable to show song history.", "Usage: !history [number of songs]",
+				musicChatChannelID.String)
+			return
+		}
+		limit = number
+	}
+	if limit > maxHistorySongs {
+		limit = maxHistorySongs
+	}
+	history, err := instance.SongHistory(instance.Ctx, limit)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get song history.")
+		instance.SendErrorEmbed("Unable to show song history.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	var songs strings.Builder
+	for index, songRequest := range history {
+		songs.WriteString(fmt.Sprintf("%d. ", index+1))
+		if songRequest.R.Song != nil {
+			songs.WriteString(fmt.Sprintf("[%s](%s)", songRequest.R.Song.SongName, songRequest.R.Song.URL))
+		} else {
+			songs.WriteString(songRequest.SongName)
+		}
+		songs.WriteString(fmt.Sprintf(" requested by %s, %s ago", songRequest.UsernameAtTime,
+			discord.FormatQueueWait(time.Since(songRequest.PlayedAt.Time).Truncate(time.Second))))
+		if songRequest.Skipped {
+			songs.WriteString(" (skipped)")
+		}
+		songs.WriteString("\n")
+	}
+	if len(history) == 0 {
+		songs.WriteString("No songs have been played yet.")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle("Recently played songs").
+		SetDescription(songs.String()).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send song history message.")
+}
+
+func topSongs(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	period, ok := parseStatsPeriodArg(instance, "Unable to show top songs.", args, musicChatChannelID.String)
+	if !ok {
+		return
+	}
+	stats, err := instance.GetMusicStats(instance.Ctx, period, "")
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get music stats.")
+		instance.SendErrorEmbed("Unable to show top songs.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	top, err := instance.TopSongs(instance.Ctx, period, "", topStatsEntries)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get top songs.")
+		instance.SendErrorEmbed("Unable to show top songs.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	var songs strings.Builder
+	for index, songStats := range top {
+		songs.WriteString(fmt.Sprintf("%d. [%s](%s), %d plays, %s skipped\n", index+1, songStats.Song.SongName,
+			songStats.Song.URL, songStats.Plays, formatSkipRate(songStats.Skips, songStats.Plays)))
+	}
+	if len(top) == 0 {
+		songs.WriteString("No songs were played.")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(fmt.Sprintf("Top songs: %s", period.FriendlyName())).
+		SetDescription(songs.String()).
+		AddField("Songs played", strconv.Itoa(stats.Played), true).
+		AddField("Different songs", strconv.Itoa(stats.Songs), true).
+		AddField("Music played", formatListeningTime(stats.PlayedSeconds), true).
+		AddField("Skip rate", formatSkipRate(stats.Skipped, stats.Played), true).
+		AddField("Autoplayed", strconv.Itoa(stats.Autoplayed), true).
+		AddField("Requesters", strconv.Itoa(stats.Requesters), true).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send top songs message.")
+}
+
+func topRequesters(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	period, ok := parseStatsPeriodArg(instance, "Unable to show top requesters.", args, musicChatChannelID.String)
+	if !ok {
+		return
+	}
+	top, err := instance.TopRequesters(instance.Ctx, period, topStatsEntries)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get top requesters.")
+		instance.SendErrorEmbed("Unable to show top requesters.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	var requesters strings.Builder
+	for index, requester := range top {
+		requesters.WriteString(fmt.Sprintf("%d. <@%s>, %d songs, %s of music, %s skipped\n", index+1,
+			requester.UserID, requester.Requests, formatListeningTime(requester.PlayedSeconds),
+			formatSkipRate(requester.Skips, requester.Requests)))
+	}
+	if len(top) == 0 {
+		requesters.WriteString("Nobody requested any songs.")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(fmt.Sprintf("Top requesters: %s", period.FriendlyName())).
+		SetDescription(requesters.String()).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send top requesters message.")
+}
+
+func myStats(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	period, ok := parseStatsPeriodArg(instance, "Unable to show your stats.", args, musicChatChannelID.String)
+	if !ok {
+		return
+	}
+	stats, err := instance.GetMusicStats(instance.Ctx, period, message.Author.ID)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get music stats.")
+		instance.SendErrorEmbed("Unable to show your stats.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	favorite := "None yet"
+	top, err := instance.TopSongs(instance.Ctx, period, message.Author.ID, 1)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to get top songs.")
+		instance.SendErrorEmbed("Unable to show your stats.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	if len(top) > 0 {
+		favorite = fmt.Sprintf("[%s](%s), %d plays", top[0].Song.SongName, top[0].Song.URL, top[0].Plays)
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		SetTitle(fmt.Sprintf("Music stats for %s: %s", message.Author.Username, period.FriendlyName())).
+		AddField("Songs played", strconv.Itoa(stats.Played), true).
+		AddField("Different songs", strconv.Itoa(stats.Songs), true).
+		AddField("Music played", formatListeningTime(stats.PlayedSeconds), true).
+		AddField("Skip rate", formatSkipRate(stats.Skipped, stats.Played), true).
+		AddField("Most played song", favorite, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send music stats message.")
+}
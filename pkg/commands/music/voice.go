@@ -0,0 +1,124 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func joinVoice(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	channelID, err := instance.MoveToVoiceChannel(instance.Ctx, message.Author.ID, instance.UserIsDJ(message))
+	switch {
+	case errors.Is(err, discord.ErrRequesterNotInVoice):
+		instance.SendErrorEmbed("Unable to join voice.", "Join a voice channel first.", musicChatChannelID.String)
+		return
+	case errors.Is(err, discord.ErrNoMusicVoiceChannel):
+		instance.SendErrorEmbed("Unable to join voice.", "No music voice channel is set.", musicChatChannelID.String)
+		return
+	case errors.Is(err, discord.ErrVoiceChannelBusy):
+		instance.SendErrorEmbed("Unable to join voice.",
+			"I'm playing music for other listeners. Only DJs can move me until the queue is empty.",
+			musicChatChannelID.String)
+		return
+	case err != nil:
+		instance.Log.Error().Err(err).Msg("Unable to join voice channel.")
+		instance.SendErrorEmbed("Unable to join voice.", "Unable to join the voice channel.",
+			musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Joined voice", fmt.Sprintf("<#%s>", channelID), false).
+		AddField("Requested By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send join voice message.")
+}
+
+func leaveVoice(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to leave voice.", "Only DJs can make me leave voice.",
+			musicChatChannelID.String)
+		return
+	}
+	err := instance.LeaveVoiceChannel()
+	if errors.Is(err, discord.ErrNotConnectedToVoice) {
+		instance.SendErrorEmbed("Unable to leave voice.", "I'm not in a voice channel.", musicChatChannelID.String)
+		return
+	}
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to leave voice channel.")
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Left voice", "The queue is kept, and the current song picks up where it left off when I'm brought "+
+			"back with !join or a request.", false).
+		AddField("Requested By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send leave voice message.")
+}
+
+func voiceModeDescription(instance *discord.ServerInstance, follow bool) string {
+	instance.RLock()
+	musicVoiceChannelID := instance.Configuration.MusicVoiceChannelID.String
+	instance.RUnlock()
+	if follow {
+		if musicVoiceChannelID == "" {
+			return "I join the voice channel of whoever requests a song."
+		}
+		return fmt.Sprintf("I join the voice channel of whoever requests a song, or <#%s> if they aren't in one.",
+			musicVoiceChannelID)
+	}
+	if musicVoiceChannelID == "" {
+		return "I only play in the music voice channel, but none is set."
+	}
+	return fmt.Sprintf("I only play in <#%s>.", musicVoiceChannelID)
+}
+
+func voiceMode(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	instance.RUnlock()
+	if len(args) == 0 {
+		mode := "Fixed"
+		follow := instance.MusicFollowsRequester()
+		if follow {
+			mode = "Follow"
+		}
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Voice mode", mode, false).
+			AddField("Voice channel", voiceModeDescription(instance, follow), false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send voice mode message.")
+		return
+	}
+	var follow bool
+	switch strings.ToLower(args[0]) {
+	case "follow":
+		follow = true
+	case "fixed":
+		follow = false
+	default:
+		instance.SendErrorEmbed("Unable to set voice mode.", "Usage: !voicemode follow or !voicemode fixed",
+			musicChatChannelID.String)
+		return
+	}
+	err := instance.SetMusicFollowsRequester(instance.Ctx, follow)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set voice mode.")
+		instance.SendErrorEmbed("Unable to set voice mode.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Voice mode updated", voiceModeDescription(instance, follow), false).
+		AddField("Set By", message.Author.Username, false).
+		MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send voice mode message.")
+}
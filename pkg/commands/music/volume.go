@@ -0,0 +1,52 @@
+package music
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/discord"
+)
+
+func volume(instance *discord.ServerInstance, message *discordgo.Message, args []string) {
+	instance.RLock()
+	musicChatChannelID := instance.Configuration.MusicTextChannelID
+	currentVolume := instance.Configuration.MusicVolume
+	instance.RUnlock()
+	if len(args) == 0 {
+		embedmsg := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+			AddField("Music volume", fmt.Sprintf("%d%%", int(math.Round(float64(currentVolume)*100))), false).
+			MessageEmbed
+		instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send volume message.")
+		return
+	}
+	if !instance.UserIsDJ(message) {
+		instance.SendErrorEmbed("Unable to set volume.", "Only DJs can change the volume.", musicChatChannelID.String)
+		return
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(args[0], "%"))
+	maxPercent := discord.MaxMusicVolume * 100
+	if err != nil || percent < 0 || percent > maxPercent {
+		instance.SendErrorEmbed("Unable to set volume.",
+			fmt.Sprintf("The volume must be a percentage from 0 to %d. Example: !volume 80", maxPercent),
+			musicChatChannelID.String)
+		return
+	}
+	appliedNow, err := instance.SetMusicVolume(instance.Ctx, float32(percent)/100)
+	if err != nil {
+		instance.Log.Error().Err(err).Msg("Unable to set music volume.")
+		instance.SendErrorEmbed("Unable to set volume.", "Database error.", musicChatChannelID.String)
+		return
+	}
+	embed := discord.NewEmbedInfer(instance.Session.State.User, discord.GOLD).
+		AddField("Music volume set", fmt.Sprintf("%d%%", percent), false)
+	if !appliedNow {
+		embed.AddField("Live stream", "The live stream keeps its volume, so the new volume starts with the next song.",
+			false)
+	}
+	embedmsg := embed.AddField("Set By", message.Author.Username, false).MessageEmbed
+	instance.SendEmbedMessage(embedmsg, musicChatChannelID.String, "Unable to send volume message.")
+}
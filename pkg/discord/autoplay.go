@@ -0,0 +1,121 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+// autoplayRecentSongs is how many of the guild's latest song requests autoplay won't repeat.
+const autoplayRecentSongs = 25
+
+// AutoplayEnabled reports whether the guild plays songs from its history when the queue runs dry.
+func (serverInstance *ServerInstance) AutoplayEnabled() bool {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.MusicAutoplayEnabled
+}
+
+// SetAutoplayEnabled saves whether the guild plays songs from its history when the queue runs dry.
+func (serverInstance *ServerInstance) SetAutoplayEnabled(ctx context.Context, enabled bool) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicAutoplayEnabled = enabled
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicAutoplayEnabled))
+	return err
+}
+
+// queueAutoplaySong picks a song from the guild's request history and adds it to the queue. Songs are weighted by
+// how many times people requested them without skipping them. Recently requested and blocked songs are left out.
+// It returns sql.ErrNoRows if there is nothing to pick from.
+func (serverInstance *ServerInstance) queueAutoplaySong(ctx context.Context) (*models.SongRequest, error) {
+	var songID string
+	err := serverInstance.Db.QueryRowContext(ctx, `
+		select sr.song_id
+		from song_request sr
+		join song s on s.id = sr.song_id
+		where sr.guild_id = $1
+		  and sr.autoplay = false
+		  and sr.skipped = false
+		  and s.is_stream = false
+		  and sr.song_id not in (select recent.song_id
+		                         from song_request recent
+		                         where recent.guild_id = $1
+		                           and recent.song_id is not null
+		                         order by recent.id desc
+		                         limit $2)
+		  and not exists (select 1
+		                  from music_blocklist b
+		                  where b.guild_id = $1
+		                    and ((b.entry_type = 'song' and b.value = s.id)
+		                      or (b.entry_type = 'channel' and b.value = s.channel_id)
+		                      or (b.entry_type = 'keyword' and strpos(lower(s.song_name), b.value) > 0)))
+		group by sr.song_id
+		order by -ln(1.0 - random()) / count(*)
+		limit 1`, serverInstance.GuildID, autoplayRecentSongs).Scan(&songID)
+	if err != nil {
+		return nil, err
+	}
+	song, err := models.FindSong(ctx, serverInstance.Db, songID)
+	if err != nil {
+		return nil, err
+	}
+	guild, err := serverInstance.GetGuild()
+	if err != nil {
+		return nil, err
+	}
+	songRequest := &models.SongRequest{
+		SongID:            null.StringFrom(song.ID),
+		SongName:          song.SongName,
+		RequestedByUserID: serverInstance.Session.State.User.ID,
+		UsernameAtTime:    "Autoplay",
+		GuildID:           serverInstance.GuildID,
+		GuildNameAtTime:   guild.Name,
+		RequestedAt:       time.Now().UTC(),
+		Autoplay:          true,
+	}
+	err = songRequest.Insert(ctx, serverInstance.Db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	songRequest.R = songRequest.R.NewStruct()
+	songRequest.R.Song = song
+
+	s := *song
+	sr := *songRequest
+	serverInstance.SendSongQueueEvent(music.SongQueueEvent{Song: &s, SongRequest: &sr, Type: music.SongAdded})
+	return songRequest, nil
+}
+
+// nextAutoplaySong returns a song to play when the queue is empty, or sql.ErrNoRows if autoplay is off or nobody is
+// listening.
+func (serverInstance *ServerInstance) nextAutoplaySong(ctx context.Context) (*models.SongRequest, error) {
+	if !serverInstance.AutoplayEnabled() {
+		return nil, sql.ErrNoRows
+	}
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err != nil {
+		return nil, err
+	}
+	if len(listeners) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return serverInstance.queueAutoplaySong(ctx)
+}
+
+// StopAutoplay stops the song that is playing if autoplay picked it, so a song someone requested plays right away.
+func (serverInstance *ServerInstance) StopAutoplay() {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if serverInstance.MusicData.SongPlaying && serverInstance.MusicData.CurrentSongRequest != nil &&
+		serverInstance.MusicData.CurrentSongRequest.Autoplay {
+		serverInstance.MusicData.CtxCancel()
+	}
+}
@@ -0,0 +1,199 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+// BlocklistEntryType is what a blocklist entry matches against.
+type BlocklistEntryType string
+
+const (
+	// BlocklistSong blocks a single song by its ID.
+	BlocklistSong BlocklistEntryType = "song"
+	// BlocklistChannel blocks every song uploaded by a channel.
+	BlocklistChannel BlocklistEntryType = "channel"
+	// BlocklistKeyword blocks every song with the keyword in its title.
+	BlocklistKeyword BlocklistEntryType = "keyword"
+)
+
+var (
+	ErrUnknownBlocklistEntryType = errors.New("unknown blocklist entry type")
+	ErrBlocklistEntryNotFound    = errors.New("blocklist entry not found")
+)
+
+// ParseBlocklistEntryType parses song, channel or keyword.
+func ParseBlocklistEntryType(entryType string) (BlocklistEntryType, error) {
+	switch BlocklistEntryType(strings.ToLower(entryType)) {
+	case BlocklistSong:
+		return BlocklistSong, nil
+	case BlocklistChannel:
+		return BlocklistChannel, nil
+	case BlocklistKeyword, "title", "pattern":
+		return BlocklistKeyword, nil
+	}
+	return "", ErrUnknownBlocklistEntryType
+}
+
+func (t BlocklistEntryType) FriendlyName() string {
+	switch t {
+	case BlocklistSong:
+		return "Song"
+	case BlocklistChannel:
+		return "Channel"
+	case BlocklistKeyword:
+		return "Keyword"
+	}
+	return string(t)
+}
+
+// blocklistEntryMatches reports whether the entry blocks a song with the ID, uploader channel ID and title.
+func blocklistEntryMatches(entry *models.MusicBlocklist, songID, channelID, title string) bool {
+	switch BlocklistEntryType(entry.EntryType) {
+	case BlocklistSong:
+		return songID != "" && entry.Value == songID
+	case BlocklistChannel:
+		return channelID != "" && entry.Value == channelID
+	case BlocklistKeyword:
+		return strings.Contains(strings.ToLower(title), entry.Value)
+	}
+	return false
+}
+
+// blocklistReason describes why the entry blocks a song so it can be shown to the user.
+func blocklistReason(entry *models.MusicBlocklist) string {
+	switch BlocklistEntryType(entry.EntryType) {
+	case BlocklistChannel:
+		label := entry.Value
+		if entry.Label.Valid {
+			label = entry.Label.String
+		}
+		return fmt.Sprintf("Songs uploaded by %s are blocked on this server.", label)
+	case BlocklistKeyword:
+		return fmt.Sprintf("Songs with \"%s\" in the title are blocked on this server.", entry.Value)
+	}
+	return "This song is blocked on this server."
+}
+
+// GetBlocklist returns the guild's blocklist, oldest entries first.
+func (serverInstance *ServerInstance) GetBlocklist(ctx context.Context) (models.MusicBlocklistSlice, error) {
+	return models.MusicBlocklists(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.OrderBy("id asc"),
+	).All(ctx, serverInstance.Db)
+}
+
+// CheckSongBlocklist returns a *MusicPolicyViolation if the song, its uploader or its title is on the guild's
+// blocklist. The blocklist applies to everyone, including people who can ignore the music policy.
+func (serverInstance *ServerInstance) CheckSongBlocklist(ctx context.Context, songInfo *music.Song) error {
+	blocklist, err := serverInstance.GetBlocklist(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range blocklist {
+		if blocklistEntryMatches(entry, songInfo.ID, songInfo.ChannelID, songInfo.Title) {
+			return &MusicPolicyViolation{Reason: blocklistReason(entry)}
+		}
+	}
+	return nil
+}
+
+// BlockSongs adds an entry to the guild's blocklist and removes the songs it matches from the queue, skipping the
+// current song if it matches too. It returns how many queued songs were removed.
+func (serverInstance *ServerInstance) BlockSongs(ctx context.Context, entryType BlocklistEntryType, value string,
+	label null.String, userID string,
+) (int, error) {
+	if entryType == BlocklistKeyword {
+		value = strings.ToLower(value)
+	}
+	entry := &models.MusicBlocklist{
+		GuildID:       serverInstance.GuildID,
+		EntryType:     string(entryType),
+		Value:         value,
+		Label:         label,
+		AddedByUserID: userID,
+	}
+	err := entry.Upsert(ctx, serverInstance.Db, true, []string{
+		models.MusicBlocklistColumns.GuildID, models.MusicBlocklistColumns.EntryType,
+		models.MusicBlocklistColumns.Value,
+	}, boil.Whitelist(models.MusicBlocklistColumns.Label), boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, songRequest := range queue {
+		if !songRequestBlocked(entry, songRequest) {
+			continue
+		}
+		errRemove := serverInstance.RemoveSongRequest(ctx, songRequest)
+		if errRemove != nil {
+			return removed, errRemove
+		}
+		removed++
+	}
+
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	current := serverInstance.MusicData.CurrentSongRequest
+	if serverInstance.MusicData.SongPlaying && current != nil && songRequestBlocked(entry, current) {
+		serverInstance.MusicData.CtxCancel()
+	}
+	return removed, nil
+}
+
+func songRequestBlocked(entry *models.MusicBlocklist, songRequest *models.SongRequest) bool {
+	if songRequest.R == nil || songRequest.R.Song == nil {
+		return blocklistEntryMatches(entry, songRequest.SongID.String, "", songRequest.SongName)
+	}
+	song := songRequest.R.Song
+	return blocklistEntryMatches(entry, song.ID, song.ChannelID.String, song.SongName)
+}
+
+// songRequestOnBlocklist reports whether the song request matches any entry on the guild's blocklist.
+func (serverInstance *ServerInstance) songRequestOnBlocklist(ctx context.Context, songRequest *models.SongRequest,
+) (bool, error) {
+	blocklist, err := serverInstance.GetBlocklist(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range blocklist {
+		if songRequestBlocked(entry, songRequest) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UnblockSongs removes an entry from the guild's blocklist.
+func (serverInstance *ServerInstance) UnblockSongs(ctx context.Context, entryType BlocklistEntryType, value string,
+) error {
+	if entryType == BlocklistKeyword {
+		value = strings.ToLower(value)
+	}
+	deleted, err := models.MusicBlocklists(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("entry_type = ?", string(entryType)),
+		qm.And("value = ?", value),
+	).DeleteAll(ctx, serverInstance.Db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrBlocklistEntryNotFound
+	}
+	return nil
+}
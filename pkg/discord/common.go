@@ -3,14 +3,11 @@ package discord
 import (
 	"context"
 	"database/sql"
-	"fmt"
 	"time"
 
-	"github.com/avast/retry-go"
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
 	"thalassa_discord/models"
 	"thalassa_discord/pkg/music"
@@ -32,17 +29,14 @@ func (serverInstance *ServerInstance) MuteUser(userID string) error {
 }
 
 func (serverInstance *ServerInstance) getNextSongInQueue() (*models.SongRequest, error) {
-	nextSongRequest, err := models.SongRequests(
-		qm.Where("guild_id = ?", serverInstance.GuildID),
-		qm.Where("played = false"),
-		qm.OrderBy("requested_at ASC"),
-		qm.OrderBy("id asc"),
-		qm.Load(models.SongRequestRels.Song),
-	).One(serverInstance.Ctx, serverInstance.Db)
+	queue, err := serverInstance.GetSongQueue(serverInstance.Ctx, 1)
 	if err != nil {
 		return nil, err
 	}
-	return nextSongRequest, nil
+	if len(queue) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return queue[0], nil
 }
 
 func (serverInstance *ServerInstance) SendSongQueueEvent(songRequestEvent music.SongQueueEvent) {
@@ -51,19 +45,34 @@ func (serverInstance *ServerInstance) SendSongQueueEvent(songRequestEvent music.
 	if serverInstance.SongQueueUpdateCallback != nil {
 		serverInstance.SongQueueUpdateCallback(serverInstance.GuildID, songRequestEvent)
 	}
+	switch songRequestEvent.Type {
+	case music.SongPaused, music.SongResumed, music.SongSeeked:
+		go serverInstance.RefreshNowPlaying()
+	}
 }
 
 func (serverInstance *ServerInstance) loopNextSongs(ctx context.Context, musicTextChannelID string) error {
+	// Whatever was prefetched won't play until the queue is started again.
+	defer serverInstance.DiscardPrefetch()
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
+			// Songs wait in the queue until the bot is brought into a voice channel, and until someone is listening
+			// if the last song finished with nobody there.
+			if !serverInstance.VoiceConnected() || serverInstance.waitingForListeners() {
+				return nil
+			}
 			nextSongRequest, err := serverInstance.getNextSongInQueue()
+			if errors.Is(err, sql.ErrNoRows) {
+				nextSongRequest, err = serverInstance.nextAutoplaySong(ctx)
+			}
 			if err != nil || nextSongRequest == nil {
 				if err != nil && !errors.Is(err, sql.ErrNoRows) {
 					serverInstance.Log.Error().Err(err).Msg("Unable to get next song in queue")
 				}
+				serverInstance.finishNowPlaying()
 				return nil
 			}
 			serverInstance.Log.Debug().Str("song", nextSongRequest.R.Song.SongName).Msg("Playing next song")
@@ -76,6 +85,15 @@ func (serverInstance *ServerInstance) loopNextSongs(ctx context.Context, musicTe
 	}
 }
 
+// triggerNextSong wakes up the song queue without blocking. When the channel is full the queue is already going to
+// look for the next song.
+func (serverInstance *ServerInstance) triggerNextSong() {
+	select {
+	case serverInstance.TriggerNextSong <- struct{}{}:
+	default:
+	}
+}
+
 func drainSongRequestTriggers(serverInstance *ServerInstance) {
 Drain:
 	for {
@@ -111,46 +129,6 @@ func (serverInstance *ServerInstance) handleSongQueue() error {
 }
 
 func (serverInstance *ServerInstance) handleSongRequest(musicChatChannelID string, songRequest *models.SongRequest) error {
-	embedmsg := NewEmbedInfer(serverInstance.Session.State.User, 53503).
-		AddField("Now Playing", fmt.Sprintf("[%s](%s)", songRequest.R.Song.SongName, songRequest.R.Song.URL), false).
-		SetImage(songRequest.R.Song.ThumbnailURL.String)
-
-	song := songRequest.R.Song
-	if song.Artist.Valid {
-		embedmsg.AddField("Artist", song.Artist.String, true)
-	}
-	if song.Album.Valid {
-		embedmsg.AddField("Album", song.Album.String, true)
-	}
-	if song.Track.Valid {
-		embedmsg.AddField("Track", song.Track.String, true)
-	}
-	if song.DurationInSeconds.Valid {
-		minutes := song.DurationInSeconds.Int / 60
-		seconds := song.DurationInSeconds.Int % 60
-		secondPlur := "second"
-		if seconds > 1 {
-			secondPlur = "seconds"
-		}
-		minutePlur := "minute"
-		if minutes > 1 {
-			minutePlur = "minutes"
-		}
-		dur := ""
-		if minutes > 0 {
-			if seconds > 0 {
-				dur = fmt.Sprintf("%d %s, %d %s", minutes, minutePlur, seconds, secondPlur)
-			} else {
-				dur = fmt.Sprintf("%d %s", minutes, minutePlur)
-			}
-		} else {
-			dur = fmt.Sprintf("%d %s", seconds, secondPlur)
-		}
-		embedmsg.AddField("Duration", dur, false)
-	}
-	embedmsg.AddField("Requested By", songRequest.UsernameAtTime, false)
-	serverInstance.SendEmbedMessage(embedmsg.MessageEmbed, musicChatChannelID, "Unable to send song playing message.")
-
 	serverInstance.Session.RLock()
 	voiceConnection, exists := serverInstance.Session.VoiceConnections[serverInstance.GuildID]
 	if !exists {
@@ -163,18 +141,49 @@ func (serverInstance *ServerInstance) handleSongRequest(musicChatChannelID strin
 	if voiceReady {
 		songRequest.PlayedAt = null.TimeFrom(time.Now().UTC())
 		ctx, ctxCancel := context.WithCancel(serverInstance.Ctx)
+		serverInstance.RLock()
+		volume := serverInstance.Configuration.MusicVolume
+		serverInstance.RUnlock()
+		filter := serverInstance.MusicFilter()
+		// Live streams play until they're skipped unless the guild limits how long they play. Running out of time
+		// finishes the stream rather than skipping it.
+		var streamCtx context.Context
+		var streamCtxCancel context.CancelFunc
+		var streamStops time.Time
+		maxStream := serverInstance.MusicPolicy().MaxStreamSeconds
+		if songRequest.R.Song.IsStream && maxStream.Valid {
+			streamStops = time.Now().UTC().Add(time.Duration(maxStream.Int) * time.Second)
+			streamCtx, streamCtxCancel = context.WithDeadline(ctx, streamStops)
+		} else {
+			streamCtx, streamCtxCancel = context.WithCancel(ctx)
+		}
+		listeners, errListeners := serverInstance.VoiceChannelListeners()
+		if errListeners != nil {
+			serverInstance.Log.Error().Err(errListeners).Msg("Unable to get voice channel listeners.")
+		}
 		serverInstance.MusicData.Lock()
 		duration := 0
 		if songRequest.R.Song.DurationInSeconds.Valid {
 			duration = songRequest.R.Song.DurationInSeconds.Int
 		}
 		serverInstance.MusicData.SongDurationSeconds = duration
-		serverInstance.MusicData.SongStarted = time.Now().UTC()
+		serverInstance.MusicData.IsStream = songRequest.R.Song.IsStream
+		serverInstance.MusicData.StreamStops = streamStops
+		startOffset := time.Duration(songRequest.StartOffsetSeconds) * time.Second
+		serverInstance.MusicData.SongStarted = time.Now().UTC().Add(-startOffset)
 		serverInstance.MusicData.SongPlaying = true
 		serverInstance.MusicData.Ctx = ctx
 		serverInstance.MusicData.CtxCancel = ctxCancel
 		serverInstance.MusicData.CurrentSongRequest = songRequest
 		serverInstance.MusicData.CurrentSong = songRequest.R.Song
+		serverInstance.MusicData.SkipVotes = make(map[string]struct{})
+		serverInstance.MusicData.SkippedAll = false
+		serverInstance.MusicData.Heard = len(listeners) > 0 || errListeners != nil
+		serverInstance.MusicData.Suspended = false
+		serverInstance.MusicData.RepeatingSongRequestID = 0
+		playback := music.NewPlayback(startOffset, volume,
+			music.GainMultiplier(songRequest.R.Song.LoudnessGainDB), filter)
+		serverInstance.MusicData.Playback = playback
 
 		// Send the song playing event to the song queue channel.
 		s := *songRequest.R.Song
@@ -185,15 +194,42 @@ func (serverInstance *ServerInstance) handleSongRequest(musicChatChannelID strin
 
 		serverInstance.MusicData.Unlock()
 
+		serverInstance.showNowPlaying(musicChatChannelID)
+		go serverInstance.checkListeners()
 		serverInstance.Log.Info().Msgf("Playing song: %s", songRequest.SongName)
-		music.StreamSong(ctx, songRequest.R.Song.URL, serverInstance.Log, voiceConnection, serverInstance.Configuration.MusicVolume)
+		source := music.SourceNamed(songRequest.R.Song.Platform.String)
+		prefetch, cachedPath, prefetched := serverInstance.takePrefetch(songRequest.ID)
+		if !prefetched {
+			cachedPath = serverInstance.AudioCache.Path(songRequest.R.Song.ID)
+		}
+		// Live streams don't end, so they aren't cached.
+		cacheSongID := ""
+		if !songRequest.R.Song.IsStream && duration > 0 {
+			cacheSongID = songRequest.R.Song.ID
+		}
+		prefetchCtx, prefetchCtxCancel := context.WithCancel(ctx)
+		go serverInstance.prefetchNextSongs(prefetchCtx)
+		go serverInstance.keepNowPlayingUpdated(prefetchCtx)
+		errStream := music.StreamSong(streamCtx, source, songRequest.R.Song.URL, cachedPath, serverInstance.AudioCache,
+			cacheSongID, prefetch, serverInstance.Log, voiceConnection, playback)
+		if errStream != nil {
+			serverInstance.Log.Error().Err(errStream).Str("song_id", songRequest.R.Song.ID).Msg("Unable to play song.")
+		} else {
+			// The audio cache saved the song while it played, so it's measured from there without another download.
+			serverInstance.MeasureSongLoudness(songRequest.R.Song)
+		}
+		streamCtxCancel()
+		prefetchCtxCancel()
 		serverInstance.MusicData.Lock()
 		serverInstance.MusicData.SongPlaying = false
+		skippedAll := serverInstance.MusicData.SkippedAll
+		heard := serverInstance.MusicData.Heard
+		suspended := serverInstance.MusicData.Suspended
 
 		// Send the song finished event to the song queue channel.
 		songQueueEvent := music.SongQueueEvent{Song: &s, SongRequest: &sr, Type: music.SongFinished}
-		if ctx.Err() != nil {
-			// If the context was cancelled, the song was skipped.
+		if ctx.Err() != nil || errStream != nil {
+			// If the context was cancelled, the song was skipped. Songs that can't be played are skipped too.
 			songQueueEvent.Type = music.SongSkipped
 		}
 		serverInstance.SendSongQueueEvent(songQueueEvent)
@@ -205,12 +241,41 @@ func (serverInstance *ServerInstance) handleSongRequest(musicChatChannelID strin
 		case <-serverInstance.Ctx.Done():
 			return nil
 		default:
+			if suspended {
+				return serverInstance.suspendSongRequest(songRequest, playback.Position())
+			}
+			// Only a song that really played is repeated, so one that can't be played doesn't repeat forever.
+			finished := ctx.Err() == nil && errStream == nil
+			loopMode := serverInstance.LoopMode()
+			if loopMode == music.LoopTrack && finished {
+				return serverInstance.repeatSongRequest(songRequest)
+			}
+			// Nobody heard the song, so it's kept at the front of the queue and plays from the start once someone
+			// joins. Until then the queue waits rather than playing to nobody.
+			if !heard && finished && serverInstance.MusicKeepsUnheardSongs() {
+				serverInstance.presence.waitForListeners()
+				return serverInstance.suspendSongRequest(songRequest, 0)
+			}
 			songRequest.Played = true
+			songRequest.Skipped = !finished
+			songRequest.PlayedSeconds = null.IntFrom(int((time.Since(songRequest.PlayedAt.Time) -
+				playback.PausedDuration()).Seconds()))
 			_, errUpdate := songRequest.Update(serverInstance.Ctx, serverInstance.Db, boil.Infer())
 			if errUpdate != nil && !errors.Is(errUpdate, context.Canceled) {
 				serverInstance.Log.Error().Err(errUpdate).Msg("Unable to update song")
 				return errUpdate
 			}
+			if loopMode == music.LoopQueue && !skippedAll && !songRequest.Autoplay && errStream == nil {
+				// A song that was blocked while it played is skipped and left out of the loop.
+				blocked, errBlocked := serverInstance.songRequestOnBlocklist(serverInstance.Ctx, songRequest)
+				if errBlocked != nil {
+					return errBlocked
+				}
+				if blocked {
+					return nil
+				}
+				return serverInstance.requeueSongRequest(songRequest)
+			}
 		}
 	} else {
 		serverInstance.Log.Error().Msg("Voice not ready.")
@@ -219,34 +284,11 @@ func (serverInstance *ServerInstance) handleSongRequest(musicChatChannelID strin
 	return nil
 }
 
+// ConnectToVoice connects the bot to the music voice channel.
 func (serverInstance *ServerInstance) ConnectToVoice() error {
-	retryOptions := []retry.Option{
-		retry.Context(serverInstance.Ctx),
-		retry.Attempts(10),
-		retry.Delay(1 * time.Second),
-		retry.MaxDelay(1 * time.Minute),
+	musicVoiceChannelID := serverInstance.musicVoiceChannelID()
+	if musicVoiceChannelID == "" {
+		return ErrNoMusicVoiceChannel
 	}
-	errRetry := retry.Do(func() error {
-		serverInstance.Session.RLock()
-		voiceConnection, exists := serverInstance.Session.VoiceConnections[serverInstance.GuildID]
-		guildID := serverInstance.GuildID
-		musicVoiceChannelID := serverInstance.Configuration.MusicVoiceChannelID.String
-		serverInstance.Session.RUnlock()
-		if !exists {
-			vc, errConnectVoice := serverInstance.Session.ChannelVoiceJoin(guildID,
-				musicVoiceChannelID, false, true)
-			if errConnectVoice != nil {
-				return errConnectVoice
-			}
-			voiceConnection = vc
-		}
-		serverInstance.Session.RLock()
-		voiceReady := voiceConnection.Ready
-		serverInstance.Session.RUnlock()
-		if !voiceReady {
-			return errors.New("voice not ready")
-		}
-		return nil
-	}, retryOptions...)
-	return errRetry
+	return serverInstance.JoinVoiceChannel(musicVoiceChannelID)
 }
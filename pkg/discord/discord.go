@@ -40,6 +40,18 @@ type BotConfiguration struct {
 	EnableAPI    bool
 	APIHost      string
 	APIPort      string
+	// AudioCacheDirectory is where songs are cached so they can be played again without downloading them. The
+	// cache is turned off if it's empty.
+	AudioCacheDirectory string
+	// AudioCacheMaxSizeMB is the most disk space the audio cache can use. Zero means no limit.
+	AudioCacheMaxSizeMB int64
+	// BotOwnerUserIDs are the Discord users who can manage settings shared by every server, like the audio cache.
+	BotOwnerUserIDs []string
+	// LocalMusicDirectories are folders of audio files that can be played with file: links, like
+	// file:artist/album/song.mp3, and searched with local: links. Local files can't be played if it's empty.
+	LocalMusicDirectories []string
+	// LocalMusicRescanMinutes is how often the local music folders are checked for changes. Defaults to 15.
+	LocalMusicRescanMinutes int
 }
 
 type handlers struct {
@@ -62,6 +74,8 @@ type ShardInstance struct {
 	GuildCreatedFunction         func(*discordgo.Session, *discordgo.GuildCreate)
 	SongQueueUpdateCallback      func(guildID string, event music.SongQueueEvent)
 	SongQueueUpdateCallbackMutex *sync.RWMutex
+	AudioCache                   *music.AudioCache
+	Library                      *music.Library
 	*sync.RWMutex
 }
 
@@ -76,6 +90,21 @@ type musicOpts struct {
 	SkipAllCtxCancel    context.CancelFunc
 	CurrentSongRequest  *models.SongRequest
 	CurrentSong         *models.Song
+	SkipVotes           map[string]struct{}
+	Playback            *music.Playback
+	SkippedAll          bool
+	// StreamStops is when the live stream that is playing is stopped by the guild's max stream time. It's zero when
+	// the stream plays until it's skipped.
+	StreamStops time.Time
+	// Heard is set when someone has been in the voice channel while the current song played.
+	Heard bool
+	// Suspended is set when the bot leaves voice while a song is playing, so the song is kept in the queue and
+	// resumes where it left off.
+	Suspended bool
+	// RepeatingSongRequestID is the song request being repeated by the track loop mode.
+	RepeatingSongRequestID int64
+	// Prefetched is the next song in the queue, downloading and encoding before the current song ends.
+	Prefetched *prefetchedSong
 	*sync.RWMutex
 }
 
@@ -103,6 +132,7 @@ type rolePermission struct {
 	playSongs             bool
 	playLists             bool
 	skipSongs             bool
+	ignoreMusicPolicy     bool
 }
 
 type setRolePermsAnswer struct {
@@ -139,6 +169,17 @@ type ServerInstance struct {
 	SongQueueUpdateCallback      func(guildID string, event music.SongQueueEvent)
 	SongQueueUpdateCallbackMutex *sync.RWMutex
 	TriggerNextSong              chan struct{}
+	songQueueMutex               *sync.Mutex
+	voiceMutex                   *sync.Mutex
+	musicPolicy                  *models.MusicPolicy
+	nowPlaying                   *nowPlayingMessage
+	searchPicks                  *searchPicks
+	playlistImports              *playlistImports
+	presence                     *voicePresence
+	djGrants                     *djGrants
+	AudioCache                   *music.AudioCache
+	Library                      *music.Library
+	BotOwnerUserIDs              []string
 	*sync.RWMutex
 }
 
@@ -176,7 +217,26 @@ func NewInstance(ctx context.Context) (*ShardInstance, error) {
 	}
 	botConfig := getBotConfiguration()
 	db := connectDB(botConfig)
+	var audioCache *music.AudioCache
+	if botConfig.AudioCacheDirectory != "" {
+		audioCache, err = music.NewAudioCache(botConfig.AudioCacheDirectory, botConfig.AudioCacheMaxSizeMB*1024*1024)
+		if err != nil {
+			return nil, err
+		}
+	}
 	shardCtx, shardCtxCancel := context.WithCancel(ctx)
+	var library *music.Library
+	if len(botConfig.LocalMusicDirectories) > 0 {
+		files := music.NewFileSource(botConfig.LocalMusicDirectories...)
+		library = music.NewLibrary(db, files)
+		music.RegisterSource(files, "file")
+		music.RegisterSource(library, "local")
+		rescanInterval := time.Duration(botConfig.LocalMusicRescanMinutes) * time.Minute
+		if rescanInterval <= 0 {
+			rescanInterval = 15 * time.Minute
+		}
+		go library.Watch(shardCtx, rescanInterval)
+	}
 	return &ShardInstance{
 		CloseSignal:                  make(chan os.Signal, 1),
 		directory:                    d,
@@ -185,6 +245,8 @@ func NewInstance(ctx context.Context) (*ShardInstance, error) {
 		BotConfig:                    botConfig,
 		Db:                           db,
 		SongQueueUpdateCallbackMutex: &sync.RWMutex{},
+		AudioCache:                   audioCache,
+		Library:                      library,
 		handlers: handlers{
 			guildCreate:    &guildCreate{},
 			guildMemberAdd: &guildMemberAdd{},
@@ -265,6 +327,8 @@ func (s *ShardInstance) Start() {
 	dg.AddHandler(s.guildCreate)
 	dg.AddHandler(s.guildMemberAdd)
 	dg.AddHandler(s.guildMemberUpdate)
+	dg.AddHandler(s.interactionCreate)
+	dg.AddHandler(s.voiceStateUpdate)
 
 	// Open the websocket and begin listening.
 	err = dg.Open()
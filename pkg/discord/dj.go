@@ -0,0 +1,180 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+)
+
+var ErrNoDJGrant = errors.New("user doesn't have a DJ grant")
+
+// DJGrant is a member who is a DJ until the grant expires.
+type DJGrant struct {
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// djGrants are the guild's DJ grants that haven't expired, by user ID.
+type djGrants struct {
+	expires map[string]time.Time
+	*sync.Mutex
+}
+
+func newDJGrants(grants models.MusicDJGrantSlice) *djGrants {
+	expires := make(map[string]time.Time, len(grants))
+	for _, grant := range grants {
+		expires[grant.UserID] = grant.ExpiresAt
+	}
+	return &djGrants{expires: expires, Mutex: &sync.Mutex{}}
+}
+
+// DJRoleID returns the role whose members are DJs, or an empty string if there isn't one.
+func (serverInstance *ServerInstance) DJRoleID() string {
+	return serverInstance.MusicPolicy().DJRoleID.String
+}
+
+// DJGrantExpires returns when the user's DJ grant expires, or false if they don't have one.
+func (serverInstance *ServerInstance) DJGrantExpires(userID string) (time.Time, bool) {
+	serverInstance.djGrants.Lock()
+	defer serverInstance.djGrants.Unlock()
+	expires, exists := serverInstance.djGrants.expires[userID]
+	if !exists {
+		return time.Time{}, false
+	}
+	if !time.Now().Before(expires) {
+		delete(serverInstance.djGrants.expires, userID)
+		return time.Time{}, false
+	}
+	return expires, true
+}
+
+// DJGrants returns the DJ grants that haven't expired, the soonest to expire first.
+func (serverInstance *ServerInstance) DJGrants() []DJGrant {
+	serverInstance.djGrants.Lock()
+	defer serverInstance.djGrants.Unlock()
+	now := time.Now()
+	grants := make([]DJGrant, 0, len(serverInstance.djGrants.expires))
+	for userID, expires := range serverInstance.djGrants.expires {
+		if !now.Before(expires) {
+			delete(serverInstance.djGrants.expires, userID)
+			continue
+		}
+		grants = append(grants, DJGrant{UserID: userID, ExpiresAt: expires})
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].ExpiresAt.Before(grants[j].ExpiresAt)
+	})
+	return grants
+}
+
+// GrantDJ makes the user a DJ for the duration, replacing any grant they already have, and returns when it expires.
+func (serverInstance *ServerInstance) GrantDJ(ctx context.Context, userID, grantedByUserID string,
+	duration time.Duration,
+) (time.Time, error) {
+	expires := time.Now().UTC().Add(duration)
+	grant := &models.MusicDJGrant{
+		GuildID:         serverInstance.GuildID,
+		UserID:          userID,
+		GrantedByUserID: grantedByUserID,
+		ExpiresAt:       expires,
+	}
+	err := grant.Upsert(ctx, serverInstance.Db, true,
+		[]string{models.MusicDJGrantColumns.GuildID, models.MusicDJGrantColumns.UserID},
+		boil.Whitelist(models.MusicDJGrantColumns.GrantedByUserID, models.MusicDJGrantColumns.ExpiresAt),
+		boil.Infer())
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Clear out grants that have expired while we're here.
+	_, err = models.MusicDJGrants(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("expires_at <= now()"),
+	).DeleteAll(ctx, serverInstance.Db)
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to delete expired DJ grants.")
+	}
+	serverInstance.djGrants.Lock()
+	serverInstance.djGrants.expires[userID] = expires
+	serverInstance.djGrants.Unlock()
+	return expires, nil
+}
+
+// RevokeDJ removes the user's DJ grant. It returns ErrNoDJGrant if they don't have one.
+func (serverInstance *ServerInstance) RevokeDJ(ctx context.Context, userID string) error {
+	if _, exists := serverInstance.DJGrantExpires(userID); !exists {
+		return ErrNoDJGrant
+	}
+	_, err := models.MusicDJGrants(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("user_id = ?", userID),
+	).DeleteAll(ctx, serverInstance.Db)
+	if err != nil {
+		return err
+	}
+	serverInstance.djGrants.Lock()
+	delete(serverInstance.djGrants.expires, userID)
+	serverInstance.djGrants.Unlock()
+	return nil
+}
+
+// memberIsDJ reports whether the member has the DJ role or a DJ grant that hasn't expired.
+func (serverInstance *ServerInstance) memberIsDJ(member *discordgo.Member) bool {
+	if djRoleID := serverInstance.DJRoleID(); djRoleID != "" {
+		for _, roleID := range member.Roles {
+			if roleID == djRoleID {
+				return true
+			}
+		}
+	}
+	_, granted := serverInstance.DJGrantExpires(member.User.ID)
+	return granted
+}
+
+// MemberIsDJ reports whether the guild member has full control over the music queue.
+func (serverInstance *ServerInstance) MemberIsDJ(userID string) bool {
+	userPerms, err := serverInstance.memberPermissions(userID)
+	if err != nil {
+		return false
+	}
+	if _, exists := userPerms[PermissionAdministrator]; exists {
+		return true
+	}
+	_, exists := userPerms[PermissionSkipSongs]
+	return exists
+}
+
+// CheckQueueAccess returns a *MusicPolicyViolation if only DJs can add songs right now and the user isn't one. That's
+// when the queue is locked, or when more people are listening than the guild allows before the queue is DJ only.
+func (serverInstance *ServerInstance) CheckQueueAccess(userID string) error {
+	policy := serverInstance.MusicPolicy()
+	if !policy.QueueLocked && !policy.DJOnlyListeners.Valid {
+		return nil
+	}
+	if serverInstance.MemberIsDJ(userID) {
+		return nil
+	}
+	if policy.QueueLocked {
+		return &MusicPolicyViolation{Reason: "The queue is locked, so only DJs can add songs.", QueueFull: true}
+	}
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err != nil {
+		return err
+	}
+	if len(listeners) > policy.DJOnlyListeners.Int {
+		return &MusicPolicyViolation{
+			Reason: fmt.Sprintf("Only DJs can add songs while more than %d people are listening.",
+				policy.DJOnlyListeners.Int),
+			QueueFull: true,
+		}
+	}
+	return nil
+}
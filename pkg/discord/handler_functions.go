@@ -57,10 +57,13 @@ func (*guildCreate) startMusicBot(serverInstance *ServerInstance, guildCreate *d
 	serverInstance.RUnlock()
 	if musicEnabled {
 		log.Debug().Msg("Starting music bot.")
-		errConnectVoice := serverInstance.ConnectToVoice()
-		if errConnectVoice != nil {
-			serverInstance.Log.Error().Err(errConnectVoice).Msg("Unable to connect to voice.")
-			return
+		// When the bot follows requesters it waits for the first request to join a voice channel.
+		if !serverInstance.MusicFollowsRequester() {
+			errConnectVoice := serverInstance.ConnectToVoice()
+			if errConnectVoice != nil {
+				serverInstance.Log.Error().Err(errConnectVoice).Msg("Unable to connect to voice.")
+				return
+			}
 		}
 		log.Debug().Msg("Starting song queue.")
 		go func() {
@@ -99,21 +102,22 @@ func (*guildCreate) loadOrCreateDiscordGuildFromDatabase(ctx context.Context, db
 
 		// No server configuration found create a new one.
 		newServer := &models.DiscordServer{
-			GuildID:                 guildCreate.ID,
-			GuildName:               guildCreate.Name,
-			LinkRemovalEnabled:      false,
-			MusicEnabled:            false,
-			CustomCommandsEnabled:   true,
-			DiceRollEnabled:         true,
-			PrefixCommand:           "~",
-			MusicTextChannelID:      null.String{},
-			MusicVoiceChannelID:     null.String{},
-			MusicVolume:             0.5,
-			AnnounceSongs:           true,
-			ThrottleCommandsEnabled: false,
-			ThrottleCommandsSeconds: null.Int64{},
-			WelcomeMessageEnabled:   false,
-			WelcomeMessage:          null.String{},
+			GuildID:                  guildCreate.ID,
+			GuildName:                guildCreate.Name,
+			LinkRemovalEnabled:       false,
+			MusicEnabled:             false,
+			CustomCommandsEnabled:    true,
+			DiceRollEnabled:          true,
+			PrefixCommand:            "~",
+			MusicTextChannelID:       null.String{},
+			MusicVoiceChannelID:      null.String{},
+			MusicVolume:              1,
+			AnnounceSongs:            true,
+			ThrottleCommandsEnabled:  false,
+			ThrottleCommandsSeconds:  null.Int64{},
+			WelcomeMessageEnabled:    false,
+			WelcomeMessage:           null.String{},
+			VoteSkipThresholdPercent: 50,
 		}
 		err := newServer.Insert(ctx, db, boil.Infer())
 		if err != nil {
@@ -156,6 +160,7 @@ func (*guildCreate) createEveryoneRolePermissionsIfNotExist(instance *ServerInst
 			PlaySongs:             true,
 			PlayLists:             true,
 			SkipSongs:             false,
+			IgnoreMusicPolicy:     false,
 		}
 
 		err := newPerms.Insert(context.TODO(), instance.Db, boil.Infer())
@@ -197,6 +202,22 @@ func (*guildCreate) createDiscordGuildInstance(ctx context.Context, db *sql.DB,
 		l.Error().Err(err).Msg("Unable to get role permissions for guild.")
 	}
 
+	musicPolicy, err := models.FindMusicPolicy(serverCtx, db, guildCreate.ID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			l.Error().Err(err).Msg("Unable to load music policy.")
+		}
+		musicPolicy = newMusicPolicy(guildCreate.ID)
+	}
+
+	grants, err := models.MusicDJGrants(
+		qm.Where("guild_id = ?", guildCreate.ID),
+		qm.And("expires_at > now()"),
+	).All(serverCtx, db)
+	if err != nil {
+		l.Error().Err(err).Msg("Unable to load DJ grants.")
+	}
+
 	rolePermissions := make(map[string]rolePermission)
 	for _, permission := range permissions {
 		r := rolePermission{
@@ -212,6 +233,7 @@ func (*guildCreate) createDiscordGuildInstance(ctx context.Context, db *sql.DB,
 			playSongs:             permission.PlaySongs,
 			playLists:             permission.PlayLists,
 			skipSongs:             permission.SkipSongs,
+			ignoreMusicPolicy:     permission.IgnoreMusicPolicy,
 		}
 		rolePermissions[permission.RoleID] = r
 	}
@@ -260,6 +282,7 @@ func (*guildCreate) createDiscordGuildInstance(ctx context.Context, db *sql.DB,
 			CtxCancel:           musicCtxCancel,
 			SkipAllCtx:          skipAllCtx,
 			SkipAllCtxCancel:    skipAllCtxCancel,
+			SkipVotes:           make(map[string]struct{}),
 			RWMutex:             &sync.RWMutex{},
 		},
 		CommandSetRolePerms: &setRolePerms{
@@ -276,6 +299,14 @@ func (*guildCreate) createDiscordGuildInstance(ctx context.Context, db *sql.DB,
 			Timeout: time.Second * 30,
 		},
 		TriggerNextSong: make(chan struct{}, 10),
+		songQueueMutex:  &sync.Mutex{},
+		voiceMutex:      &sync.Mutex{},
+		musicPolicy:     musicPolicy,
+		nowPlaying:      newNowPlayingMessage(),
+		searchPicks:     newSearchPicks(),
+		playlistImports: newPlaylistImports(),
+		presence:        newVoicePresence(),
+		djGrants:        newDJGrants(grants),
 		RWMutex:         &sync.RWMutex{},
 	}
 
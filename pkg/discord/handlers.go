@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -27,6 +28,9 @@ func (s *ShardInstance) guildCreate(dSession *discordgo.Session, guildCreate *di
 	}
 
 	serverInstance := s.handlers.guildCreate.createDiscordGuildInstance(s.Ctx, s.Db, serverInfo, dSession, guildCreate)
+	serverInstance.AudioCache = s.AudioCache
+	serverInstance.Library = s.Library
+	serverInstance.BotOwnerUserIDs = s.BotConfig.BotOwnerUserIDs
 
 	s.addServerInstance(guildCreate.ID, serverInstance)
 
@@ -70,6 +74,11 @@ func (s *ShardInstance) messageCreate(dSession *discordgo.Session, messageCreate
 
 	// s.handlers.messageCreate.checkForRolePermsSet(serverInstance, messageCreate)
 
+	// A number replying to a search picks a result rather than being a command.
+	if serverInstance != nil && serverInstance.searchPickReply(messageCreate.Message) {
+		return
+	}
+
 	commandFound, commandName, args := s.parseMessageForCommand(messageCreate.Message, serverInstance)
 	if commandFound {
 		// Custom commands can override built-in commands.
@@ -86,5 +95,67 @@ func (s *ShardInstance) messageCreate(dSession *discordgo.Session, messageCreate
 	}
 }
 
+// interactionCreate handles the buttons on the now playing message, search results and playlist imports.
+func (s *ShardInstance) interactionCreate(dSession *discordgo.Session, interactionCreate *discordgo.InteractionCreate) {
+	if interactionCreate.Type != discordgo.InteractionMessageComponent || interactionCreate.Member == nil {
+		return
+	}
+	s.RLock()
+	serverInstance, exists := s.ServerInstances[interactionCreate.GuildID]
+	s.RUnlock()
+	if !exists {
+		return
+	}
+	customID := interactionCreate.MessageComponentData().CustomID
+	switch {
+	case strings.HasPrefix(customID, NowPlayingButtonPrefix):
+		s.nowPlayingButton(dSession, interactionCreate, serverInstance, customID)
+	case strings.HasPrefix(customID, SearchPickPrefix):
+		serverInstance.searchPickInteraction(interactionCreate)
+	case strings.HasPrefix(customID, PlaylistImportPrefix):
+		serverInstance.playlistImportInteraction(interactionCreate)
+	}
+}
+
+// nowPlayingButton runs the music command the now playing button stands for, so the same permissions apply as when
+// typing the command.
+func (s *ShardInstance) nowPlayingButton(dSession *discordgo.Session, interactionCreate *discordgo.InteractionCreate,
+	serverInstance *ServerInstance, customID string,
+) {
+	err := dSession.InteractionRespond(interactionCreate.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to respond to button press.")
+	}
+	commandName, args, ok := serverInstance.NowPlayingButtonCommand(customID)
+	if !ok {
+		return
+	}
+	message := &discordgo.Message{
+		ChannelID: interactionCreate.ChannelID,
+		GuildID:   interactionCreate.GuildID,
+		Author:    interactionCreate.Member.User,
+		Member:    interactionCreate.Member,
+	}
+	s.handleCommand(commandName, args, message, serverInstance)
+	log.Info().Fields(map[string]interface{}{
+		"Username": interactionCreate.Member.User.Username,
+		"Command":  commandName,
+	}).Msg("Now playing button pressed.")
+	serverInstance.RefreshNowPlaying()
+}
+
+// voiceStateUpdate keeps track of who is listening to the music.
+func (s *ShardInstance) voiceStateUpdate(dSession *discordgo.Session, voiceStateUpdate *discordgo.VoiceStateUpdate) {
+	s.RLock()
+	serverInstance, exists := s.ServerInstances[voiceStateUpdate.GuildID]
+	s.RUnlock()
+	if !exists {
+		return
+	}
+	serverInstance.voiceStateChanged(voiceStateUpdate.VoiceState)
+}
+
 func (s *ShardInstance) guildMemberUpdate(dSession *discordgo.Session, guildMemberUpdate *discordgo.GuildMemberUpdate) {
 }
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+// LoopMode returns the guild's loop mode.
+func (serverInstance *ServerInstance) LoopMode() music.LoopMode {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	mode, err := music.ParseLoopMode(serverInstance.Configuration.MusicLoopMode)
+	if err != nil {
+		return music.LoopOff
+	}
+	return mode
+}
+
+// SetLoopMode saves the guild's loop mode.
+func (serverInstance *ServerInstance) SetLoopMode(ctx context.Context, mode music.LoopMode) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicLoopMode = string(mode)
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicLoopMode))
+	return err
+}
+
+// repeatSongRequest puts a song request that finished playing back at the front of the queue so it plays again
+// from the start.
+func (serverInstance *ServerInstance) repeatSongRequest(songRequest *models.SongRequest) error {
+	if songRequest.StartOffsetSeconds != 0 {
+		songRequest.StartOffsetSeconds = 0
+		_, err := songRequest.Update(serverInstance.Ctx, serverInstance.Db,
+			boil.Whitelist(models.SongRequestColumns.StartOffsetSeconds))
+		if err != nil {
+			return err
+		}
+	}
+	serverInstance.MusicData.Lock()
+	serverInstance.MusicData.RepeatingSongRequestID = songRequest.ID
+	serverInstance.MusicData.Unlock()
+	return serverInstance.MoveSongRequestToFront(serverInstance.Ctx, songRequest)
+}
+
+// requeueSongRequest adds a song request that finished playing back to the end of the queue, keeping the original
+// requester.
+func (serverInstance *ServerInstance) requeueSongRequest(songRequest *models.SongRequest) error {
+	newSongRequest := &models.SongRequest{
+		SongID:            songRequest.SongID,
+		SongName:          songRequest.SongName,
+		RequestedByUserID: songRequest.RequestedByUserID,
+		UsernameAtTime:    songRequest.UsernameAtTime,
+		GuildID:           songRequest.GuildID,
+		GuildNameAtTime:   songRequest.GuildNameAtTime,
+		RequestedAt:       time.Now().UTC(),
+		PlayedAt:          null.Time{},
+		Played:            false,
+	}
+	err := newSongRequest.Insert(serverInstance.Ctx, serverInstance.Db, boil.Infer())
+	if err != nil {
+		return err
+	}
+	sr := *newSongRequest
+	event := music.SongQueueEvent{SongRequest: &sr, Type: music.SongAdded}
+	if songRequest.R != nil && songRequest.R.Song != nil {
+		s := *songRequest.R.Song
+		event.Song = &s
+	}
+	serverInstance.SendSongQueueEvent(event)
+	return nil
+}
@@ -0,0 +1,57 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+// MeasureSongLoudness measures the song's loudness in the background and saves the gain that normalizes it. Songs
+// that play before it's saved play at their original loudness. Live streams and songs that were already measured are
+// skipped. With the audio cache on, songs are measured from the cache, so songs that aren't cached yet are measured
+// after they play.
+func (serverInstance *ServerInstance) MeasureSongLoudness(song *models.Song) {
+	if song == nil || song.IsStream {
+		return
+	}
+	songID := song.ID
+	link := song.URL
+	source := music.SourceNamed(song.Platform.String)
+	go func() {
+		// The song passed in may have been saved without loading its loudness, so check the database.
+		unmeasured, err := models.Songs(
+			qm.Where("id = ?", songID),
+			qm.And("loudness_gain_db is null"),
+		).Exists(serverInstance.Ctx, serverInstance.Db)
+		if err != nil || !unmeasured {
+			return
+		}
+		lufs, err := music.MeasureLoudness(serverInstance.Ctx, source, songID, link, serverInstance.AudioCache)
+		if errors.Is(err, music.ErrLoudnessMeasuring) || errors.Is(err, music.ErrLoudnessNotCached) ||
+			errors.Is(err, context.Canceled) {
+			return
+		}
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Str("song_id", songID).Msg("Unable to measure song loudness.")
+			return
+		}
+		measured := &models.Song{
+			ID:             songID,
+			LoudnessLufs:   null.Float32From(float32(lufs)),
+			LoudnessGainDB: null.Float32From(float32(music.LoudnessGain(lufs))),
+		}
+		_, err = measured.Update(serverInstance.Ctx, serverInstance.Db,
+			boil.Whitelist(models.SongColumns.LoudnessLufs, models.SongColumns.LoudnessGainDB))
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Str("song_id", songID).Msg("Unable to save song loudness.")
+			return
+		}
+		serverInstance.Log.Debug().Str("song_id", songID).Float64("lufs", lufs).Msg("Measured song loudness.")
+	}()
+}
@@ -0,0 +1,214 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+// MusicPolicyViolation is returned when a song or playlist breaks the guild's music policy. The message is meant to
+// be shown to the user.
+type MusicPolicyViolation struct {
+	Reason string
+	// QueueFull is set when the user has reached their queued song limit, so nothing else they request will be added.
+	QueueFull bool
+}
+
+func (v *MusicPolicyViolation) Error() string {
+	return v.Reason
+}
+
+func newMusicPolicy(guildID string) *models.MusicPolicy {
+	return &models.MusicPolicy{
+		GuildID:          guildID,
+		AllowLiveStreams: true,
+	}
+}
+
+// MusicPolicy returns a copy of the guild's music policy.
+func (serverInstance *ServerInstance) MusicPolicy() models.MusicPolicy {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	policy := *serverInstance.musicPolicy
+	policy.R = nil
+	return policy
+}
+
+// UpdateMusicPolicy applies the change to the guild's music policy and saves it.
+func (serverInstance *ServerInstance) UpdateMusicPolicy(ctx context.Context, change func(policy *models.MusicPolicy),
+) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	policy := *serverInstance.musicPolicy
+	change(&policy)
+	// Greylist allow_live_streams so turning live streams off isn't replaced by the column default on insert.
+	err := policy.Upsert(ctx, serverInstance.Db, true, []string{models.MusicPolicyColumns.GuildID}, boil.Infer(),
+		boil.Greylist(models.MusicPolicyColumns.AllowLiveStreams))
+	if err != nil {
+		return err
+	}
+	serverInstance.musicPolicy = &policy
+	return nil
+}
+
+// IgnoresMusicPolicy reports whether the author of the message can request anything.
+func (serverInstance *ServerInstance) IgnoresMusicPolicy(message *discordgo.Message) bool {
+	return serverInstance.memberIgnoresMusicPolicy(message.Author.ID)
+}
+
+func (serverInstance *ServerInstance) memberIgnoresMusicPolicy(userID string) bool {
+	userPerms, err := serverInstance.memberPermissions(userID)
+	if err != nil {
+		return false
+	}
+	if _, exists := userPerms[PermissionAdministrator]; exists {
+		return true
+	}
+	_, exists := userPerms[PermissionIgnoreMusicPolicy]
+	return exists
+}
+
+// CheckSongPolicy returns a *MusicPolicyViolation if the song can't be queued by the author of the message.
+func (serverInstance *ServerInstance) CheckSongPolicy(ctx context.Context, message *discordgo.Message,
+	songInfo *music.Song,
+) error {
+	return serverInstance.checkSongPolicy(ctx, message, songInfo, 0)
+}
+
+// checkSongPolicy is CheckSongPolicy for when the author already has pending songs that are allowed but not in the
+// queue yet.
+func (serverInstance *ServerInstance) checkSongPolicy(ctx context.Context, message *discordgo.Message,
+	songInfo *music.Song, pending int,
+) error {
+	if err := serverInstance.CheckQueueAccess(message.Author.ID); err != nil {
+		return err
+	}
+	if serverInstance.IgnoresMusicPolicy(message) {
+		return nil
+	}
+	policy := serverInstance.MusicPolicy()
+
+	if policy.MaxQueuedSongsPerUser.Valid {
+		queued, err := models.SongRequests(
+			qm.Where("guild_id = ?", serverInstance.GuildID),
+			qm.And("played = false"),
+			qm.And("requested_by_user_id = ?", message.Author.ID),
+		).Count(ctx, serverInstance.Db)
+		if err != nil {
+			return err
+		}
+		if queued+int64(pending) >= int64(policy.MaxQueuedSongsPerUser.Int) {
+			return &MusicPolicyViolation{
+				Reason: fmt.Sprintf("You already have %d songs in the queue, which is the most allowed.",
+					policy.MaxQueuedSongsPerUser.Int),
+				QueueFull: true,
+			}
+		}
+	}
+
+	return songPolicyViolation(policy, songInfo)
+}
+
+// songPolicyViolation returns a *MusicPolicyViolation if the policy doesn't allow the song itself, no matter who
+// requests it.
+func songPolicyViolation(policy models.MusicPolicy, songInfo *music.Song) error {
+	if !policy.AllowLiveStreams && songInfo.Live() {
+		return &MusicPolicyViolation{Reason: "Live streams aren't allowed."}
+	}
+
+	if policy.MaxSongDurationSeconds.Valid && songInfo.Duration > float64(policy.MaxSongDurationSeconds.Int) {
+		return &MusicPolicyViolation{Reason: fmt.Sprintf("Songs can't be longer than %s.",
+			FormatQueueWait(time.Duration(policy.MaxSongDurationSeconds.Int)*time.Second))}
+	}
+
+	if policy.MaxAgeLimit.Valid && songInfo.AgeLimit > policy.MaxAgeLimit.Int {
+		return &MusicPolicyViolation{Reason: "Age-restricted content isn't allowed."}
+	}
+
+	if policy.AllowedExtractors.Valid && !extractorAllowed(policy.AllowedExtractors.String, songInfo) {
+		return &MusicPolicyViolation{Reason: fmt.Sprintf("Songs can only be played from %s.",
+			policy.AllowedExtractors.String)}
+	}
+
+	if policy.AllowedDomains.Valid && !domainAllowed(policy.AllowedDomains.String, songInfo) {
+		return &MusicPolicyViolation{Reason: fmt.Sprintf("Songs can only be played from %s.",
+			policy.AllowedDomains.String)}
+	}
+	return nil
+}
+
+// CheckPlaylistPolicy returns a *MusicPolicyViolation if a playlist with that many songs can't be queued by the
+// author of the message.
+func (serverInstance *ServerInstance) CheckPlaylistPolicy(message *discordgo.Message, numberOfSongs int) error {
+	if err := serverInstance.CheckQueueAccess(message.Author.ID); err != nil {
+		return err
+	}
+	if serverInstance.IgnoresMusicPolicy(message) {
+		return nil
+	}
+	policy := serverInstance.MusicPolicy()
+	if policy.MaxPlaylistSize.Valid && numberOfSongs > policy.MaxPlaylistSize.Int {
+		return &MusicPolicyViolation{Reason: fmt.Sprintf("Playlists can't have more than %d songs. This one has %d.",
+			policy.MaxPlaylistSize.Int, numberOfSongs)}
+	}
+	return nil
+}
+
+// splitPolicyList splits a comma separated policy list into lowercase entries.
+func splitPolicyList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func extractorAllowed(allowed string, songInfo *music.Song) bool {
+	names := []string{songInfo.ExtractorKey, songInfo.Extractor}
+	// Playlist entries are reported with the playlist's extractor, like youtube:tab, so the extractor that plays the
+	// entry and the site the playlist's extractor belongs to are checked too.
+	if songInfo.IeKey != "" {
+		names = append(names, songInfo.IeKey)
+	}
+	if site, _, found := strings.Cut(songInfo.Extractor, ":"); found {
+		names = append(names, site)
+	}
+	for _, extractor := range splitPolicyList(allowed) {
+		for _, name := range names {
+			if strings.EqualFold(extractor, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func domainAllowed(allowed string, songInfo *music.Song) bool {
+	link := songInfo.WebpageURL
+	if link == "" {
+		link = songInfo.OriginalURL
+	}
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsedURL.Hostname())
+	for _, domain := range splitPolicyList(allowed) {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
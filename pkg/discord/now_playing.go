@@ -0,0 +1,292 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"thalassa_discord/pkg/music"
+)
+
+const (
+	// NowPlayingButtonPrefix starts the custom ID of every button on the now playing message.
+	NowPlayingButtonPrefix = "music:"
+	// nowPlayingUpdateInterval is how often the progress bar on the now playing message moves.
+	nowPlayingUpdateInterval = 15 * time.Second
+	// nowPlayingRepostAfter is how many messages can be sent after the now playing message before it's posted again
+	// at the bottom of the channel.
+	nowPlayingRepostAfter = 10
+	// progressBarLength is how many segments the progress bar has.
+	progressBarLength = 20
+)
+
+// nowPlayingMessage is the message in the music channel that shows the song that is playing. It's edited as songs
+// change rather than sending a message for every song.
+type nowPlayingMessage struct {
+	channelID string
+	messageID string
+	*sync.Mutex
+}
+
+func newNowPlayingMessage() *nowPlayingMessage {
+	return &nowPlayingMessage{Mutex: &sync.Mutex{}}
+}
+
+// progressBar draws how far into the song playback is.
+func progressBar(position, duration time.Duration) string {
+	if duration <= 0 {
+		return strings.Repeat("▬", progressBarLength)
+	}
+	marker := int(float64(progressBarLength) * float64(position) / float64(duration))
+	if marker >= progressBarLength {
+		marker = progressBarLength - 1
+	}
+	if marker < 0 {
+		marker = 0
+	}
+	return strings.Repeat("▬", marker) + "🔘" + strings.Repeat("▬", progressBarLength-marker-1)
+}
+
+// nextLoopMode returns the loop mode the loop button switches to.
+func nextLoopMode(mode music.LoopMode) music.LoopMode {
+	switch mode {
+	case music.LoopOff:
+		return music.LoopTrack
+	case music.LoopTrack:
+		return music.LoopQueue
+	default:
+		return music.LoopOff
+	}
+}
+
+// NowPlayingButtonCommand returns the command a now playing button runs, and its arguments.
+func (serverInstance *ServerInstance) NowPlayingButtonCommand(customID string) (string, []string, bool) {
+	switch strings.TrimPrefix(customID, NowPlayingButtonPrefix) {
+	case "pause":
+		if serverInstance.SongPaused() {
+			return "resume", nil, true
+		}
+		return "pause", nil, true
+	case "skip":
+		return "skip", nil, true
+	case "voteskip":
+		return "voteskip", nil, true
+	case "loop":
+		return "loop", []string{string(nextLoopMode(serverInstance.LoopMode()))}, true
+	case "shuffle":
+		return "shuffle", nil, true
+	}
+	return "", nil, false
+}
+
+func (serverInstance *ServerInstance) announceSongs() bool {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.AnnounceSongs
+}
+
+// nowPlayingContent builds the now playing message for the song that is playing. It returns false if nothing is playing.
+func (serverInstance *ServerInstance) nowPlayingContent() (*discordgo.MessageEmbed, []discordgo.MessageComponent, bool) {
+	serverInstance.MusicData.RLock()
+	playing := serverInstance.MusicData.SongPlaying
+	songRequest := serverInstance.MusicData.CurrentSongRequest
+	song := serverInstance.MusicData.CurrentSong
+	serverInstance.MusicData.RUnlock()
+	if !playing || songRequest == nil || song == nil {
+		return nil, nil, false
+	}
+	paused := serverInstance.SongPaused()
+	loopMode := serverInstance.LoopMode()
+
+	title := "Now Playing"
+	if songRequest.Autoplay {
+		title = "Now Playing (autoplay)"
+	}
+	if paused {
+		title = "Paused"
+	}
+	embed := NewEmbedInfer(serverInstance.Session.State.User, 53503).
+		AddField(title, fmt.Sprintf("[%s](%s)", song.SongName, song.URL), false).
+		SetImage(song.ThumbnailURL.String)
+	if song.Artist.Valid {
+		embed.AddField("Artist", song.Artist.String, true)
+	}
+	if song.Album.Valid {
+		embed.AddField("Album", song.Album.String, true)
+	}
+	if song.Track.Valid {
+		embed.AddField("Track", song.Track.String, true)
+	}
+	position := serverInstance.SongPosition()
+	duration := time.Duration(song.DurationInSeconds.Int) * time.Second
+	switch {
+	case song.IsStream:
+		progress := fmt.Sprintf("🔴 LIVE `%s`", FormatQueueWait(position))
+		if left, known := serverInstance.CurrentSongTimeLeft(); known {
+			progress += fmt.Sprintf(", stops in %s", FormatQueueWait(left))
+		}
+		embed.AddField("Progress", progress, false)
+	case duration <= 0:
+		embed.AddField("Progress", fmt.Sprintf("`%s`", FormatQueueWait(position)), false)
+	default:
+		embed.AddField("Progress", fmt.Sprintf("`%s` %s `%s`", FormatQueueWait(position),
+			progressBar(position, duration), FormatQueueWait(duration)), false)
+	}
+	embed.AddField("Requested By", songRequest.UsernameAtTime, true)
+	if loopMode != music.LoopOff {
+		embed.AddField("Loop", loopMode.FriendlyName(), true)
+	}
+	if filter := serverInstance.SongFilter(); filter != music.FilterOff {
+		embed.AddField("Filter", filter.FriendlyName(), true)
+	}
+	if songRequest.Autoplay {
+		embed.AddField("Autoplay", "Picked from songs this server has requested. Request a song to take over.", false)
+	}
+
+	pauseLabel := "Pause"
+	if paused {
+		pauseLabel = "Resume"
+	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{Label: pauseLabel, Style: discordgo.PrimaryButton, CustomID: NowPlayingButtonPrefix + "pause"},
+			discordgo.Button{Label: "Skip", Style: discordgo.SecondaryButton, CustomID: NowPlayingButtonPrefix + "skip"},
+			discordgo.Button{Label: "Vote Skip", Style: discordgo.SecondaryButton,
+				CustomID: NowPlayingButtonPrefix + "voteskip"},
+			discordgo.Button{Label: fmt.Sprintf("Loop: %s", loopMode.FriendlyName()), Style: discordgo.SecondaryButton,
+				CustomID: NowPlayingButtonPrefix + "loop"},
+			discordgo.Button{Label: "Shuffle", Style: discordgo.SecondaryButton,
+				CustomID: NowPlayingButtonPrefix + "shuffle"},
+		}},
+	}
+	return embed.MessageEmbed, components, true
+}
+
+// nowPlayingScrolledAway reports whether enough messages were sent after the now playing message that it's hard to
+// find.
+func (serverInstance *ServerInstance) nowPlayingScrolledAway() bool {
+	messages, err := serverInstance.Session.ChannelMessages(serverInstance.nowPlaying.channelID,
+		nowPlayingRepostAfter, "", serverInstance.nowPlaying.messageID, "")
+	if err != nil {
+		return true
+	}
+	return len(messages) >= nowPlayingRepostAfter
+}
+
+// showNowPlaying updates the now playing message for a new song. The message is posted again if it's not near the
+// bottom of the music channel. Nothing is shown if the guild doesn't announce songs.
+func (serverInstance *ServerInstance) showNowPlaying(musicChatChannelID string) {
+	if !serverInstance.announceSongs() {
+		return
+	}
+	serverInstance.nowPlaying.Lock()
+	defer serverInstance.nowPlaying.Unlock()
+	if serverInstance.nowPlaying.messageID != "" && (serverInstance.nowPlaying.channelID != musicChatChannelID ||
+		serverInstance.nowPlayingScrolledAway()) {
+		serverInstance.deleteNowPlaying()
+	}
+	serverInstance.updateNowPlaying(musicChatChannelID)
+}
+
+// RefreshNowPlaying redraws the now playing message, like after the song is paused or the loop mode changes.
+func (serverInstance *ServerInstance) RefreshNowPlaying() {
+	serverInstance.nowPlaying.Lock()
+	defer serverInstance.nowPlaying.Unlock()
+	if serverInstance.nowPlaying.messageID == "" {
+		return
+	}
+	serverInstance.updateNowPlaying(serverInstance.nowPlaying.channelID)
+}
+
+// updateNowPlaying edits the now playing message, or sends it if there isn't one. The caller must hold the now
+// playing lock.
+func (serverInstance *ServerInstance) updateNowPlaying(musicChatChannelID string) {
+	embed, components, playing := serverInstance.nowPlayingContent()
+	if !playing {
+		return
+	}
+	if serverInstance.nowPlaying.messageID != "" {
+		_, err := serverInstance.Session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:         serverInstance.nowPlaying.messageID,
+			Channel:    serverInstance.nowPlaying.channelID,
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		})
+		if err == nil {
+			return
+		}
+		// The message was probably deleted, so send a new one.
+		serverInstance.Log.Debug().Err(err).Msg("Unable to edit now playing message.")
+	}
+	message, err := serverInstance.Session.ChannelMessageSendComplex(musicChatChannelID, &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	})
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to send song playing message.")
+		serverInstance.nowPlaying.messageID = ""
+		return
+	}
+	serverInstance.nowPlaying.channelID = musicChatChannelID
+	serverInstance.nowPlaying.messageID = message.ID
+}
+
+// deleteNowPlaying removes the now playing message. The caller must hold the now playing lock.
+func (serverInstance *ServerInstance) deleteNowPlaying() {
+	err := serverInstance.Session.ChannelMessageDelete(serverInstance.nowPlaying.channelID,
+		serverInstance.nowPlaying.messageID)
+	if err != nil {
+		serverInstance.Log.Debug().Err(err).Msg("Unable to delete now playing message.")
+	}
+	serverInstance.nowPlaying.messageID = ""
+}
+
+// finishNowPlaying changes the now playing message to say the queue is finished and removes its buttons. The next
+// song gets a new message.
+func (serverInstance *ServerInstance) finishNowPlaying() {
+	serverInstance.nowPlaying.Lock()
+	defer serverInstance.nowPlaying.Unlock()
+	if serverInstance.nowPlaying.messageID == "" {
+		return
+	}
+	embed := NewEmbedInfer(serverInstance.Session.State.User, 53503).
+		AddField("Nothing playing", "The queue is finished. Request a song with !play.", false).
+		MessageEmbed
+	_, err := serverInstance.Session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         serverInstance.nowPlaying.messageID,
+		Channel:    serverInstance.nowPlaying.channelID,
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: []discordgo.MessageComponent{},
+	})
+	if err != nil {
+		serverInstance.Log.Debug().Err(err).Msg("Unable to edit now playing message.")
+	}
+	serverInstance.nowPlaying.messageID = ""
+}
+
+// keepNowPlayingUpdated moves the progress bar on the now playing message until ctx is cancelled, and posts the
+// message again if it scrolls too far up the channel.
+func (serverInstance *ServerInstance) keepNowPlayingUpdated(ctx context.Context) {
+	ticker := time.NewTicker(nowPlayingUpdateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			serverInstance.nowPlaying.Lock()
+			if serverInstance.nowPlaying.messageID != "" {
+				channelID := serverInstance.nowPlaying.channelID
+				if serverInstance.nowPlayingScrolledAway() {
+					serverInstance.deleteNowPlaying()
+				}
+				serverInstance.updateNowPlaying(channelID)
+			}
+			serverInstance.nowPlaying.Unlock()
+		}
+	}
+}
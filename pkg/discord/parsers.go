@@ -23,6 +23,7 @@ const (
 	PermissionSkipSongs
 	PermissionAdministrator
 	PermissionOwner
+	PermissionIgnoreMusicPolicy
 )
 
 var AllPermissions = []Permission{
@@ -39,6 +40,7 @@ var AllPermissions = []Permission{
 	PermissionSkipSongs,
 	PermissionAdministrator,
 	PermissionOwner,
+	PermissionIgnoreMusicPolicy,
 }
 
 func (p Permission) FriendlyName() string {
@@ -65,6 +67,8 @@ func (p Permission) FriendlyName() string {
 		return "Play Lists"
 	case PermissionSkipSongs:
 		return "Skip Songs"
+	case PermissionIgnoreMusicPolicy:
+		return "Ignore Music Policy"
 	case PermissionAdministrator:
 		return "Administrator"
 	case PermissionOwner:
@@ -105,7 +109,12 @@ func GetDiscordUserIDFromString(mention string) (string, bool) {
 }
 
 func (serverInstance *ServerInstance) getUserPermissions(message *discordgo.Message) (map[Permission]struct{}, error) {
-	commandMember, err := serverInstance.GetGuildMember(message.Author.ID)
+	return serverInstance.memberPermissions(message.Author.ID)
+}
+
+// memberPermissions returns the permissions of the guild member from their roles. DJs get every music permission.
+func (serverInstance *ServerInstance) memberPermissions(userID string) (map[Permission]struct{}, error) {
+	commandMember, err := serverInstance.GetGuildMember(userID)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +135,7 @@ func (serverInstance *ServerInstance) getUserPermissions(message *discordgo.Mess
 
 		guild, err := serverInstance.GetGuild()
 		if err == nil {
-			if guild.OwnerID == message.Author.ID {
+			if guild.OwnerID == userID {
 				// User is the owner of the server.
 				perms[PermissionAdministrator] = struct{}{}
 				perms[PermissionOwner] = struct{}{}
@@ -246,8 +255,16 @@ func (serverInstance *ServerInstance) getUserPermissions(message *discordgo.Mess
 				if role.skipSongs {
 					perms[PermissionSkipSongs] = struct{}{}
 				}
+				if role.ignoreMusicPolicy {
+					perms[PermissionIgnoreMusicPolicy] = struct{}{}
+				}
 			}
 		}
 	}
+	if serverInstance.memberIsDJ(commandMember) {
+		perms[PermissionPlaySongs] = struct{}{}
+		perms[PermissionPlayLists] = struct{}{}
+		perms[PermissionSkipSongs] = struct{}{}
+	}
 	return perms, nil
 }
@@ -0,0 +1,196 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+var (
+	ErrSongAlreadyPaused = errors.New("song is already paused")
+	ErrSongNotPaused     = errors.New("song is not paused")
+	ErrSeekPastEnd       = errors.New("position is past the end of the song")
+	ErrSeekStream        = errors.New("live streams can't be seeked")
+	ErrVolumeOutOfRange  = errors.New("volume out of range")
+)
+
+// MaxMusicVolume is the loudest the music volume can be set, as a multiplier of the original volume.
+const MaxMusicVolume = 2
+
+// SetSongPaused pauses or resumes the song that is playing and sends a SongPaused or SongResumed event.
+func (serverInstance *ServerInstance) SetSongPaused(paused bool) error {
+	serverInstance.MusicData.RLock()
+	playing := serverInstance.MusicData.SongPlaying
+	playback := serverInstance.MusicData.Playback
+	songRequest := serverInstance.MusicData.CurrentSongRequest
+	song := serverInstance.MusicData.CurrentSong
+	serverInstance.MusicData.RUnlock()
+	if !playing || playback == nil {
+		return ErrNoSongPlaying
+	}
+	if !playback.SetPaused(paused) {
+		if paused {
+			return ErrSongAlreadyPaused
+		}
+		return ErrSongNotPaused
+	}
+	eventType := music.SongResumed
+	if paused {
+		eventType = music.SongPaused
+	}
+	serverInstance.sendCurrentSongEvent(songRequest, song, eventType)
+	return nil
+}
+
+func (serverInstance *ServerInstance) sendCurrentSongEvent(songRequest *models.SongRequest, song *models.Song,
+	eventType music.SongQueueEventType,
+) {
+	event := music.SongQueueEvent{Type: eventType}
+	if songRequest != nil {
+		sr := *songRequest
+		event.SongRequest = &sr
+	}
+	if song != nil {
+		s := *song
+		event.Song = &s
+	}
+	serverInstance.SendSongQueueEvent(event)
+}
+
+// SongPosition returns how far into the song that is playing playback is.
+func (serverInstance *ServerInstance) SongPosition() time.Duration {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.Playback == nil {
+		return 0
+	}
+	return serverInstance.MusicData.Playback.Position()
+}
+
+// SeekSong restarts the song that is playing at the position and sends a SongSeeked event.
+func (serverInstance *ServerInstance) SeekSong(position time.Duration) error {
+	serverInstance.MusicData.Lock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.Playback == nil {
+		serverInstance.MusicData.Unlock()
+		return ErrNoSongPlaying
+	}
+	if serverInstance.MusicData.IsStream || serverInstance.MusicData.CurrentSong.IsStream {
+		serverInstance.MusicData.Unlock()
+		return ErrSeekStream
+	}
+	if position < 0 {
+		position = 0
+	}
+	duration := time.Duration(serverInstance.MusicData.SongDurationSeconds) * time.Second
+	if duration > 0 && position >= duration {
+		serverInstance.MusicData.Unlock()
+		return ErrSeekPastEnd
+	}
+	err := serverInstance.MusicData.Playback.Seek(position)
+	if err != nil {
+		serverInstance.MusicData.Unlock()
+		return err
+	}
+	serverInstance.MusicData.SongStarted = time.Now().UTC().Add(-position)
+	songRequest := serverInstance.MusicData.CurrentSongRequest
+	song := serverInstance.MusicData.CurrentSong
+	serverInstance.MusicData.Unlock()
+	serverInstance.sendCurrentSongEvent(songRequest, song, music.SongSeeked)
+	return nil
+}
+
+// SongPaused reports whether the song that is playing is paused.
+func (serverInstance *ServerInstance) SongPaused() bool {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	return serverInstance.MusicData.SongPlaying && serverInstance.MusicData.Playback != nil &&
+		serverInstance.MusicData.Playback.Paused()
+}
+
+// SongPausedDuration returns the total time the song that is playing has spent paused.
+func (serverInstance *ServerInstance) SongPausedDuration() time.Duration {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if serverInstance.MusicData.Playback == nil {
+		return 0
+	}
+	return serverInstance.MusicData.Playback.PausedDuration()
+}
+
+// SetMusicVolume saves the guild's music volume, a multiplier where 1 is the original volume, and applies it to the
+// song that is playing. Like filters, the volume of a live stream can't change until the next song because the stream
+// can't be restarted where it was, so it reports false when a live stream is playing.
+func (serverInstance *ServerInstance) SetMusicVolume(ctx context.Context, volume float32) (bool, error) {
+	if volume < 0 || volume > MaxMusicVolume {
+		return false, ErrVolumeOutOfRange
+	}
+	serverInstance.Lock()
+	serverInstance.Configuration.MusicVolume = volume
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicVolume))
+	serverInstance.Unlock()
+	if err != nil {
+		return false, err
+	}
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.Playback == nil {
+		return true, nil
+	}
+	if serverInstance.MusicData.IsStream {
+		return false, nil
+	}
+	serverInstance.MusicData.Playback.SetVolume(volume)
+	return true, nil
+}
+
+// MusicFilter returns the audio filter the guild plays songs with. Filters that aren't valid anymore are ignored.
+func (serverInstance *ServerInstance) MusicFilter() music.AudioFilter {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	filter := music.AudioFilter(serverInstance.Configuration.MusicFilter)
+	if !filter.Valid() {
+		return music.FilterOff
+	}
+	return filter
+}
+
+// SetMusicFilter saves the guild's audio filter. Songs after the current one play with it, and when now is set the song
+// that is playing is restarted with it at the current position. Live streams always wait for the next song because
+// they can't be restarted where they were.
+func (serverInstance *ServerInstance) SetMusicFilter(ctx context.Context, filter music.AudioFilter, now bool) error {
+	serverInstance.Lock()
+	serverInstance.Configuration.MusicFilter = string(filter)
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicFilter))
+	serverInstance.Unlock()
+	if err != nil {
+		return err
+	}
+	if !now {
+		return nil
+	}
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if serverInstance.MusicData.SongPlaying && serverInstance.MusicData.Playback != nil &&
+		!serverInstance.MusicData.IsStream {
+		serverInstance.MusicData.Playback.SetFilter(filter)
+	}
+	return nil
+}
+
+// SongFilter returns the audio filter the song that is playing has, which can differ from the guild's filter until
+// the next song.
+func (serverInstance *ServerInstance) SongFilter() music.AudioFilter {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.Playback == nil {
+		return music.FilterOff
+	}
+	return serverInstance.MusicData.Playback.Filter()
+}
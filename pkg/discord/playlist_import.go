@@ -0,0 +1,484 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+const (
+	// PlaylistImportPrefix starts the custom ID of the buttons on a playlist import message.
+	PlaylistImportPrefix = "import:"
+	// PlaylistImportCancelButtonID is the custom ID of the button that cancels a playlist import.
+	PlaylistImportCancelButtonID = PlaylistImportPrefix + "cancel"
+	// playlistImportBatchSize is the most songs added to the queue in one statement.
+	playlistImportBatchSize = 50
+	// playlistImportInterval is how long found songs wait before they're queued, and how often the progress message
+	// is edited, which keeps it under Discord's rate limit.
+	playlistImportInterval = 2 * time.Second
+)
+
+// playlistImport is a playlist being added to the queue.
+type playlistImport struct {
+	userID    string
+	channelID string
+	messageID string
+	cancel    context.CancelFunc
+	// cancelledBy is the user who cancelled the import, if someone did.
+	cancelledBy string
+}
+
+// playlistImports are the playlists being added to the queue, by the ID of their progress message.
+type playlistImports struct {
+	byMessage map[string]*playlistImport
+	*sync.Mutex
+}
+
+func newPlaylistImports() *playlistImports {
+	return &playlistImports{byMessage: make(map[string]*playlistImport), Mutex: &sync.Mutex{}}
+}
+
+// playlistImportProgress counts what happened to the entries found in a playlist so far.
+type playlistImportProgress struct {
+	// total is how many entries the playlist says it has, or 0 if it doesn't say.
+	total       int
+	found       int
+	queued      int
+	unavailable int
+	rejected    int
+	// reason is why the import stopped early, or why the last song wasn't allowed.
+	reason string
+}
+
+func (progress *playlistImportProgress) String() string {
+	text := fmt.Sprintf("%d queued", progress.queued)
+	if progress.total > 0 {
+		text = fmt.Sprintf("%d/%d queued", progress.queued, progress.total)
+	}
+	if progress.unavailable > 0 {
+		text += fmt.Sprintf(", %d unavailable", progress.unavailable)
+	}
+	if progress.rejected > 0 {
+		text += fmt.Sprintf(", %d not allowed", progress.rejected)
+	}
+	return text
+}
+
+// QueueSongRequests adds the songs to the end of the queue for the requester in a single transaction, and returns
+// the new song requests in the same order.
+func (serverInstance *ServerInstance) QueueSongRequests(ctx context.Context, requester *discordgo.User,
+	songInfos []*music.Song,
+) (models.SongRequestSlice, error) {
+	if len(songInfos) == 0 {
+		return nil, nil
+	}
+	songs := make(models.SongSlice, 0, len(songInfos))
+	songRequests := make(models.SongRequestSlice, 0, len(songInfos))
+	for _, songInfo := range songInfos {
+		if songInfo.Thumbnail == "" && len(songInfo.Thumbnails) > 0 {
+			songInfo.Thumbnail = songInfo.Thumbnails[len(songInfo.Thumbnails)-1].URL
+		}
+		songs = append(songs, music.SongModel(songInfo))
+		songRequests = append(songRequests, &models.SongRequest{
+			SongID:             null.StringFrom(songInfo.ID),
+			SongName:           songInfo.Title,
+			RequestedByUserID:  requester.ID,
+			UsernameAtTime:     requester.Username,
+			GuildID:            serverInstance.GuildID,
+			GuildNameAtTime:    serverInstance.GuildID,
+			RequestedAt:        time.Now().UTC(),
+			StartOffsetSeconds: int(songInfo.StartOffset().Seconds()),
+		})
+	}
+	tx, err := serverInstance.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = insertSongs(ctx, tx, songs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	err = insertSongRequests(ctx, tx, songRequests)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	for index, songRequest := range songRequests {
+		serverInstance.MeasureSongLoudness(songs[index])
+		s := *songs[index]
+		sr := *songRequest
+		serverInstance.SendSongQueueEvent(music.SongQueueEvent{Song: &s, SongRequest: &sr, Type: music.SongAdded})
+	}
+	// Songs someone asked for take over from autoplay.
+	serverInstance.StopAutoplay()
+	return songRequests, nil
+}
+
+// valuesPlaceholders returns the placeholders for one row of a multi-row insert, starting at $first.
+func valuesPlaceholders(first, count int) string {
+	placeholders := make([]string, 0, count)
+	for index := 0; index < count; index++ {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", first+index))
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")"
+}
+
+// insertSongs saves the songs with a single statement. Songs that were already saved are updated like saveSong does.
+func insertSongs(ctx context.Context, exec boil.ContextExecutor, songs models.SongSlice) error {
+	columns := []string{
+		models.SongColumns.ID,
+		models.SongColumns.Platform,
+		models.SongColumns.SongName,
+		models.SongColumns.Description,
+		models.SongColumns.URL,
+		models.SongColumns.DurationInSeconds,
+		models.SongColumns.IsStream,
+		models.SongColumns.ThumbnailURL,
+		models.SongColumns.Artist,
+		models.SongColumns.Album,
+		models.SongColumns.Track,
+		models.SongColumns.ChannelID,
+		models.SongColumns.Uploader,
+	}
+	var query strings.Builder
+	query.WriteString("insert into song (" + strings.Join(columns, ", ") + ") values ")
+	args := make([]interface{}, 0, len(songs)*len(columns))
+	// A row can only be updated once per statement, so songs that are in the batch more than once are saved once.
+	saved := make(map[string]struct{}, len(songs))
+	for _, song := range songs {
+		if _, exists := saved[song.ID]; exists {
+			continue
+		}
+		saved[song.ID] = struct{}{}
+		if len(args) > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString(valuesPlaceholders(len(args)+1, len(columns)))
+		args = append(args, song.ID, song.Platform, song.SongName, song.Description, song.URL, song.DurationInSeconds,
+			song.IsStream, song.ThumbnailURL, song.Artist, song.Album, song.Track, song.ChannelID, song.Uploader)
+	}
+	updates := make([]string, 0, len(columns)-1)
+	for _, column := range columns[1:] {
+		updates = append(updates, column+" = excluded."+column)
+	}
+	query.WriteString(" on conflict (" + models.SongColumns.ID + ") do update set " + strings.Join(updates, ", "))
+	_, err := exec.ExecContext(ctx, query.String(), args...)
+	return err
+}
+
+// insertSongRequests adds the song requests with a single statement and sets their IDs and queue positions, which
+// follow the order of the slice.
+func insertSongRequests(ctx context.Context, exec boil.ContextExecutor, songRequests models.SongRequestSlice) error {
+	columns := []string{
+		models.SongRequestColumns.SongID,
+		models.SongRequestColumns.SongName,
+		models.SongRequestColumns.RequestedByUserID,
+		models.SongRequestColumns.UsernameAtTime,
+		models.SongRequestColumns.GuildID,
+		models.SongRequestColumns.GuildNameAtTime,
+		models.SongRequestColumns.RequestedAt,
+		models.SongRequestColumns.StartOffsetSeconds,
+	}
+	var query strings.Builder
+	query.WriteString("insert into song_request (" + strings.Join(columns, ", ") + ") values ")
+	args := make([]interface{}, 0, len(songRequests)*len(columns))
+	for index, songRequest := range songRequests {
+		if index > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString(valuesPlaceholders(len(args)+1, len(columns)))
+		args = append(args, songRequest.SongID, songRequest.SongName, songRequest.RequestedByUserID,
+			songRequest.UsernameAtTime, songRequest.GuildID, songRequest.GuildNameAtTime, songRequest.RequestedAt,
+			songRequest.StartOffsetSeconds)
+	}
+	query.WriteString(" returning " + models.SongRequestColumns.ID + ", " + models.SongRequestColumns.Position)
+	rows, err := exec.QueryContext(ctx, query.String(), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for _, songRequest := range songRequests {
+		if !rows.Next() {
+			if err = rows.Err(); err != nil {
+				return err
+			}
+			return sql.ErrNoRows
+		}
+		err = rows.Scan(&songRequest.ID, &songRequest.Position)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ImportPlaylist adds the songs that list finds to the queue for the author of the message. Songs are checked
+// against the music policy and queued in batches while list is still finding them, so the first ones can play
+// straight away. A single progress message in the channel is kept up to date until the import is done, it's
+// cancelled or the queue is skipped. list must stop when its context is cancelled, and pass nil to found for
+// entries that can't be played.
+func (serverInstance *ServerInstance) ImportPlaylist(message *discordgo.Message, channelID, title string,
+	list func(ctx context.Context, found func(*music.Song)) error,
+) {
+	serverInstance.MusicData.RLock()
+	skipAllCtx := serverInstance.MusicData.SkipAllCtx
+	serverInstance.MusicData.RUnlock()
+	ctx, cancel := context.WithCancel(skipAllCtx)
+	defer cancel()
+
+	maxSongs := 0
+	policy := serverInstance.MusicPolicy()
+	if policy.MaxPlaylistSize.Valid && !serverInstance.IgnoresMusicPolicy(message) {
+		maxSongs = policy.MaxPlaylistSize.Int
+	}
+	progress := &playlistImportProgress{}
+	imp := &playlistImport{userID: message.Author.ID, channelID: channelID, cancel: cancel}
+	sent, err := serverInstance.Session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{
+			serverInstance.playlistImportEmbed(message, title, "Importing playlist", DARKER_GREY, progress),
+		},
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: "Cancel", Style: discordgo.DangerButton, CustomID: PlaylistImportCancelButtonID},
+			}},
+		},
+	})
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to send playlist import message.")
+	} else {
+		imp.messageID = sent.ID
+		serverInstance.playlistImports.Lock()
+		serverInstance.playlistImports.byMessage[imp.messageID] = imp
+		serverInstance.playlistImports.Unlock()
+		defer func() {
+			serverInstance.playlistImports.Lock()
+			delete(serverInstance.playlistImports.byMessage, imp.messageID)
+			serverInstance.playlistImports.Unlock()
+		}()
+	}
+
+	var batch []*music.Song
+	var lastQueued, lastUpdate time.Time
+	stopped := false
+	stop := func(reason string) {
+		stopped = true
+		progress.reason = reason
+		cancel()
+	}
+	queueBatch := func() {
+		if len(batch) == 0 {
+			return
+		}
+		// Songs that were already allowed are still queued when the import stops itself.
+		songRequests, errQueue := serverInstance.QueueSongRequests(serverInstance.Ctx, message.Author, batch)
+		batch = nil
+		lastQueued = time.Now()
+		if errQueue != nil {
+			serverInstance.Log.Error().Err(errQueue).Msg("Unable to queue playlist songs.")
+			stop("Database error.")
+			return
+		}
+		progress.queued += len(songRequests)
+		serverInstance.MusicData.RLock()
+		currentlyPlaying := serverInstance.MusicData.SongPlaying
+		serverInstance.MusicData.RUnlock()
+		if !currentlyPlaying {
+			serverInstance.TriggerNextSong <- struct{}{}
+		}
+	}
+	updateProgress := func() {
+		if imp.messageID == "" || time.Since(lastUpdate) < playlistImportInterval {
+			return
+		}
+		lastUpdate = time.Now()
+		serverInstance.editPlaylistImport(imp, serverInstance.playlistImportEmbed(message, title, "Importing playlist",
+			DARKER_GREY, progress), true)
+	}
+
+	errList := list(ctx, func(songInfo *music.Song) {
+		if stopped || ctx.Err() != nil {
+			return
+		}
+		defer updateProgress()
+		if songInfo == nil {
+			progress.unavailable++
+			return
+		}
+		progress.found++
+		if songInfo.PlaylistCount > progress.total {
+			progress.total = songInfo.PlaylistCount
+		}
+		if maxSongs > 0 && progress.found > maxSongs {
+			stop(fmt.Sprintf("Playlists can't have more than %d songs, so the rest weren't added.", maxSongs))
+			return
+		}
+		errPolicy := serverInstance.CheckSongBlocklist(ctx, songInfo)
+		if errPolicy == nil {
+			errPolicy = serverInstance.checkSongPolicy(ctx, message, songInfo, len(batch))
+		}
+		if errPolicy != nil {
+			var violation *MusicPolicyViolation
+			if !errors.As(errPolicy, &violation) {
+				serverInstance.Log.Error().Err(errPolicy).Msg("Unable to check music policy.")
+				stop("Unable to check the music policy.")
+				return
+			}
+			progress.rejected++
+			progress.reason = violation.Reason
+			if violation.QueueFull {
+				stop(violation.Reason)
+			}
+			return
+		}
+		batch = append(batch, songInfo)
+		if len(batch) >= playlistImportBatchSize || time.Since(lastQueued) >= playlistImportInterval {
+			queueBatch()
+		}
+	})
+	serverInstance.playlistImports.Lock()
+	cancelledBy := imp.cancelledBy
+	serverInstance.playlistImports.Unlock()
+	status := "Playlist added to the queue"
+	color := 28804
+	switch {
+	case cancelledBy != "":
+		status = "Playlist import cancelled"
+		color = 0xff9999
+		progress.reason = fmt.Sprintf("Cancelled by <@%s>.", cancelledBy)
+	case skipAllCtx.Err() != nil:
+		status = "Playlist import cancelled"
+		color = 0xff9999
+		progress.reason = "The queue was skipped."
+	default:
+		queueBatch()
+		if errList != nil && !stopped {
+			status = "Unable to import playlist"
+			color = 0xff9999
+			progress.reason = errList.Error()
+			if errors.Is(errList, music.ErrEmptyPlaylist) || errors.Is(errList, music.ErrUnsupportedLink) {
+				progress.reason = "The playlist has no songs that can be played."
+			} else {
+				serverInstance.Log.Error().Err(errList).Msg("Unable to get playlist info.")
+			}
+		}
+	}
+	embed := serverInstance.playlistImportEmbed(message, title, status, color, progress)
+	if imp.messageID == "" {
+		serverInstance.SendEmbedMessage(embed, channelID, "Unable to send playlist import message.")
+		return
+	}
+	serverInstance.editPlaylistImport(imp, embed, false)
+}
+
+// playlistImportEmbed shows how far along a playlist import is.
+func (serverInstance *ServerInstance) playlistImportEmbed(message *discordgo.Message, title, status string, color int,
+	progress *playlistImportProgress,
+) *discordgo.MessageEmbed {
+	embed := NewEmbedInfer(serverInstance.Session.State.User, color).
+		SetTitle(status).
+		SetDescription(title).
+		AddField("Progress", progress.String(), false)
+	if progress.reason != "" {
+		embed.AddField("Reason", progress.reason, false)
+	}
+	return embed.AddField("Requested by", message.Author.Username, false).
+		SetThumbnail("https://img.icons8.com/arcade/64/playlist.png").
+		MessageEmbed
+}
+
+// editPlaylistImport replaces the embed on the import's progress message, and removes the cancel button once the
+// import is over.
+func (serverInstance *ServerInstance) editPlaylistImport(imp *playlistImport, embed *discordgo.MessageEmbed,
+	running bool,
+) {
+	edit := &discordgo.MessageEdit{
+		ID:      imp.messageID,
+		Channel: imp.channelID,
+		Embeds:  []*discordgo.MessageEmbed{embed},
+	}
+	if !running {
+		edit.Components = []discordgo.MessageComponent{}
+	}
+	_, err := serverInstance.Session.ChannelMessageEditComplex(edit)
+	if err != nil {
+		serverInstance.Log.Debug().Err(err).Msg("Unable to edit playlist import message.")
+	}
+}
+
+// cancelPlaylistImport stops the import for the user who cancelled it. Songs that were already queued stay queued.
+func (serverInstance *ServerInstance) cancelPlaylistImport(imp *playlistImport, userID string) {
+	serverInstance.playlistImports.Lock()
+	if imp.cancelledBy == "" {
+		imp.cancelledBy = userID
+	}
+	serverInstance.playlistImports.Unlock()
+	imp.cancel()
+}
+
+// CancelPlaylistImports cancels the playlists the user is importing, or every import if all is set, and returns how
+// many were cancelled.
+func (serverInstance *ServerInstance) CancelPlaylistImports(userID string, all bool) int {
+	serverInstance.playlistImports.Lock()
+	var imports []*playlistImport
+	for _, imp := range serverInstance.playlistImports.byMessage {
+		if all || imp.userID == userID {
+			imports = append(imports, imp)
+		}
+	}
+	serverInstance.playlistImports.Unlock()
+	for _, imp := range imports {
+		serverInstance.cancelPlaylistImport(imp, userID)
+	}
+	return len(imports)
+}
+
+// playlistImportInteraction handles the cancel button on a playlist import message. Only the user who started the
+// import or a DJ can cancel it.
+func (serverInstance *ServerInstance) playlistImportInteraction(interaction *discordgo.InteractionCreate) {
+	userID := interaction.Member.User.ID
+	serverInstance.playlistImports.Lock()
+	imp := serverInstance.playlistImports.byMessage[interaction.Message.ID]
+	serverInstance.playlistImports.Unlock()
+	if imp == nil || (imp.userID != userID && !serverInstance.MemberIsDJ(userID)) {
+		content := "Only the person who added the playlist or a DJ can cancel it."
+		if imp == nil {
+			content = "The playlist isn't being imported anymore."
+		}
+		err := serverInstance.Session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: content,
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Msg("Unable to respond to playlist import cancel.")
+		}
+		return
+	}
+	err := serverInstance.Session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to respond to playlist import cancel.")
+	}
+	if interaction.MessageComponentData().CustomID == PlaylistImportCancelButtonID {
+		serverInstance.cancelPlaylistImport(imp, userID)
+	}
+}
@@ -0,0 +1,149 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+const (
+	// prefetchLead is how long before the current song ends the next song starts downloading and encoding.
+	prefetchLead = 20 * time.Second
+	// prefetchCheckInterval is how often the queue is checked while waiting to prefetch, and while a prefetch is
+	// waiting to be played.
+	prefetchCheckInterval = 2 * time.Second
+)
+
+type prefetchedSong struct {
+	songRequestID int64
+	cachedPath    string
+	prefetch      *music.Prefetch
+}
+
+// prefetchNextSongs prefetches the next song in the queue near the end of the current song. If the next song changes
+// before the current song ends, the prefetch is thrown away and the new next song is prefetched instead. It returns
+// when ctx is cancelled.
+func (serverInstance *ServerInstance) prefetchNextSongs(ctx context.Context) {
+	ticker := time.NewTicker(prefetchCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			serverInstance.checkPrefetch(ctx)
+		}
+	}
+}
+
+func (serverInstance *ServerInstance) checkPrefetch(ctx context.Context) {
+	// Songs without a known end, like live streams without a time limit, have nothing to prefetch ahead of.
+	left, leftKnown := serverInstance.CurrentSongTimeLeft()
+	if !leftKnown || left > prefetchLead || serverInstance.SongPaused() {
+		return
+	}
+	var next *models.SongRequest
+	var nextSongRequestID int64
+	// The track loop plays the current song again, which can't be prefetched because it's still playing.
+	if serverInstance.LoopMode() != music.LoopTrack {
+		queue, err := serverInstance.GetSongQueue(ctx, 1)
+		if err != nil {
+			if ctx.Err() == nil {
+				serverInstance.Log.Error().Err(err).Msg("Unable to get the next song to prefetch.")
+			}
+			return
+		}
+		if len(queue) > 0 && queue[0].R.Song != nil && !queue[0].R.Song.IsStream {
+			next = queue[0]
+			nextSongRequestID = next.ID
+		}
+	}
+
+	serverInstance.MusicData.Lock()
+	prefetched := serverInstance.MusicData.Prefetched
+	if prefetched != nil && prefetched.songRequestID == nextSongRequestID {
+		serverInstance.MusicData.Unlock()
+		return
+	}
+	serverInstance.MusicData.Prefetched = nil
+	serverInstance.MusicData.Unlock()
+	if prefetched != nil {
+		serverInstance.Log.Debug().Msg("Next song changed, discarding prefetch.")
+		prefetched.prefetch.Close()
+	}
+	if next == nil {
+		return
+	}
+
+	serverInstance.RLock()
+	volume := serverInstance.Configuration.MusicVolume
+	serverInstance.RUnlock()
+	filter := serverInstance.MusicFilter()
+	cachedPath := serverInstance.AudioCache.Path(next.R.Song.ID)
+	startOffset := time.Duration(next.StartOffsetSeconds) * time.Second
+	// The prefetch belongs to the server rather than the current song, so it survives the current song ending.
+	source := music.SourceNamed(next.R.Song.Platform.String)
+	cacheSongID := ""
+	if next.R.Song.DurationInSeconds.Int > 0 {
+		cacheSongID = next.R.Song.ID
+	}
+	prefetch, err := music.PrefetchSong(serverInstance.Ctx, source, next.R.Song.URL, cachedPath,
+		serverInstance.AudioCache, cacheSongID, startOffset, volume*music.GainMultiplier(next.R.Song.LoudnessGainDB),
+		filter, serverInstance.Log)
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to prefetch next song.")
+		return
+	}
+	serverInstance.Log.Debug().Str("song", next.R.Song.SongName).Msg("Prefetching next song")
+
+	serverInstance.MusicData.Lock()
+	defer serverInstance.MusicData.Unlock()
+	if ctx.Err() != nil || serverInstance.MusicData.Prefetched != nil {
+		prefetch.Close()
+		return
+	}
+	serverInstance.MusicData.Prefetched = &prefetchedSong{
+		songRequestID: next.ID,
+		cachedPath:    cachedPath,
+		prefetch:      prefetch,
+	}
+}
+
+// takePrefetch returns the prefetch for the song request and its cached file, if it was prefetched. Any other
+// prefetch is thrown away.
+func (serverInstance *ServerInstance) takePrefetch(songRequestID int64) (*music.Prefetch, string, bool) {
+	serverInstance.MusicData.Lock()
+	prefetched := serverInstance.MusicData.Prefetched
+	serverInstance.MusicData.Prefetched = nil
+	serverInstance.MusicData.Unlock()
+	if prefetched == nil {
+		return nil, "", false
+	}
+	if prefetched.songRequestID != songRequestID {
+		prefetched.prefetch.Close()
+		return nil, "", false
+	}
+	return prefetched.prefetch, prefetched.cachedPath, true
+}
+
+// DiscardPrefetch throws away the prefetched song, if there is one. It's called when the queue is cleared or stops
+// playing, so the download and encoder don't keep running for a song that may never play.
+func (serverInstance *ServerInstance) DiscardPrefetch() {
+	prefetch, _, _ := serverInstance.takePrefetch(0)
+	prefetch.Close()
+}
+
+// discardPrefetchOf throws away the prefetched song if it's for the song request.
+func (serverInstance *ServerInstance) discardPrefetchOf(songRequestID int64) {
+	serverInstance.MusicData.Lock()
+	prefetched := serverInstance.MusicData.Prefetched
+	if prefetched == nil || prefetched.songRequestID != songRequestID {
+		serverInstance.MusicData.Unlock()
+		return
+	}
+	serverInstance.MusicData.Prefetched = nil
+	serverInstance.MusicData.Unlock()
+	prefetched.prefetch.Close()
+}
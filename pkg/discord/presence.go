@@ -0,0 +1,300 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+)
+
+// IdleAction is what the bot does when nobody has been listening for the guild's idle time.
+type IdleAction string
+
+const (
+	// IdlePause pauses the song and resumes it when someone joins the voice channel.
+	IdlePause IdleAction = "pause"
+	// IdleLeave leaves the voice channel and comes back when someone joins it.
+	IdleLeave IdleAction = "leave"
+)
+
+var ErrInvalidIdleAction = errors.New("invalid idle action")
+
+// ParseIdleAction parses the name of an idle action.
+func ParseIdleAction(action string) (IdleAction, error) {
+	switch strings.ToLower(action) {
+	case "pause":
+		return IdlePause, nil
+	case "leave", "disconnect":
+		return IdleLeave, nil
+	}
+	return IdlePause, ErrInvalidIdleAction
+}
+
+// voicePresence keeps track of what the bot did because nobody was listening, so it can be undone when someone
+// comes back.
+type voicePresence struct {
+	// idleTimer runs out when nobody has been listening for the idle time. It's nil while someone is listening.
+	idleTimer *time.Timer
+	// idlePaused is set when the song was paused because nobody was listening, so it's only resumed automatically
+	// then.
+	idlePaused bool
+	// leftChannelID is the voice channel the bot left because nobody was listening.
+	leftChannelID string
+	// waitingForListeners is set when a song nobody heard was kept in the queue, so the queue stops until someone
+	// joins instead of playing songs into an empty channel.
+	waitingForListeners bool
+	*sync.Mutex
+}
+
+func newVoicePresence() *voicePresence {
+	return &voicePresence{Mutex: &sync.Mutex{}}
+}
+
+// listening stops the idle timer because someone is listening. It returns whether a song paused for being idle
+// should be resumed, and whether the queue was waiting for listeners and should start again.
+func (presence *voicePresence) listening() (bool, bool) {
+	presence.Lock()
+	defer presence.Unlock()
+	if presence.idleTimer != nil {
+		presence.idleTimer.Stop()
+		presence.idleTimer = nil
+	}
+	resume, resumeQueue := presence.idlePaused, presence.waitingForListeners
+	presence.idlePaused = false
+	presence.waitingForListeners = false
+	return resume, resumeQueue
+}
+
+// idle starts the idle timer because nobody is listening, unless it's already running. timedOut is called once the
+// timer runs out.
+func (presence *voicePresence) idle(timeout time.Duration, timedOut func()) {
+	presence.Lock()
+	defer presence.Unlock()
+	if presence.idleTimer == nil {
+		presence.idleTimer = time.AfterFunc(timeout, func() {
+			presence.Lock()
+			presence.idleTimer = nil
+			presence.Unlock()
+			timedOut()
+		})
+	}
+}
+
+// waitForListeners stops the queue until someone is listening.
+func (presence *voicePresence) waitForListeners() {
+	presence.Lock()
+	defer presence.Unlock()
+	presence.waitingForListeners = true
+}
+
+// waiting reports whether the queue is waiting for someone to listen.
+func (presence *voicePresence) waiting() bool {
+	presence.Lock()
+	defer presence.Unlock()
+	return presence.waitingForListeners
+}
+
+// MusicIdleTimeout returns how long the bot waits with nobody listening before it pauses or leaves. Zero means it
+// never does.
+func (serverInstance *ServerInstance) MusicIdleTimeout() time.Duration {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return time.Duration(serverInstance.Configuration.MusicIdleSeconds) * time.Second
+}
+
+// MusicIdleAction returns what the bot does when nobody has been listening for the idle time.
+func (serverInstance *ServerInstance) MusicIdleAction() IdleAction {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	action, err := ParseIdleAction(serverInstance.Configuration.MusicIdleAction)
+	if err != nil {
+		return IdlePause
+	}
+	return action
+}
+
+// SetMusicIdle saves how long the bot waits with nobody listening and what it does then.
+func (serverInstance *ServerInstance) SetMusicIdle(ctx context.Context, timeout time.Duration, action IdleAction,
+) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicIdleSeconds = int(timeout.Seconds())
+	serverInstance.Configuration.MusicIdleAction = string(action)
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicIdleSeconds, models.DiscordServerColumns.MusicIdleAction))
+	return err
+}
+
+// MusicKeepsUnheardSongs reports whether songs that finish without anyone in the voice channel stay in the queue
+// instead of being marked played.
+func (serverInstance *ServerInstance) MusicKeepsUnheardSongs() bool {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.MusicKeepUnheard
+}
+
+// SetMusicKeepsUnheardSongs saves whether songs nobody heard stay in the queue.
+func (serverInstance *ServerInstance) SetMusicKeepsUnheardSongs(ctx context.Context, keep bool) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicKeepUnheard = keep
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicKeepUnheard))
+	return err
+}
+
+// voiceStateIsBot reports whether the voice state belongs to a bot.
+func (serverInstance *ServerInstance) voiceStateIsBot(voiceState *discordgo.VoiceState) bool {
+	if voiceState.UserID == serverInstance.Session.State.User.ID {
+		return true
+	}
+	if voiceState.Member != nil && voiceState.Member.User != nil {
+		return voiceState.Member.User.Bot
+	}
+	member, err := serverInstance.GetGuildMember(voiceState.UserID)
+	return err != nil || member.User.Bot
+}
+
+// voiceStateChanged is called when someone joins, leaves or moves between voice channels. If the bot left a channel
+// because nobody was listening and someone joins it, the bot comes back and carries on with the queue.
+func (serverInstance *ServerInstance) voiceStateChanged(voiceState *discordgo.VoiceState) {
+	serverInstance.presence.Lock()
+	leftChannelID := serverInstance.presence.leftChannelID
+	serverInstance.presence.Unlock()
+	if leftChannelID != "" && voiceState.ChannelID == leftChannelID && !serverInstance.voiceStateIsBot(voiceState) {
+		err := serverInstance.JoinVoiceChannel(leftChannelID)
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Msg("Unable to rejoin voice channel.")
+			return
+		}
+		serverInstance.triggerNextSong()
+		return
+	}
+	serverInstance.checkListeners()
+}
+
+// checkListeners starts the idle timer when nobody is in the bot's voice channel, and stops it and resumes a song
+// paused for being idle when someone is.
+func (serverInstance *ServerInstance) checkListeners() {
+	if !serverInstance.VoiceConnected() {
+		return
+	}
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to get voice channel listeners.")
+		return
+	}
+	timeout := serverInstance.MusicIdleTimeout()
+	presence := serverInstance.presence
+	if len(listeners) > 0 {
+		serverInstance.MusicData.Lock()
+		serverInstance.MusicData.Heard = true
+		serverInstance.MusicData.Unlock()
+		resume, resumeQueue := presence.listening()
+		if resume {
+			err = serverInstance.SetSongPaused(false)
+			if err != nil && !errors.Is(err, ErrNoSongPlaying) && !errors.Is(err, ErrSongNotPaused) {
+				serverInstance.Log.Error().Err(err).Msg("Unable to resume song when listeners came back.")
+			}
+		}
+		if resumeQueue {
+			serverInstance.triggerNextSong()
+		}
+		return
+	}
+	if timeout <= 0 {
+		return
+	}
+	presence.idle(timeout, serverInstance.idleTimeout)
+}
+
+// waitingForListeners reports whether the queue is waiting for someone to join the voice channel because a song
+// finished with nobody listening. It stops waiting if someone is listening now.
+func (serverInstance *ServerInstance) waitingForListeners() bool {
+	if !serverInstance.presence.waiting() {
+		return false
+	}
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err == nil && len(listeners) == 0 {
+		return true
+	}
+	serverInstance.presence.listening()
+	return false
+}
+
+// idleTimeout pauses the song or leaves the voice channel once nobody has been listening for the idle time.
+func (serverInstance *ServerInstance) idleTimeout() {
+	channelID := serverInstance.botVoiceChannelID()
+	if channelID == "" || serverInstance.MusicIdleTimeout() <= 0 {
+		return
+	}
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err != nil || len(listeners) > 0 {
+		return
+	}
+	serverInstance.RLock()
+	musicChatChannelID := serverInstance.Configuration.MusicTextChannelID.String
+	serverInstance.RUnlock()
+	var description string
+	switch serverInstance.MusicIdleAction() {
+	case IdleLeave:
+		err = serverInstance.LeaveVoiceChannel()
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Msg("Unable to leave idle voice channel.")
+			return
+		}
+		serverInstance.presence.Lock()
+		serverInstance.presence.leftChannelID = channelID
+		serverInstance.presence.Unlock()
+		description = fmt.Sprintf("Nobody was listening, so I left <#%s>. I'll come back when someone joins it.",
+			channelID)
+	default:
+		err = serverInstance.SetSongPaused(true)
+		if err != nil {
+			return
+		}
+		serverInstance.presence.Lock()
+		serverInstance.presence.idlePaused = true
+		serverInstance.presence.Unlock()
+		description = fmt.Sprintf("Nobody is listening, so the song is paused until someone joins <#%s>.", channelID)
+	}
+	serverInstance.Log.Info().Str("voice_channel_id", channelID).Msg("Nobody is listening to music.")
+	embedmsg := NewEmbedInfer(serverInstance.Session.State.User, GOLD).
+		AddField("Nobody is listening", description, false).
+		MessageEmbed
+	serverInstance.SendEmbedMessage(embedmsg, musicChatChannelID, "Unable to send idle voice message.")
+}
+
+// forgetLeftChannel stops waiting for someone to join the channel the bot left for being idle.
+func (serverInstance *ServerInstance) forgetLeftChannel() {
+	serverInstance.presence.Lock()
+	defer serverInstance.presence.Unlock()
+	serverInstance.presence.leftChannelID = ""
+}
+
+// suspendSongRequest keeps a song request that was stopped because the bot left voice at the front of the queue, so
+// it picks up at position when the bot joins a voice channel again. Songs nobody heard are kept the same way from the
+// start.
+func (serverInstance *ServerInstance) suspendSongRequest(songRequest *models.SongRequest, position time.Duration,
+) error {
+	offset := int(position.Seconds())
+	if songRequest.R != nil && songRequest.R.Song != nil && songRequest.R.Song.IsStream {
+		offset = 0
+	}
+	if songRequest.StartOffsetSeconds != offset {
+		songRequest.StartOffsetSeconds = offset
+		_, err := songRequest.Update(serverInstance.Ctx, serverInstance.Db,
+			boil.Whitelist(models.SongRequestColumns.StartOffsetSeconds))
+		if err != nil {
+			return err
+		}
+	}
+	return serverInstance.MoveSongRequestToFront(serverInstance.Ctx, songRequest)
+}
@@ -0,0 +1,71 @@
+package discord
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVoicePresenceIdle(t *testing.T) {
+	presence := newVoicePresence()
+	timedOut := make(chan struct{}, 2)
+	onTimeout := func() {
+		timedOut <- struct{}{}
+	}
+
+	// Nobody listening is seen more than once before the timer runs out, which doesn't restart it.
+	presence.idle(10*time.Millisecond, onTimeout)
+	presence.idle(10*time.Millisecond, onTimeout)
+	<-timedOut
+	select {
+	case <-timedOut:
+		t.Fatal("the idle timer ran out twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once it has run out, the next time nobody is listening starts it again.
+	presence.idle(10*time.Millisecond, onTimeout)
+	select {
+	case <-timedOut:
+	case <-time.After(time.Second):
+		t.Fatal("the idle timer didn't start again")
+	}
+}
+
+func TestVoicePresenceListening(t *testing.T) {
+	presence := newVoicePresence()
+	timedOut := make(chan struct{}, 1)
+	presence.idle(20*time.Millisecond, func() {
+		timedOut <- struct{}{}
+	})
+	resume, resumeQueue := presence.listening()
+	if resume || resumeQueue {
+		t.Errorf("listening() = %v, %v with nothing paused or waiting, want false, false", resume, resumeQueue)
+	}
+	select {
+	case <-timedOut:
+		t.Fatal("the idle timer ran out after someone started listening")
+	case <-time.After(60 * time.Millisecond):
+	}
+
+	presence.idlePaused = true
+	resume, resumeQueue = presence.listening()
+	if !resume || resumeQueue {
+		t.Errorf("listening() = %v, %v with the song paused for being idle, want true, false", resume, resumeQueue)
+	}
+
+	presence.waitForListeners()
+	if !presence.waiting() {
+		t.Fatal("the queue isn't waiting for listeners")
+	}
+	resume, resumeQueue = presence.listening()
+	if resume || !resumeQueue {
+		t.Errorf("listening() = %v, %v with the queue waiting, want false, true", resume, resumeQueue)
+	}
+	if presence.waiting() {
+		t.Error("the queue is still waiting after someone started listening")
+	}
+	resume, resumeQueue = presence.listening()
+	if resume || resumeQueue {
+		t.Errorf("listening() = %v, %v a second time, want false, false", resume, resumeQueue)
+	}
+}
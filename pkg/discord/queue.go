@@ -0,0 +1,440 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+var ErrQueuePositionOutOfRange = errors.New("queue position out of range")
+
+// UserIsDJ reports whether the author of the message has full control over the music queue.
+func (serverInstance *ServerInstance) UserIsDJ(message *discordgo.Message) bool {
+	return serverInstance.MemberIsDJ(message.Author.ID)
+}
+
+// UserIsAdmin reports whether the author of the message is a server administrator.
+func (serverInstance *ServerInstance) UserIsAdmin(message *discordgo.Message) bool {
+	userPerms, err := serverInstance.getUserPermissions(message)
+	if err != nil {
+		return false
+	}
+	_, exists := userPerms[PermissionAdministrator]
+	return exists
+}
+
+// UserIsBotOwner reports whether the author of the message is one of the bot's owners, who manage settings shared by
+// every server.
+func (serverInstance *ServerInstance) UserIsBotOwner(message *discordgo.Message) bool {
+	for _, userID := range serverInstance.BotOwnerUserIDs {
+		if userID == message.Author.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// currentSongRequestID returns the ID of the song request that is playing, or 0 if nothing is playing.
+func (serverInstance *ServerInstance) currentSongRequestID() int64 {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.CurrentSongRequest == nil {
+		return 0
+	}
+	return serverInstance.MusicData.CurrentSongRequest.ID
+}
+
+var ErrFairQueueReorder = errors.New("the queue can't be reordered while fair queue is on")
+
+// FairQueueEnabled reports whether the guild takes turns between requesters instead of playing songs in the order
+// they were queued.
+func (serverInstance *ServerInstance) FairQueueEnabled() bool {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.MusicFairQueue
+}
+
+// SetFairQueueEnabled saves whether the guild takes turns between requesters.
+func (serverInstance *ServerInstance) SetFairQueueEnabled(ctx context.Context, enabled bool) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicFairQueue = enabled
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicFairQueue))
+	return err
+}
+
+// requesterTurns returns when each requester last had a song played in the guild. The requester of the song that is
+// playing had their turn just now.
+func (serverInstance *ServerInstance) requesterTurns(ctx context.Context) (map[string]time.Time, error) {
+	rows, err := serverInstance.Db.QueryContext(ctx, `
+		select requested_by_user_id, max(played_at)
+		from song_request
+		where guild_id = $1
+		  and played = true
+		  and played_at is not null
+		group by requested_by_user_id`, serverInstance.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	turns := make(map[string]time.Time)
+	for rows.Next() {
+		var userID string
+		var playedAt time.Time
+		errScan := rows.Scan(&userID, &playedAt)
+		if errScan != nil {
+			return nil, errScan
+		}
+		turns[userID] = playedAt
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	serverInstance.MusicData.RLock()
+	if serverInstance.MusicData.SongPlaying && serverInstance.MusicData.CurrentSongRequest != nil {
+		turns[serverInstance.MusicData.CurrentSongRequest.RequestedByUserID] = time.Now().UTC()
+	}
+	serverInstance.MusicData.RUnlock()
+	return turns, nil
+}
+
+// fairQueueOrder sorts a queue that is in position order so requesters take turns: everyone's first song plays before
+// anyone's second song, and so on. Within a round, requesters who haven't had a song played go first, then whoever
+// had their last turn longest ago. Songs moved to the front have a position of 0 or below and play before the turns.
+func fairQueueOrder(queue models.SongRequestSlice, turns map[string]time.Time) {
+	rounds := make(map[int64]int, len(queue))
+	requested := make(map[string]int)
+	for _, songRequest := range queue {
+		if songRequest.Position <= 0 {
+			continue
+		}
+		requested[songRequest.RequestedByUserID]++
+		rounds[songRequest.ID] = requested[songRequest.RequestedByUserID]
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		if rounds[queue[i].ID] != rounds[queue[j].ID] {
+			return rounds[queue[i].ID] < rounds[queue[j].ID]
+		}
+		if rounds[queue[i].ID] == 0 {
+			return false
+		}
+		turnI, playedI := turns[queue[i].RequestedByUserID]
+		turnJ, playedJ := turns[queue[j].RequestedByUserID]
+		if playedI != playedJ {
+			return !playedI
+		}
+		return turnI.Before(turnJ)
+	})
+}
+
+// GetSongQueue returns the song requests waiting behind the current song in the order they will be played.
+// A limit of 0 returns the entire queue.
+func (serverInstance *ServerInstance) GetSongQueue(ctx context.Context, limit int) (models.SongRequestSlice, error) {
+	mods := []qm.QueryMod{
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("played = false"),
+		qm.Load(models.SongRequestRels.Song),
+		qm.OrderBy("position asc"),
+		qm.OrderBy("id asc"),
+	}
+	if currentID := serverInstance.currentSongRequestID(); currentID != 0 {
+		mods = append(mods, qm.And("id != ?", currentID))
+	}
+	// Fair queue order depends on the whole queue, so it's sorted after loading it.
+	fair := serverInstance.FairQueueEnabled()
+	if limit > 0 && !fair {
+		mods = append(mods, qm.Limit(limit))
+	}
+	queue, err := models.SongRequests(mods...).All(ctx, serverInstance.Db)
+	if err != nil || !fair {
+		return queue, err
+	}
+	err = serverInstance.OrderSongQueue(ctx, queue)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(queue) > limit {
+		queue = queue[:limit]
+	}
+	return queue, nil
+}
+
+// OrderSongQueue sorts song requests that are in position order into the order they will be played.
+func (serverInstance *ServerInstance) OrderSongQueue(ctx context.Context, queue models.SongRequestSlice) error {
+	if !serverInstance.FairQueueEnabled() {
+		return nil
+	}
+	turns, err := serverInstance.requesterTurns(ctx)
+	if err != nil {
+		return err
+	}
+	fairQueueOrder(queue, turns)
+	return nil
+}
+
+// GetQueuedSongRequest returns the song request at the 1-based position in the queue.
+func (serverInstance *ServerInstance) GetQueuedSongRequest(ctx context.Context, position int) (*models.SongRequest, error) {
+	if position < 1 {
+		return nil, ErrQueuePositionOutOfRange
+	}
+	queue, err := serverInstance.GetSongQueue(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	if len(queue) < position {
+		return nil, ErrQueuePositionOutOfRange
+	}
+	return queue[position-1], nil
+}
+
+// CurrentSongTimeLeft returns the estimated time left in the song that is playing. It returns false when that can't
+// be known, like for a live stream that plays until it's skipped.
+func (serverInstance *ServerInstance) CurrentSongTimeLeft() (time.Duration, bool) {
+	serverInstance.MusicData.RLock()
+	defer serverInstance.MusicData.RUnlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.Playback == nil {
+		return 0, true
+	}
+	var left time.Duration
+	switch {
+	case serverInstance.MusicData.IsStream && serverInstance.MusicData.StreamStops.IsZero():
+		return 0, false
+	case serverInstance.MusicData.IsStream:
+		left = time.Until(serverInstance.MusicData.StreamStops)
+	case serverInstance.MusicData.SongDurationSeconds <= 0:
+		return 0, false
+	default:
+		playback := serverInstance.MusicData.Playback
+		songLeft := time.Duration(serverInstance.MusicData.SongDurationSeconds)*time.Second - playback.Position()
+		left = time.Duration(float64(songLeft) / playback.Filter().Speed())
+	}
+	if left < 0 {
+		return 0, true
+	}
+	return left, true
+}
+
+// songPlayTime returns how long a queued song is expected to play with the guild's audio filter. Live streams play
+// for the guild's max stream time, and it returns false when they have no limit.
+func songPlayTime(song *models.Song, policy models.MusicPolicy, filter music.AudioFilter) (time.Duration, bool) {
+	if song.IsStream {
+		return time.Duration(policy.MaxStreamSeconds.Int) * time.Second, policy.MaxStreamSeconds.Valid
+	}
+	return time.Duration(float64(song.DurationInSeconds.Int) * float64(time.Second) / filter.Speed()), true
+}
+
+// SongQueuePosition returns the 1-based position of the song request in the queue and the estimated time until it
+// starts playing. The wait isn't known, and false is returned, when a live stream without a time limit plays first.
+func (serverInstance *ServerInstance) SongQueuePosition(ctx context.Context, songRequest *models.SongRequest,
+) (int, time.Duration, bool, error) {
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	policy := serverInstance.MusicPolicy()
+	filter := serverInstance.MusicFilter()
+	wait, waitKnown := serverInstance.CurrentSongTimeLeft()
+	for index, queued := range queue {
+		if queued.ID == songRequest.ID {
+			return index + 1, wait, waitKnown, nil
+		}
+		if queued.R != nil && queued.R.Song != nil {
+			playTime, known := songPlayTime(queued.R.Song, policy, filter)
+			wait += playTime
+			waitKnown = waitKnown && known
+		}
+	}
+	return 0, 0, false, sql.ErrNoRows
+}
+
+func (serverInstance *ServerInstance) sendSongRequestEvent(songRequest *models.SongRequest,
+	eventType music.SongQueueEventType,
+) {
+	sr := *songRequest
+	event := music.SongQueueEvent{SongRequest: &sr, Type: eventType}
+	if songRequest.R != nil && songRequest.R.Song != nil {
+		s := *songRequest.R.Song
+		event.Song = &s
+	}
+	serverInstance.SendSongQueueEvent(event)
+}
+
+// RemoveSongRequest removes a song request that hasn't been played yet from the queue.
+func (serverInstance *ServerInstance) RemoveSongRequest(ctx context.Context, songRequest *models.SongRequest) error {
+	_, err := songRequest.Delete(ctx, serverInstance.Db)
+	if err != nil {
+		return err
+	}
+	serverInstance.discardPrefetchOf(songRequest.ID)
+	serverInstance.sendSongRequestEvent(songRequest, music.SongRemoved)
+	return nil
+}
+
+// RemoveUserSongRequests removes every queued song requested by the user and returns how many were removed.
+func (serverInstance *ServerInstance) RemoveUserSongRequests(ctx context.Context, userID string) (int, error) {
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, songRequest := range queue {
+		if songRequest.RequestedByUserID != userID {
+			continue
+		}
+		errRemove := serverInstance.RemoveSongRequest(ctx, songRequest)
+		if errRemove != nil {
+			return removed, errRemove
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// reorderSongQueue saves the queue in the given order. The positions already used by the queue are reused so
+// requests added while reordering still end up at the back.
+func (serverInstance *ServerInstance) reorderSongQueue(ctx context.Context, queue models.SongRequestSlice,
+	reordered models.SongRequestSlice,
+) error {
+	tx, err := serverInstance.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for index, songRequest := range reordered {
+		position := queue[index].Position
+		if songRequest.Position == position {
+			continue
+		}
+		_, errUpdate := tx.ExecContext(ctx, `update song_request set position = $1 where id = $2`,
+			position, songRequest.ID)
+		if errUpdate != nil {
+			_ = tx.Rollback()
+			return errUpdate
+		}
+		songRequest.Position = position
+	}
+	return tx.Commit()
+}
+
+// MoveSongRequest moves the song request at the 1-based position from to the position to.
+func (serverInstance *ServerInstance) MoveSongRequest(ctx context.Context, from, to int) (*models.SongRequest, error) {
+	if serverInstance.FairQueueEnabled() {
+		return nil, ErrFairQueueReorder
+	}
+	serverInstance.songQueueMutex.Lock()
+	defer serverInstance.songQueueMutex.Unlock()
+
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if from < 1 || from > len(queue) || to < 1 || to > len(queue) {
+		return nil, ErrQueuePositionOutOfRange
+	}
+	moved := queue[from-1]
+	reordered := make(models.SongRequestSlice, 0, len(queue))
+	reordered = append(reordered, queue[:from-1]...)
+	reordered = append(reordered, queue[from:]...)
+	reordered = append(reordered[:to-1], append(models.SongRequestSlice{moved}, reordered[to-1:]...)...)
+
+	err = serverInstance.reorderSongQueue(ctx, queue, reordered)
+	if err != nil {
+		return nil, err
+	}
+	serverInstance.sendSongRequestEvent(moved, music.SongMoved)
+	return moved, nil
+}
+
+// MoveSongRequestToFront makes the song request the next one to play.
+func (serverInstance *ServerInstance) MoveSongRequestToFront(ctx context.Context, songRequest *models.SongRequest) error {
+	serverInstance.songQueueMutex.Lock()
+	defer serverInstance.songQueueMutex.Unlock()
+
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 || queue[0].ID == songRequest.ID {
+		return nil
+	}
+	// The first song in play order doesn't have the lowest position when fair queue is on. Positions of 0 or below
+	// also play ahead of the fair queue turns.
+	position := queue[0].Position
+	for _, queued := range queue {
+		if queued.Position < position {
+			position = queued.Position
+		}
+	}
+	position--
+	if position > 0 {
+		position = 0
+	}
+	_, err = serverInstance.Db.ExecContext(ctx, `update song_request set position = $1 where id = $2`,
+		position, songRequest.ID)
+	if err != nil {
+		return err
+	}
+	songRequest.Position = position
+	serverInstance.sendSongRequestEvent(songRequest, music.SongMoved)
+	return nil
+}
+
+// ShuffleSongQueue randomizes the order of the queue and returns the number of songs shuffled.
+func (serverInstance *ServerInstance) ShuffleSongQueue(ctx context.Context) (int, error) {
+	serverInstance.songQueueMutex.Lock()
+	defer serverInstance.songQueueMutex.Unlock()
+
+	queue, err := serverInstance.GetSongQueue(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	if len(queue) < 2 {
+		return len(queue), nil
+	}
+	shuffled := make(models.SongRequestSlice, len(queue))
+	copy(shuffled, queue)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	err = serverInstance.reorderSongQueue(ctx, queue, shuffled)
+	if err != nil {
+		return 0, err
+	}
+	serverInstance.SendSongQueueEvent(music.SongQueueEvent{Type: music.SongQueueShuffled})
+	return len(queue), nil
+}
+
+// FormatQueueWait turns an estimated wait into a short human-readable string.
+func FormatQueueWait(wait time.Duration) string {
+	wait = wait.Round(time.Second)
+	hours := int(wait.Hours())
+	minutes := int(wait.Minutes()) % 60
+	seconds := int(wait.Seconds()) % 60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, seconds)
+}
+
+// FormatSongDuration returns LIVE for live streams and the duration for other songs. It's empty when the duration isn't
+// known.
+func FormatSongDuration(song *models.Song) string {
+	if song.IsStream {
+		return "🔴 LIVE"
+	}
+	if !song.DurationInSeconds.Valid || song.DurationInSeconds.Int <= 0 {
+		return ""
+	}
+	return FormatQueueWait(time.Duration(song.DurationInSeconds.Int) * time.Second)
+}
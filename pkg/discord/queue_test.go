@@ -0,0 +1,92 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"thalassa_discord/models"
+)
+
+// testQueue returns song requests in position order, one per requester letter.
+func testQueue(requesters string) models.SongRequestSlice {
+	queue := make(models.SongRequestSlice, 0, len(requesters))
+	for index, requester := range requesters {
+		queue = append(queue, &models.SongRequest{
+			ID:                int64(index + 1),
+			RequestedByUserID: string(requester),
+			Position:          int64(index + 1),
+		})
+	}
+	return queue
+}
+
+func queueRequesters(queue models.SongRequestSlice) string {
+	var requesters strings.Builder
+	for _, songRequest := range queue {
+		requesters.WriteString(songRequest.RequestedByUserID)
+	}
+	return requesters.String()
+}
+
+func TestFairQueuePlayOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		queued string
+		turns  map[string]time.Time
+		want   string
+	}{
+		{name: "a playlist doesn't hold up other requesters", queued: "AAAB", want: "ABAA"},
+		{name: "requesters take turns", queued: "AAABBC", want: "ABCABA"},
+		{name: "a single requester plays in order", queued: "AAA", want: "AAA"},
+		{
+			name:   "whoever played longest ago goes first",
+			queued: "AAABB",
+			turns:  map[string]time.Time{"A": time.Unix(20, 0), "B": time.Unix(10, 0)},
+			want:   "BABAA",
+		},
+		{
+			name:   "requesters who haven't played go first",
+			queued: "AAB",
+			turns:  map[string]time.Time{"B": time.Unix(10, 0)},
+			want:   "ABA",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			turns := make(map[string]time.Time)
+			for userID, playedAt := range test.turns {
+				turns[userID] = playedAt
+			}
+			queue := testQueue(test.queued)
+			var played strings.Builder
+			// Play the queue one song at a time, like the queue loop does.
+			for turn := 1; len(queue) > 0; turn++ {
+				fairQueueOrder(queue, turns)
+				next := queue[0]
+				played.WriteString(next.RequestedByUserID)
+				turns[next.RequestedByUserID] = time.Unix(int64(100+turn), 0)
+				queue = queue[1:]
+			}
+			if played.String() != test.want {
+				t.Errorf("played %s, want %s", played.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestFairQueueOrder(t *testing.T) {
+	queue := testQueue("AAAB")
+	// A's first song is playing.
+	fairQueueOrder(queue[1:], map[string]time.Time{"A": time.Unix(10, 0)})
+	if got := queueRequesters(queue[1:]); got != "BAA" {
+		t.Errorf("queue behind A's song is %s, want BAA", got)
+	}
+
+	queue = testQueue("AAAB")
+	queue[3].Position = 0
+	fairQueueOrder(queue, map[string]time.Time{"B": time.Unix(10, 0)})
+	if queue[0].ID != 4 {
+		t.Errorf("song moved to the front plays %s, want first", queueRequesters(queue))
+	}
+}
@@ -0,0 +1,334 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/music"
+)
+
+const (
+	// MaxSavedPlaylistSongs is the most songs a saved playlist can have.
+	MaxSavedPlaylistSongs = 500
+	// maxSavedPlaylistName is the longest a saved playlist name can be.
+	maxSavedPlaylistName = 50
+)
+
+var (
+	ErrSavedPlaylistNotFound     = errors.New("saved playlist not found")
+	ErrSavedPlaylistExists       = errors.New("a playlist with that name already exists")
+	ErrSavedPlaylistName         = errors.New("playlist names must be 1 to 50 characters with no spaces")
+	ErrSavedPlaylistFull         = errors.New("the playlist is full")
+	ErrSavedPlaylistSongNotFound = errors.New("the playlist doesn't have a song at that position")
+)
+
+// SavedPlaylistShared reports whether the playlist belongs to the whole guild rather than one user.
+func SavedPlaylistShared(playlist *models.SavedPlaylist) bool {
+	return !playlist.OwnerUserID.Valid
+}
+
+// CanEditSavedPlaylist reports whether the author of the message can change the playlist. Users change their own
+// playlists, and shared playlists can be changed by whoever created them and by DJs.
+func (serverInstance *ServerInstance) CanEditSavedPlaylist(message *discordgo.Message,
+	playlist *models.SavedPlaylist,
+) bool {
+	return serverInstance.UserCanEditSavedPlaylist(message.Author.ID, playlist)
+}
+
+// UserCanEditSavedPlaylist is CanEditSavedPlaylist for a guild member who didn't send a message, like an API caller.
+func (serverInstance *ServerInstance) UserCanEditSavedPlaylist(userID string, playlist *models.SavedPlaylist) bool {
+	if userID == "" {
+		return false
+	}
+	if SavedPlaylistShared(playlist) {
+		return playlist.CreatedByUserID == userID || serverInstance.MemberIsDJ(userID)
+	}
+	return playlist.OwnerUserID.String == userID
+}
+
+// UserCanSeeSavedPlaylist reports whether the user can see the playlist. Everyone sees shared playlists, but only
+// the owner sees their own.
+func UserCanSeeSavedPlaylist(userID string, playlist *models.SavedPlaylist) bool {
+	return SavedPlaylistShared(playlist) || (userID != "" && playlist.OwnerUserID.String == userID)
+}
+
+func validSavedPlaylistName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxSavedPlaylistName && !strings.ContainsAny(name, " \t\n")
+}
+
+// CheckSavedPlaylistSong returns a *MusicPolicyViolation if the user can't add the song to a playlist because it's on
+// the guild's blocklist or the music policy doesn't allow it.
+func (serverInstance *ServerInstance) CheckSavedPlaylistSong(ctx context.Context, userID string,
+	songInfo *music.Song,
+) error {
+	if err := serverInstance.CheckSongBlocklist(ctx, songInfo); err != nil {
+		return err
+	}
+	if serverInstance.memberIgnoresMusicPolicy(userID) {
+		return nil
+	}
+	return songPolicyViolation(serverInstance.MusicPolicy(), songInfo)
+}
+
+// SaveSong saves the song so it can be requested or added to a playlist.
+func (serverInstance *ServerInstance) SaveSong(ctx context.Context, songInfo *music.Song) (*models.Song, error) {
+	return saveSong(ctx, serverInstance.Db, songInfo)
+}
+
+// saveSong adds the song to the database or updates it with exec, so it can be part of a transaction.
+func saveSong(ctx context.Context, exec boil.ContextExecutor, songInfo *music.Song) (*models.Song, error) {
+	song := music.SongModel(songInfo)
+	// Whether the song is in the local music library is kept up to date by the library scan, and its loudness by
+	// MeasureSongLoudness.
+	err := song.Upsert(ctx, exec, true, []string{models.SongColumns.ID},
+		boil.Blacklist(models.SongColumns.LibraryModifiedAt, models.SongColumns.LoudnessLufs,
+			models.SongColumns.LoudnessGainDB), boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return song, nil
+}
+
+// SavedPlaylists returns the user's playlists followed by the guild's shared playlists, sorted by name.
+func (serverInstance *ServerInstance) SavedPlaylists(ctx context.Context, userID string) (models.SavedPlaylistSlice,
+	error,
+) {
+	return models.SavedPlaylists(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.Expr(qm.Where("owner_user_id = ?", userID), qm.Or("owner_user_id is null")),
+		qm.OrderBy("owner_user_id is null asc"),
+		qm.OrderBy("lower(name) asc"),
+	).All(ctx, serverInstance.Db)
+}
+
+// SavedPlaylistSongCounts returns how many songs are in each of the playlists, keyed by playlist ID.
+func (serverInstance *ServerInstance) SavedPlaylistSongCounts(ctx context.Context,
+	playlists models.SavedPlaylistSlice,
+) (map[int64]int, error) {
+	counts := make(map[int64]int, len(playlists))
+	if len(playlists) == 0 {
+		return counts, nil
+	}
+	ids := make([]interface{}, 0, len(playlists))
+	for _, playlist := range playlists {
+		ids = append(ids, playlist.ID)
+	}
+	var rows []struct {
+		SavedPlaylistID int64 `boil:"saved_playlist_id"`
+		Songs           int   `boil:"songs"`
+	}
+	err := models.NewQuery(
+		qm.Select("saved_playlist_id", "count(*) as songs"),
+		qm.From(models.TableNames.SavedPlaylistSong),
+		qm.WhereIn("saved_playlist_id in ?", ids...),
+		qm.GroupBy("saved_playlist_id"),
+	).Bind(ctx, serverInstance.Db, &rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.SavedPlaylistID] = row.Songs
+	}
+	return counts, nil
+}
+
+// FindSavedPlaylist finds a playlist by name, ignoring case. The user's own playlist is preferred over a shared one
+// with the same name.
+func (serverInstance *ServerInstance) FindSavedPlaylist(ctx context.Context, userID, name string) (
+	*models.SavedPlaylist, error,
+) {
+	playlist, err := models.SavedPlaylists(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("lower(name) = lower(?)", name),
+		qm.Expr(qm.Where("owner_user_id = ?", userID), qm.Or("owner_user_id is null")),
+		qm.OrderBy("owner_user_id is null asc"),
+	).One(ctx, serverInstance.Db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSavedPlaylistNotFound
+	}
+	return playlist, err
+}
+
+// GetSavedPlaylist finds one of the guild's playlists by ID.
+func (serverInstance *ServerInstance) GetSavedPlaylist(ctx context.Context, id int64) (*models.SavedPlaylist, error) {
+	playlist, err := models.SavedPlaylists(
+		qm.Where("id = ?", id),
+		qm.And("guild_id = ?", serverInstance.GuildID),
+	).One(ctx, serverInstance.Db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSavedPlaylistNotFound
+	}
+	return playlist, err
+}
+
+// savedPlaylistNameTaken reports whether the owner already has another playlist with the name.
+func (serverInstance *ServerInstance) savedPlaylistNameTaken(ctx context.Context, owner null.String, name string,
+	exceptID int64,
+) (bool, error) {
+	mods := []qm.QueryMod{
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		qm.And("lower(name) = lower(?)", name),
+		qm.And("id <> ?", exceptID),
+	}
+	if owner.Valid {
+		mods = append(mods, qm.And("owner_user_id = ?", owner.String))
+	} else {
+		mods = append(mods, qm.And("owner_user_id is null"))
+	}
+	return models.SavedPlaylists(mods...).Exists(ctx, serverInstance.Db)
+}
+
+// CreateSavedPlaylist creates an empty playlist owned by the user, or shared with the guild if shared is set.
+func (serverInstance *ServerInstance) CreateSavedPlaylist(ctx context.Context, name, userID string, shared bool) (
+	*models.SavedPlaylist, error,
+) {
+	if !validSavedPlaylistName(name) {
+		return nil, ErrSavedPlaylistName
+	}
+	owner := null.NewString(userID, !shared)
+	taken, err := serverInstance.savedPlaylistNameTaken(ctx, owner, name, 0)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, ErrSavedPlaylistExists
+	}
+	playlist := &models.SavedPlaylist{
+		GuildID:         serverInstance.GuildID,
+		OwnerUserID:     owner,
+		Name:            name,
+		CreatedByUserID: userID,
+	}
+	err = playlist.Insert(ctx, serverInstance.Db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// RenameSavedPlaylist renames the playlist.
+func (serverInstance *ServerInstance) RenameSavedPlaylist(ctx context.Context, playlist *models.SavedPlaylist,
+	name string,
+) error {
+	if !validSavedPlaylistName(name) {
+		return ErrSavedPlaylistName
+	}
+	taken, err := serverInstance.savedPlaylistNameTaken(ctx, playlist.OwnerUserID, name, playlist.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return ErrSavedPlaylistExists
+	}
+	playlist.Name = name
+	_, err = playlist.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.SavedPlaylistColumns.Name, models.SavedPlaylistColumns.UpdatedAt))
+	return err
+}
+
+// DeleteSavedPlaylist deletes the playlist and its songs.
+func (serverInstance *ServerInstance) DeleteSavedPlaylist(ctx context.Context, playlist *models.SavedPlaylist) error {
+	_, err := playlist.Delete(ctx, serverInstance.Db)
+	return err
+}
+
+// SavedPlaylistSongs returns the playlist's songs in order, with the songs loaded.
+func (serverInstance *ServerInstance) SavedPlaylistSongs(ctx context.Context, playlist *models.SavedPlaylist) (
+	models.SavedPlaylistSongSlice, error,
+) {
+	return playlist.SavedPlaylistSongs(
+		qm.Load(models.SavedPlaylistSongRels.Song),
+		qm.OrderBy("position asc"),
+		qm.OrderBy("id asc"),
+	).All(ctx, serverInstance.Db)
+}
+
+// AddSavedPlaylistSong adds the song to the end of the playlist.
+func (serverInstance *ServerInstance) AddSavedPlaylistSong(ctx context.Context, playlist *models.SavedPlaylist,
+	song *models.Song, userID string,
+) (*models.SavedPlaylistSong, error) {
+	tx, err := serverInstance.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Lock the playlist so songs added at the same time get different positions.
+	_, err = tx.ExecContext(ctx, `select 1 from saved_playlist where id = $1 for update`, playlist.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	var songs, lastPosition int
+	err = tx.QueryRowContext(ctx, `
+		select count(*), coalesce(max(position), 0)
+		from saved_playlist_song
+		where saved_playlist_id = $1`, playlist.ID).Scan(&songs, &lastPosition)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if songs >= MaxSavedPlaylistSongs {
+		_ = tx.Rollback()
+		return nil, ErrSavedPlaylistFull
+	}
+	playlistSong := &models.SavedPlaylistSong{
+		SavedPlaylistID: playlist.ID,
+		SongID:          song.ID,
+		Position:        lastPosition + 1,
+		AddedByUserID:   userID,
+	}
+	err = playlistSong.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	_, err = playlist.Update(ctx, tx, boil.Whitelist(models.SavedPlaylistColumns.UpdatedAt))
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	playlistSong.R = playlistSong.R.NewStruct()
+	playlistSong.R.Song = song
+	return playlistSong, nil
+}
+
+// RemoveSavedPlaylistSong removes the song at the 1-based position from the playlist and returns it.
+func (serverInstance *ServerInstance) RemoveSavedPlaylistSong(ctx context.Context, playlist *models.SavedPlaylist,
+	position int,
+) (*models.SavedPlaylistSong, error) {
+	playlistSongs, err := serverInstance.SavedPlaylistSongs(ctx, playlist)
+	if err != nil {
+		return nil, err
+	}
+	if position < 1 || position > len(playlistSongs) {
+		return nil, ErrSavedPlaylistSongNotFound
+	}
+	removed := playlistSongs[position-1]
+	tx, err := serverInstance.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, err = removed.Delete(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	_, err = playlist.Update(ctx, tx, boil.Whitelist(models.SavedPlaylistColumns.UpdatedAt))
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return removed, tx.Commit()
+}
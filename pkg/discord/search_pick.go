@@ -0,0 +1,169 @@
+package discord
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// SearchPickPrefix starts the custom ID of the menu and buttons on a search results message.
+	SearchPickPrefix = "search:"
+	// SearchPickMenuID is the custom ID of the menu used to pick a search result.
+	SearchPickMenuID = SearchPickPrefix + "pick"
+	// SearchCancelButtonID is the custom ID of the button that cancels a search.
+	SearchCancelButtonID = SearchPickPrefix + "cancel"
+	// searchPickTimeout is how long a user has to pick a search result.
+	searchPickTimeout = time.Minute
+)
+
+// searchPick is a search results message waiting for the user who searched to pick a result.
+type searchPick struct {
+	channelID string
+	messageID string
+	choices   int
+	pick      func(choice int)
+	timer     *time.Timer
+}
+
+// searchPicks are the searches waiting for a pick, by the user who searched. Each user has at most one.
+type searchPicks struct {
+	byUser map[string]*searchPick
+	*sync.Mutex
+}
+
+func newSearchPicks() *searchPicks {
+	return &searchPicks{byUser: make(map[string]*searchPick), Mutex: &sync.Mutex{}}
+}
+
+// AwaitSearchPick waits for the user to pick one of the results in the search message, with the menu on it or by
+// replying with a number in the channel. pick is called with the 0-based choice. A new search replaces the user's
+// previous one, and searches that aren't picked in time are closed.
+func (serverInstance *ServerInstance) AwaitSearchPick(userID, channelID, messageID string, choices int,
+	pick func(choice int),
+) {
+	p := &searchPick{channelID: channelID, messageID: messageID, choices: choices, pick: pick}
+	p.timer = time.AfterFunc(searchPickTimeout, func() {
+		if serverInstance.takeSearchPick(userID, p) {
+			serverInstance.closeSearchPick(p, "Nothing was picked in time.")
+		}
+	})
+	serverInstance.searchPicks.Lock()
+	previous := serverInstance.searchPicks.byUser[userID]
+	serverInstance.searchPicks.byUser[userID] = p
+	serverInstance.searchPicks.Unlock()
+	if previous != nil {
+		previous.timer.Stop()
+		serverInstance.closeSearchPick(previous, "Replaced by a newer search.")
+	}
+}
+
+// takeSearchPick removes the user's search if it's still p, and reports whether it was.
+func (serverInstance *ServerInstance) takeSearchPick(userID string, p *searchPick) bool {
+	serverInstance.searchPicks.Lock()
+	defer serverInstance.searchPicks.Unlock()
+	if serverInstance.searchPicks.byUser[userID] != p {
+		return false
+	}
+	delete(serverInstance.searchPicks.byUser, userID)
+	p.timer.Stop()
+	return true
+}
+
+// userSearchPick returns the user's search if it's waiting in the channel.
+func (serverInstance *ServerInstance) userSearchPick(userID, channelID string) *searchPick {
+	serverInstance.searchPicks.Lock()
+	defer serverInstance.searchPicks.Unlock()
+	p := serverInstance.searchPicks.byUser[userID]
+	if p == nil || p.channelID != channelID {
+		return nil
+	}
+	return p
+}
+
+// closeSearchPick removes the menu from the search message and says why.
+func (serverInstance *ServerInstance) closeSearchPick(p *searchPick, status string) {
+	_, err := serverInstance.Session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:         p.messageID,
+		Channel:    p.channelID,
+		Content:    &status,
+		Components: []discordgo.MessageComponent{},
+	})
+	if err != nil {
+		serverInstance.Log.Debug().Err(err).Msg("Unable to close search message.")
+	}
+}
+
+// pickSearchResult picks the 0-based choice from the user's search. It reports false if the user's search is gone or
+// the choice isn't one of the results.
+func (serverInstance *ServerInstance) pickSearchResult(userID string, p *searchPick, choice int) bool {
+	if choice < 0 || choice >= p.choices || !serverInstance.takeSearchPick(userID, p) {
+		return false
+	}
+	serverInstance.closeSearchPick(p, fmt.Sprintf("Picked result %d.", choice+1))
+	p.pick(choice)
+	return true
+}
+
+// searchPickReply picks a search result when the message is a number replying to the author's search. It reports
+// whether the message was used to pick.
+func (serverInstance *ServerInstance) searchPickReply(message *discordgo.Message) bool {
+	choice, err := strconv.Atoi(strings.TrimSpace(message.Content))
+	if err != nil {
+		return false
+	}
+	p := serverInstance.userSearchPick(message.Author.ID, message.ChannelID)
+	if p == nil || !serverInstance.pickSearchResult(message.Author.ID, p, choice-1) {
+		return false
+	}
+	err = serverInstance.Session.ChannelMessageDelete(message.ChannelID, message.ID)
+	if err != nil {
+		serverInstance.Log.Debug().Err(err).Msg("Unable to delete search reply.")
+	}
+	return true
+}
+
+// searchPickInteraction handles the menu and cancel button on a search message. Only the user who searched can use
+// them.
+func (serverInstance *ServerInstance) searchPickInteraction(interaction *discordgo.InteractionCreate) {
+	userID := interaction.Member.User.ID
+	p := serverInstance.userSearchPick(userID, interaction.ChannelID)
+	if p == nil || p.messageID != interaction.Message.ID {
+		err := serverInstance.Session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "Only the person who searched can pick a result.",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			serverInstance.Log.Error().Err(err).Msg("Unable to respond to search pick.")
+		}
+		return
+	}
+	err := serverInstance.Session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		serverInstance.Log.Error().Err(err).Msg("Unable to respond to search pick.")
+	}
+	data := interaction.MessageComponentData()
+	switch data.CustomID {
+	case SearchCancelButtonID:
+		if serverInstance.takeSearchPick(userID, p) {
+			serverInstance.closeSearchPick(p, "Search cancelled.")
+		}
+	case SearchPickMenuID:
+		if len(data.Values) == 0 {
+			return
+		}
+		choice, errChoice := strconv.Atoi(data.Values[0])
+		if errChoice == nil {
+			serverInstance.pickSearchResult(userID, p, choice)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+)
+
+// StatsPeriod is how far back music statistics go.
+type StatsPeriod string
+
+const (
+	StatsAllTime StatsPeriod = "all"
+	StatsDay     StatsPeriod = "day"
+	StatsWeek    StatsPeriod = "week"
+	StatsMonth   StatsPeriod = "month"
+	StatsYear    StatsPeriod = "year"
+)
+
+var ErrUnknownStatsPeriod = errors.New("unknown stats period")
+
+// ParseStatsPeriod parses day, week, month, year or all.
+func ParseStatsPeriod(period string) (StatsPeriod, error) {
+	switch StatsPeriod(strings.ToLower(period)) {
+	case StatsAllTime, "alltime", "":
+		return StatsAllTime, nil
+	case StatsDay, "today":
+		return StatsDay, nil
+	case StatsWeek:
+		return StatsWeek, nil
+	case StatsMonth:
+		return StatsMonth, nil
+	case StatsYear:
+		return StatsYear, nil
+	}
+	return "", ErrUnknownStatsPeriod
+}
+
+func (p StatsPeriod) FriendlyName() string {
+	switch p {
+	case StatsDay:
+		return "Today"
+	case StatsWeek:
+		return "This week"
+	case StatsMonth:
+		return "This month"
+	case StatsYear:
+		return "This year"
+	}
+	return "All time"
+}
+
+// Since returns when the period started. Statistics for all time start at the zero time.
+func (p StatsPeriod) Since(now time.Time) time.Time {
+	switch p {
+	case StatsDay:
+		return now.Add(-24 * time.Hour)
+	case StatsWeek:
+		return now.AddDate(0, 0, -7)
+	case StatsMonth:
+		return now.AddDate(0, -1, 0)
+	case StatsYear:
+		return now.AddDate(-1, 0, 0)
+	}
+	return time.Time{}
+}
+
+// MusicStats sums up the songs played in a guild.
+type MusicStats struct {
+	Played        int
+	Skipped       int
+	Autoplayed    int
+	Songs         int
+	Requesters    int
+	PlayedSeconds int64
+}
+
+// SkipRate returns the share of played songs that were skipped, from 0 to 1.
+func (s MusicStats) SkipRate() float64 {
+	if s.Played == 0 {
+		return 0
+	}
+	return float64(s.Skipped) / float64(s.Played)
+}
+
+// SongStats is how often a song was played.
+type SongStats struct {
+	Song       *models.Song
+	Plays      int
+	Skips      int
+	Requesters int
+}
+
+// RequesterStats is how many songs a user requested.
+type RequesterStats struct {
+	UserID        string
+	Username      string
+	Requests      int
+	Skips         int
+	PlayedSeconds int64
+}
+
+// SongHistory returns the guild's most recently played song requests, with their songs loaded.
+func (serverInstance *ServerInstance) SongHistory(ctx context.Context, limit int) (models.SongRequestSlice, error) {
+	return models.SongRequests(
+		qm.Where("guild_id = ?", serverInstance.GuildID),
+		// Literal so the partial history index is used.
+		qm.And("played = true"),
+		qm.And("played_at is not null"),
+		qm.OrderBy("played_at desc"),
+		qm.Limit(limit),
+		qm.Load(models.SongRequestRels.Song),
+	).All(ctx, serverInstance.Db)
+}
+
+// GetMusicStats sums up the songs played in the period. When userID is set only the user's requests are counted.
+// Songs that were skipped count the time they were listened to rather than their whole duration.
+func (serverInstance *ServerInstance) GetMusicStats(ctx context.Context, period StatsPeriod, userID string) (
+	MusicStats, error,
+) {
+	var stats MusicStats
+	err := serverInstance.Db.QueryRowContext(ctx, `
+		select count(*),
+		       count(*) filter (where sr.skipped),
+		       count(*) filter (where sr.autoplay),
+		       count(distinct sr.song_id),
+		       count(distinct sr.requested_by_user_id) filter (where not sr.autoplay),
+		       coalesce(sum(coalesce(sr.played_seconds, s.duration_in_seconds, 0)), 0)
+		from song_request sr
+		left join song s on s.id = sr.song_id
+		where sr.guild_id = $1
+		  and sr.played = true
+		  and sr.played_at >= $2
+		  and ($3 = '' or sr.requested_by_user_id = $3)`,
+		serverInstance.GuildID, period.Since(time.Now().UTC()), userID,
+	).Scan(&stats.Played, &stats.Skipped, &stats.Autoplayed, &stats.Songs, &stats.Requesters, &stats.PlayedSeconds)
+	return stats, err
+}
+
+// TopSongs returns the songs played most in the period. When userID is set only the user's requests are counted.
+func (serverInstance *ServerInstance) TopSongs(ctx context.Context, period StatsPeriod, userID string, limit int) (
+	[]SongStats, error,
+) {
+	rows, err := serverInstance.Db.QueryContext(ctx, `
+		select sr.song_id,
+		       count(*)                                                      as plays,
+		       count(*) filter (where sr.skipped),
+		       count(distinct sr.requested_by_user_id) filter (where not sr.autoplay)
+		from song_request sr
+		where sr.guild_id = $1
+		  and sr.played = true
+		  and sr.played_at >= $2
+		  and sr.song_id is not null
+		  and ($3 = '' or sr.requested_by_user_id = $3)
+		group by sr.song_id
+		order by plays desc, sr.song_id
+		limit $4`,
+		serverInstance.GuildID, period.Since(time.Now().UTC()), userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var topSongs []SongStats
+	var songIDs []interface{}
+	for rows.Next() {
+		var songID string
+		var songStats SongStats
+		err = rows.Scan(&songID, &songStats.Plays, &songStats.Skips, &songStats.Requesters)
+		if err != nil {
+			return nil, err
+		}
+		songStats.Song = &models.Song{ID: songID}
+		topSongs = append(topSongs, songStats)
+		songIDs = append(songIDs, songID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(songIDs) == 0 {
+		return topSongs, nil
+	}
+	songs, err := models.Songs(qm.WhereIn("id in ?", songIDs...)).All(ctx, serverInstance.Db)
+	if err != nil {
+		return nil, err
+	}
+	songsByID := make(map[string]*models.Song, len(songs))
+	for _, song := range songs {
+		songsByID[song.ID] = song
+	}
+	for index := range topSongs {
+		if song, exists := songsByID[topSongs[index].Song.ID]; exists {
+			topSongs[index].Song = song
+		}
+	}
+	return topSongs, nil
+}
+
+// TopRequesters returns the users who requested the most songs that were played in the period. Autoplay isn't
+// counted.
+func (serverInstance *ServerInstance) TopRequesters(ctx context.Context, period StatsPeriod, limit int) (
+	[]RequesterStats, error,
+) {
+	rows, err := serverInstance.Db.QueryContext(ctx, `
+		select sr.requested_by_user_id,
+		       (array_agg(sr.username_at_time order by sr.id desc))[1],
+		       count(*)                                                      as requests,
+		       count(*) filter (where sr.skipped),
+		       coalesce(sum(coalesce(sr.played_seconds, s.duration_in_seconds, 0)), 0)
+		from song_request sr
+		left join song s on s.id = sr.song_id
+		where sr.guild_id = $1
+		  and sr.played = true
+		  and sr.played_at >= $2
+		  and sr.autoplay = false
+		group by sr.requested_by_user_id
+		order by requests desc, sr.requested_by_user_id
+		limit $3`,
+		serverInstance.GuildID, period.Since(time.Now().UTC()), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var requesters []RequesterStats
+	for rows.Next() {
+		var requester RequesterStats
+		err = rows.Scan(&requester.UserID, &requester.Username, &requester.Requests, &requester.Skips,
+			&requester.PlayedSeconds)
+		if err != nil {
+			return nil, err
+		}
+		requesters = append(requesters, requester)
+	}
+	return requesters, rows.Err()
+}
@@ -0,0 +1,216 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"thalassa_discord/models"
+)
+
+var (
+	ErrRequesterNotInVoice = errors.New("the requester isn't in a voice channel and there's no music voice channel")
+	ErrNoMusicVoiceChannel = errors.New("no music voice channel is set")
+	ErrVoiceChannelBusy    = errors.New("the bot is playing music in another voice channel")
+	ErrNotConnectedToVoice = errors.New("the bot isn't in a voice channel")
+)
+
+// MusicFollowsRequester reports whether the bot joins the voice channel of whoever requests a song. When it's off the
+// bot only plays in the music voice channel.
+func (serverInstance *ServerInstance) MusicFollowsRequester() bool {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.MusicFollowRequester
+}
+
+// SetMusicFollowsRequester saves whether the bot joins the voice channel of whoever requests a song.
+func (serverInstance *ServerInstance) SetMusicFollowsRequester(ctx context.Context, enabled bool) error {
+	serverInstance.Lock()
+	defer serverInstance.Unlock()
+	serverInstance.Configuration.MusicFollowRequester = enabled
+	_, err := serverInstance.Configuration.Update(ctx, serverInstance.Db,
+		boil.Whitelist(models.DiscordServerColumns.MusicFollowRequester))
+	return err
+}
+
+// musicVoiceChannelID returns the configured music voice channel, or an empty string if there isn't one.
+func (serverInstance *ServerInstance) musicVoiceChannelID() string {
+	serverInstance.RLock()
+	defer serverInstance.RUnlock()
+	return serverInstance.Configuration.MusicVoiceChannelID.String
+}
+
+// VoiceConnected reports whether the bot is in a voice channel in this guild.
+func (serverInstance *ServerInstance) VoiceConnected() bool {
+	return serverInstance.botVoiceChannelID() != ""
+}
+
+// UserVoiceChannelID returns the voice channel the user is in, or an empty string if they aren't in one.
+func (serverInstance *ServerInstance) UserVoiceChannelID(userID string) string {
+	guild, err := serverInstance.GetGuild()
+	if err != nil {
+		return ""
+	}
+	for _, voiceState := range guild.VoiceStates {
+		if voiceState.UserID == userID {
+			return voiceState.ChannelID
+		}
+	}
+	return ""
+}
+
+// musicIdle reports whether nothing is playing and nothing is queued, which is when the bot can move to another
+// voice channel without interrupting anyone.
+func (serverInstance *ServerInstance) musicIdle(ctx context.Context) (bool, error) {
+	serverInstance.MusicData.RLock()
+	playing := serverInstance.MusicData.SongPlaying
+	serverInstance.MusicData.RUnlock()
+	if playing {
+		return false, nil
+	}
+	queue, err := serverInstance.GetSongQueue(ctx, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(queue) == 0, nil
+}
+
+// RequestVoiceChannelID returns the voice channel the bot should play a user's requests in. The music voice channel
+// is always used unless the bot follows requesters, in which case it's only used when the user isn't in a voice
+// channel.
+func (serverInstance *ServerInstance) RequestVoiceChannelID(userID string) (string, error) {
+	musicVoiceChannelID := serverInstance.musicVoiceChannelID()
+	if serverInstance.MusicFollowsRequester() {
+		if channelID := serverInstance.UserVoiceChannelID(userID); channelID != "" {
+			return channelID, nil
+		}
+		if musicVoiceChannelID == "" {
+			return "", ErrRequesterNotInVoice
+		}
+		return musicVoiceChannelID, nil
+	}
+	if musicVoiceChannelID == "" {
+		return "", ErrNoMusicVoiceChannel
+	}
+	return musicVoiceChannelID, nil
+}
+
+// JoinRequesterVoiceChannel makes sure the bot is in a voice channel before a user's song request is queued. The bot
+// joins the channel the request should play in if it isn't in one, and only moves there from another channel when
+// nothing is playing or queued. Otherwise the song is queued for the channel the bot is already in.
+func (serverInstance *ServerInstance) JoinRequesterVoiceChannel(ctx context.Context, userID string) error {
+	channelID, err := serverInstance.RequestVoiceChannelID(userID)
+	if err != nil {
+		return err
+	}
+	botChannelID := serverInstance.botVoiceChannelID()
+	if botChannelID == channelID {
+		return nil
+	}
+	if botChannelID != "" {
+		idle, err := serverInstance.musicIdle(ctx)
+		if err != nil || !idle {
+			return err
+		}
+	}
+	return serverInstance.JoinVoiceChannel(channelID)
+}
+
+// MoveToVoiceChannel joins the voice channel for a user who asked the bot to join. Anyone can bring the bot in when
+// it isn't in a voice channel or nothing is playing or queued, but only DJs can move it away from listeners.
+func (serverInstance *ServerInstance) MoveToVoiceChannel(ctx context.Context, userID string, dj bool) (string, error) {
+	channelID, err := serverInstance.RequestVoiceChannelID(userID)
+	if err != nil {
+		return "", err
+	}
+	botChannelID := serverInstance.botVoiceChannelID()
+	if botChannelID == channelID {
+		return channelID, nil
+	}
+	if botChannelID != "" && !dj {
+		idle, err := serverInstance.musicIdle(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !idle {
+			return "", ErrVoiceChannelBusy
+		}
+	}
+	err = serverInstance.JoinVoiceChannel(channelID)
+	if err != nil {
+		return "", err
+	}
+	// Start the queue in case songs were waiting for the bot to join.
+	serverInstance.triggerNextSong()
+	return channelID, nil
+}
+
+// JoinVoiceChannel connects the bot to the voice channel, moving it there if it's in another channel.
+func (serverInstance *ServerInstance) JoinVoiceChannel(channelID string) error {
+	retryOptions := []retry.Option{
+		retry.Context(serverInstance.Ctx),
+		retry.Attempts(10),
+		retry.Delay(1 * time.Second),
+		retry.MaxDelay(1 * time.Minute),
+	}
+	serverInstance.forgetLeftChannel()
+	serverInstance.voiceMutex.Lock()
+	defer serverInstance.voiceMutex.Unlock()
+	errRetry := retry.Do(func() error {
+		serverInstance.Session.RLock()
+		voiceConnection, exists := serverInstance.Session.VoiceConnections[serverInstance.GuildID]
+		serverInstance.Session.RUnlock()
+		connectedChannelID := ""
+		if exists {
+			voiceConnection.RLock()
+			connectedChannelID = voiceConnection.ChannelID
+			voiceConnection.RUnlock()
+		}
+		if connectedChannelID != channelID {
+			vc, errConnectVoice := serverInstance.Session.ChannelVoiceJoin(serverInstance.GuildID,
+				channelID, false, true)
+			if errConnectVoice != nil {
+				return errConnectVoice
+			}
+			voiceConnection = vc
+		}
+		voiceConnection.RLock()
+		voiceReady := voiceConnection.Ready
+		voiceConnection.RUnlock()
+		if !voiceReady {
+			return errors.New("voice not ready")
+		}
+		return nil
+	}, retryOptions...)
+	if errRetry != nil {
+		return errRetry
+	}
+	// Start waiting to go idle if nobody is in the channel.
+	go serverInstance.checkListeners()
+	return nil
+}
+
+// LeaveVoiceChannel disconnects the bot from voice. The song that is playing and the rest of the queue are kept, and
+// start again when the bot joins a voice channel.
+func (serverInstance *ServerInstance) LeaveVoiceChannel() error {
+	serverInstance.voiceMutex.Lock()
+	defer serverInstance.voiceMutex.Unlock()
+	serverInstance.Session.RLock()
+	voiceConnection, exists := serverInstance.Session.VoiceConnections[serverInstance.GuildID]
+	serverInstance.Session.RUnlock()
+	if !exists {
+		return ErrNotConnectedToVoice
+	}
+	// Disconnect before stopping the song so the queue loop doesn't start the next song in the channel being left.
+	err := voiceConnection.Disconnect()
+	serverInstance.MusicData.Lock()
+	if serverInstance.MusicData.SongPlaying {
+		serverInstance.MusicData.Suspended = true
+		serverInstance.MusicData.CtxCancel()
+	}
+	serverInstance.MusicData.Unlock()
+	return err
+}
@@ -0,0 +1,114 @@
+package discord
+
+import (
+	"math"
+
+	"github.com/friendsofgo/errors"
+)
+
+var (
+	ErrNotInVoiceChannel = errors.New("user is not in the bot's voice channel")
+	ErrNoSongPlaying     = errors.New("no song is currently playing")
+)
+
+// SkipVoteResult is the state of the skip vote after a vote has been added.
+type SkipVoteResult struct {
+	Votes     int
+	Needed    int
+	Listeners int
+	Skip      bool
+	// SongRequestID and SongName are the song that was voted on, which may have ended by the time the vote is used.
+	SongRequestID int64
+	SongName      string
+}
+
+// botVoiceChannelID returns the voice channel the bot is connected to in this guild.
+func (serverInstance *ServerInstance) botVoiceChannelID() string {
+	serverInstance.Session.RLock()
+	voiceConnection, exists := serverInstance.Session.VoiceConnections[serverInstance.GuildID]
+	serverInstance.Session.RUnlock()
+	if !exists {
+		return ""
+	}
+	voiceConnection.RLock()
+	defer voiceConnection.RUnlock()
+	return voiceConnection.ChannelID
+}
+
+// VoiceChannelListeners returns the IDs of the non-bot members in the bot's voice channel.
+func (serverInstance *ServerInstance) VoiceChannelListeners() ([]string, error) {
+	channelID := serverInstance.botVoiceChannelID()
+	if channelID == "" {
+		return nil, nil
+	}
+	guild, err := serverInstance.GetGuild()
+	if err != nil {
+		return nil, err
+	}
+	var listeners []string
+	for _, voiceState := range guild.VoiceStates {
+		if voiceState.ChannelID != channelID || serverInstance.voiceStateIsBot(voiceState) {
+			continue
+		}
+		listeners = append(listeners, voiceState.UserID)
+	}
+	return listeners, nil
+}
+
+// skipVotesNeeded returns how many votes are needed to skip with the configured threshold.
+func (serverInstance *ServerInstance) skipVotesNeeded(listeners int) int {
+	serverInstance.RLock()
+	threshold := serverInstance.Configuration.VoteSkipThresholdPercent
+	serverInstance.RUnlock()
+	if threshold < 1 {
+		threshold = 1
+	}
+	if threshold > 100 {
+		threshold = 100
+	}
+	needed := int(math.Ceil(float64(listeners) * float64(threshold) / 100))
+	if needed < 1 {
+		needed = 1
+	}
+	return needed
+}
+
+// AddSkipVote records a vote from the user to skip the current song. The requester of the song skips it instantly.
+// Votes from users who have left the voice channel are no longer counted.
+func (serverInstance *ServerInstance) AddSkipVote(userID string) (*SkipVoteResult, error) {
+	listeners, err := serverInstance.VoiceChannelListeners()
+	if err != nil {
+		return nil, err
+	}
+	listening := make(map[string]struct{}, len(listeners))
+	for _, listener := range listeners {
+		listening[listener] = struct{}{}
+	}
+
+	serverInstance.MusicData.Lock()
+	defer serverInstance.MusicData.Unlock()
+	if !serverInstance.MusicData.SongPlaying || serverInstance.MusicData.CurrentSongRequest == nil {
+		return nil, ErrNoSongPlaying
+	}
+	result := &SkipVoteResult{
+		Listeners:     len(listeners),
+		Needed:        serverInstance.skipVotesNeeded(len(listeners)),
+		SongRequestID: serverInstance.MusicData.CurrentSongRequest.ID,
+		SongName:      serverInstance.MusicData.CurrentSongRequest.SongName,
+	}
+	if serverInstance.MusicData.CurrentSongRequest.RequestedByUserID == userID {
+		result.Skip = true
+		return result, nil
+	}
+	if _, exists := listening[userID]; !exists {
+		return nil, ErrNotInVoiceChannel
+	}
+	serverInstance.MusicData.SkipVotes[userID] = struct{}{}
+	for voter := range serverInstance.MusicData.SkipVotes {
+		if _, exists := listening[voter]; exists {
+			result.Votes++
+		}
+	}
+	result.Skip = result.Votes >= result.Needed
+	return result, nil
+}
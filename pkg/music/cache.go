@@ -0,0 +1,347 @@
+package music
+
+import (
+	"container/list"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// audioCacheExtension is added to cached files. The files keep the format the source downloaded, which ffmpeg
+	// detects from the contents, so the extension only marks files that belong to the cache.
+	audioCacheExtension = ".audio"
+	// audioCacheMaxFileSize is the biggest song the cache keeps.
+	audioCacheMaxFileSize = 100 * 1024 * 1024
+)
+
+// AudioCacheStats describes the contents of an audio cache.
+type AudioCacheStats struct {
+	Entries      int
+	SizeBytes    int64
+	MaxSizeBytes int64
+	Hits         int64
+	Misses       int64
+	Downloading  int
+}
+
+type audioCacheEntry struct {
+	songID string
+	path   string
+	size   int64
+}
+
+// AudioCache keeps downloaded songs on disk, keyed by song ID, so they can be played again without downloading
+// them. When the cache is bigger than its maximum size the least recently played songs are removed. A nil cache is
+// valid and never has anything in it.
+type AudioCache struct {
+	directory    string
+	maxSizeBytes int64
+	sizeBytes    int64
+	entries      map[string]*list.Element
+	recent       *list.List
+	downloading  map[string]struct{}
+	hits         int64
+	misses       int64
+	*sync.Mutex
+}
+
+// NewAudioCache opens the audio cache in directory, creating it if needed. Songs already in the directory are kept,
+// with the most recently played ones kept longest.
+func NewAudioCache(directory string, maxSizeBytes int64) (*AudioCache, error) {
+	err := os.MkdirAll(directory, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	cache := &AudioCache{
+		directory:    directory,
+		maxSizeBytes: maxSizeBytes,
+		entries:      make(map[string]*list.Element),
+		recent:       list.New(),
+		downloading:  make(map[string]struct{}),
+		Mutex:        &sync.Mutex{},
+	}
+	dirEntries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	type cachedFile struct {
+		entry   *audioCacheEntry
+		modTime time.Time
+	}
+	var files []cachedFile
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		path := filepath.Join(directory, name)
+		if dirEntry.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(name, audioCacheExtension) {
+			// Leftover partial downloads.
+			if strings.HasSuffix(name, ".part") {
+				_ = os.Remove(path)
+			}
+			continue
+		}
+		songID, errDecode := base64.RawURLEncoding.DecodeString(strings.TrimSuffix(name, audioCacheExtension))
+		if errDecode != nil {
+			continue
+		}
+		info, errInfo := dirEntry.Info()
+		if errInfo != nil {
+			continue
+		}
+		files = append(files, cachedFile{
+			entry:   &audioCacheEntry{songID: string(songID), path: path, size: info.Size()},
+			modTime: info.ModTime(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.After(files[j].modTime)
+	})
+	for _, file := range files {
+		cache.entries[file.entry.songID] = cache.recent.PushBack(file.entry)
+		cache.sizeBytes += file.entry.size
+	}
+	cache.evict()
+	return cache, nil
+}
+
+func (c *AudioCache) songPath(songID string) string {
+	return filepath.Join(c.directory, base64.RawURLEncoding.EncodeToString([]byte(songID))+audioCacheExtension)
+}
+
+// Path returns the cached file for the song, or an empty string if the song isn't cached.
+func (c *AudioCache) Path(songID string) string {
+	if c == nil {
+		return ""
+	}
+	c.Lock()
+	defer c.Unlock()
+	element, exists := c.entries[songID]
+	if !exists {
+		c.misses++
+		return ""
+	}
+	c.hits++
+	c.recent.MoveToFront(element)
+	entry := element.Value.(*audioCacheEntry)
+	// The modification time keeps track of when the song was last played across restarts.
+	now := time.Now()
+	_ = os.Chtimes(entry.path, now, now)
+	return entry.path
+}
+
+// file returns the cached file for the song, or an empty string if the song isn't cached. Unlike Path it doesn't
+// count as the song being played.
+func (c *AudioCache) file(songID string) string {
+	if c == nil {
+		return ""
+	}
+	c.Lock()
+	defer c.Unlock()
+	element, exists := c.entries[songID]
+	if !exists {
+		return ""
+	}
+	return element.Value.(*audioCacheEntry).path
+}
+
+// Tee returns a reader that saves the song into the cache while it's read, so a song that is played is downloaded
+// once for both. The song is only kept if it's read to the end without an error. The audio is returned as is if the
+// song is already cached or being saved, or if songID is empty.
+func (c *AudioCache) Tee(songID string, audio io.ReadCloser) io.ReadCloser {
+	if c == nil || songID == "" {
+		return audio
+	}
+	c.Lock()
+	_, cached := c.entries[songID]
+	_, downloading := c.downloading[songID]
+	if cached || downloading {
+		c.Unlock()
+		return audio
+	}
+	c.downloading[songID] = struct{}{}
+	c.Unlock()
+	path := c.songPath(songID)
+	file, err := os.Create(path + ".part")
+	if err != nil {
+		log.Error().Err(err).Str("song_id", songID).Msg("error caching song")
+		c.finishDownload(songID)
+		return audio
+	}
+	maxFileSize := int64(audioCacheMaxFileSize)
+	if c.maxSizeBytes > 0 && c.maxSizeBytes < maxFileSize {
+		maxFileSize = c.maxSizeBytes
+	}
+	return &audioCacheTee{
+		ReadCloser:  audio,
+		cache:       c,
+		songID:      songID,
+		path:        path,
+		file:        file,
+		maxFileSize: maxFileSize,
+		Mutex:       &sync.Mutex{},
+	}
+}
+
+func (c *AudioCache) finishDownload(songID string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.downloading, songID)
+}
+
+// add puts a song that was saved to path into the cache.
+func (c *AudioCache) add(songID, path string, size int64) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[songID] = c.recent.PushFront(&audioCacheEntry{songID: songID, path: path, size: size})
+	c.sizeBytes += size
+	c.evict()
+}
+
+// audioCacheTee copies a song into a partial cache file as it's read. The file is dropped if the song is too big for
+// the cache or can't be written.
+type audioCacheTee struct {
+	io.ReadCloser
+	cache       *AudioCache
+	songID      string
+	path        string
+	file        *os.File
+	size        int64
+	maxFileSize int64
+	eof         bool
+	*sync.Mutex
+}
+
+func (t *audioCacheTee) Read(p []byte) (int, error) {
+	n, err := t.ReadCloser.Read(p)
+	t.Lock()
+	defer t.Unlock()
+	if n > 0 && t.file != nil {
+		t.size += int64(n)
+		if t.size > t.maxFileSize {
+			t.drop()
+		} else if _, errWrite := t.file.Write(p[:n]); errWrite != nil {
+			log.Error().Err(errWrite).Str("song_id", t.songID).Msg("error caching song")
+			t.drop()
+		}
+	}
+	if errors.Is(err, io.EOF) {
+		t.eof = true
+	}
+	return n, err
+}
+
+// Close closes the song and keeps the cache file if all of the song was read.
+func (t *audioCacheTee) Close() error {
+	err := t.ReadCloser.Close()
+	t.Lock()
+	defer t.Unlock()
+	defer t.cache.finishDownload(t.songID)
+	if t.file == nil {
+		return err
+	}
+	if !t.eof || err != nil {
+		t.drop()
+		return err
+	}
+	partPath := t.file.Name()
+	errStore := t.file.Close()
+	t.file = nil
+	if errStore == nil {
+		errStore = os.Rename(partPath, t.path)
+	}
+	if errStore != nil {
+		log.Error().Err(errStore).Str("song_id", t.songID).Msg("error caching song")
+		_ = os.Remove(partPath)
+		return err
+	}
+	t.cache.add(t.songID, t.path, t.size)
+	return err
+}
+
+// drop removes the partial cache file.
+func (t *audioCacheTee) drop() {
+	if t.file == nil {
+		return
+	}
+	_ = t.file.Close()
+	_ = os.Remove(t.file.Name())
+	t.file = nil
+}
+
+// evict removes the least recently played songs until the cache fits in its maximum size.
+func (c *AudioCache) evict() {
+	if c.maxSizeBytes <= 0 {
+		return
+	}
+	for c.sizeBytes > c.maxSizeBytes && c.recent.Len() > 0 {
+		c.removeElement(c.recent.Back())
+	}
+}
+
+func (c *AudioCache) removeElement(element *list.Element) {
+	entry := element.Value.(*audioCacheEntry)
+	err := os.Remove(entry.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Error().Err(err).Str("path", entry.path).Msg("error removing cached song")
+	}
+	c.recent.Remove(element)
+	delete(c.entries, entry.songID)
+	c.sizeBytes -= entry.size
+}
+
+// Remove deletes the song from the cache. It reports whether the song was cached.
+func (c *AudioCache) Remove(songID string) bool {
+	if c == nil {
+		return false
+	}
+	c.Lock()
+	defer c.Unlock()
+	element, exists := c.entries[songID]
+	if !exists {
+		return false
+	}
+	c.removeElement(element)
+	return true
+}
+
+// Purge deletes every song in the cache and returns how many songs and bytes were removed.
+func (c *AudioCache) Purge() (int, int64) {
+	if c == nil {
+		return 0, 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	removed, freed := c.recent.Len(), c.sizeBytes
+	for c.recent.Len() > 0 {
+		c.removeElement(c.recent.Back())
+	}
+	return removed, freed
+}
+
+// Stats returns the size of the cache and how often songs were found in it.
+func (c *AudioCache) Stats() AudioCacheStats {
+	if c == nil {
+		return AudioCacheStats{}
+	}
+	c.Lock()
+	defer c.Unlock()
+	return AudioCacheStats{
+		Entries:      c.recent.Len(),
+		SizeBytes:    c.sizeBytes,
+		MaxSizeBytes: c.maxSizeBytes,
+		Hits:         c.hits,
+		Misses:       c.misses,
+		Downloading:  len(c.downloading),
+	}
+}
@@ -0,0 +1,67 @@
+package music
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestAudioCacheTee(t *testing.T) {
+	audio := bytes.Repeat([]byte("song"), 1024)
+	tests := []struct {
+		name   string
+		read   int
+		cached bool
+	}{
+		{name: "songs read to the end are kept", read: len(audio), cached: true},
+		{name: "songs stopped early are dropped", read: len(audio) / 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cache, err := NewAudioCache(t.TempDir(), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tee := cache.Tee("song", io.NopCloser(bytes.NewReader(audio)))
+			read, err := io.ReadAll(io.LimitReader(tee, int64(test.read)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(read, audio[:test.read]) {
+				t.Fatal("tee changed the audio")
+			}
+			// The end of the song is only seen by reading past it.
+			if test.read == len(audio) {
+				_, _ = tee.Read(make([]byte, 1))
+			}
+			if err = tee.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			path := cache.Path("song")
+			if (path != "") != test.cached {
+				t.Fatalf("cached path = %q, want cached %v", path, test.cached)
+			}
+			if test.cached {
+				saved, errRead := os.ReadFile(path)
+				if errRead != nil {
+					t.Fatal(errRead)
+				}
+				if !bytes.Equal(saved, audio) {
+					t.Error("cached song doesn't match the audio")
+				}
+			}
+			entries, err := os.ReadDir(cache.directory)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != len(cache.entries) {
+				t.Errorf("cache directory has %d files, want %d", len(entries), len(cache.entries))
+			}
+			if stats := cache.Stats(); stats.Downloading != 0 {
+				t.Errorf("%d songs still downloading after the tee closed", stats.Downloading)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package music
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AudioFilter is an ffmpeg audio filter preset applied to songs as they're encoded. It's saved as the preset name. The
+// equalizer preset also saves its band gains after a colon, like eq:3,0,0,-2,4.
+type AudioFilter string
+
+const (
+	FilterOff       AudioFilter = ""
+	FilterBassBoost AudioFilter = "bassboost"
+	FilterNightcore AudioFilter = "nightcore"
+	FilterVaporwave AudioFilter = "vaporwave"
+	FilterSpeed     AudioFilter = "speed"
+	FilterLoudnorm  AudioFilter = "loudnorm"
+	FilterMono      AudioFilter = "mono"
+	filterEqualizer AudioFilter = "eq"
+
+	// MaxEqualizerGain is the most an equalizer band can be boosted or cut, in dB.
+	MaxEqualizerGain = 12
+)
+
+var ErrInvalidAudioFilter = errors.New("invalid audio filter")
+
+// EqualizerBands are the center frequencies in Hz of the equalizer preset's bands.
+var EqualizerBands = []int{60, 230, 910, 3600, 14000}
+
+// FilterPresets are the presets that don't take any settings.
+var FilterPresets = []AudioFilter{FilterBassBoost, FilterNightcore, FilterVaporwave, FilterSpeed, FilterLoudnorm,
+	FilterMono}
+
+// ParseAudioFilter parses a preset name. The equalizer preset takes a gain in dB for each of the EqualizerBands, and
+// bands without a gain are left flat.
+func ParseAudioFilter(preset string, gains []string) (AudioFilter, error) {
+	switch strings.ToLower(preset) {
+	case "off", "none", "reset":
+		return FilterOff, nil
+	case "bassboost", "bass":
+		return FilterBassBoost, nil
+	case "nightcore":
+		return FilterNightcore, nil
+	case "vaporwave":
+		return FilterVaporwave, nil
+	case "speed", "fast":
+		return FilterSpeed, nil
+	case "loudnorm", "normalize":
+		return FilterLoudnorm, nil
+	case "mono":
+		return FilterMono, nil
+	case "eq", "equalizer":
+		if len(gains) == 0 || len(gains) > len(EqualizerBands) {
+			return FilterOff, ErrInvalidAudioFilter
+		}
+		parsed := make([]string, len(EqualizerBands))
+		for index := range parsed {
+			parsed[index] = "0"
+		}
+		for index, gain := range gains {
+			value, err := strconv.ParseFloat(strings.TrimSuffix(strings.ToLower(gain), "db"), 64)
+			if err != nil || value < -MaxEqualizerGain || value > MaxEqualizerGain {
+				return FilterOff, ErrInvalidAudioFilter
+			}
+			parsed[index] = strconv.FormatFloat(value, 'f', -1, 64)
+		}
+		return filterEqualizer + AudioFilter(":"+strings.Join(parsed, ",")), nil
+	}
+	return FilterOff, ErrInvalidAudioFilter
+}
+
+// equalizerGains returns the band gains of the equalizer preset, or false if the filter isn't a valid equalizer.
+func (f AudioFilter) equalizerGains() ([]float64, bool) {
+	preset, settings, found := strings.Cut(string(f), ":")
+	if !found || AudioFilter(preset) != filterEqualizer {
+		return nil, false
+	}
+	values := strings.Split(settings, ",")
+	if len(values) != len(EqualizerBands) {
+		return nil, false
+	}
+	gains := make([]float64, len(values))
+	for index, value := range values {
+		gain, err := strconv.ParseFloat(value, 64)
+		if err != nil || gain < -MaxEqualizerGain || gain > MaxEqualizerGain {
+			return nil, false
+		}
+		gains[index] = gain
+	}
+	return gains, true
+}
+
+// Valid reports whether the filter is one ParseAudioFilter can return.
+func (f AudioFilter) Valid() bool {
+	if f == FilterOff {
+		return true
+	}
+	for _, preset := range FilterPresets {
+		if f == preset {
+			return true
+		}
+	}
+	_, ok := f.equalizerGains()
+	return ok
+}
+
+// FFmpeg returns the ffmpeg audio filter graph for the preset. Speed changes resample to 48 kHz first so they change
+// the speed by the same amount whatever the song's sample rate is.
+func (f AudioFilter) FFmpeg() string {
+	switch f {
+	case FilterBassBoost:
+		return "bass=g=8:f=110:w=0.6"
+	case FilterNightcore:
+		return "aresample=48000,asetrate=60000,aresample=48000"
+	case FilterVaporwave:
+		return "aresample=48000,asetrate=38400,aresample=48000"
+	case FilterSpeed:
+		return "atempo=1.25"
+	case FilterLoudnorm:
+		return "loudnorm=I=-16:TP=-1.5:LRA=11"
+	case FilterMono:
+		return "pan=stereo|c0=0.5*c0+0.5*c1|c1=0.5*c0+0.5*c1"
+	}
+	gains, ok := f.equalizerGains()
+	if !ok {
+		return ""
+	}
+	var bands []string
+	for index, gain := range gains {
+		if gain != 0 {
+			bands = append(bands, fmt.Sprintf("equalizer=f=%d:t=o:w=1:g=%s", EqualizerBands[index],
+				strconv.FormatFloat(gain, 'f', -1, 64)))
+		}
+	}
+	return strings.Join(bands, ",")
+}
+
+// Speed returns how much faster than normal the preset plays songs.
+func (f AudioFilter) Speed() float64 {
+	switch f {
+	case FilterNightcore, FilterSpeed:
+		return 1.25
+	case FilterVaporwave:
+		return 0.8
+	}
+	return 1
+}
+
+// FriendlyName returns a name for the filter to show in messages.
+func (f AudioFilter) FriendlyName() string {
+	switch f {
+	case FilterOff:
+		return "Off"
+	case FilterBassBoost:
+		return "Bass boost"
+	case FilterNightcore:
+		return "Nightcore"
+	case FilterVaporwave:
+		return "Vaporwave"
+	case FilterSpeed:
+		return "Speed (1.25x)"
+	case FilterLoudnorm:
+		return "Loudness normalization"
+	case FilterMono:
+		return "Mono"
+	}
+	gains, ok := f.equalizerGains()
+	if !ok {
+		return "Off"
+	}
+	bands := make([]string, len(gains))
+	for index, gain := range gains {
+		bands[index] = fmt.Sprintf("%d Hz %+g dB", EqualizerBands[index], gain)
+	}
+	return "Equalizer: " + strings.Join(bands, ", ")
+}
@@ -0,0 +1,281 @@
+package music
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"thalassa_discord/models"
+)
+
+// librarySearchLimit is the most songs a local: playlist queues.
+const librarySearchLimit = 100
+
+// LibraryScan describes the result of scanning the music library.
+type LibraryScan struct {
+	Finished time.Time
+	Songs    int
+	Added    int
+	Updated  int
+	Removed  int
+}
+
+// Library indexes the audio files in the local music directories into the song table so they can be searched, and
+// plays search results with local:<search> links. Files are played with the file source.
+type Library struct {
+	files    *FileSource
+	db       *sql.DB
+	lastScan LibraryScan
+	scanning *sync.Mutex
+	*sync.RWMutex
+}
+
+func NewLibrary(db *sql.DB, files *FileSource) *Library {
+	return &Library{files: files, db: db, scanning: &sync.Mutex{}, RWMutex: &sync.RWMutex{}}
+}
+
+func (*Library) Name() string {
+	return "library"
+}
+
+// LastScan returns the result of the latest scan. It's empty until the first scan finishes.
+func (l *Library) LastScan() LibraryScan {
+	l.RLock()
+	defer l.RUnlock()
+	return l.lastScan
+}
+
+// Watch scans the library straight away and then every interval until ctx is cancelled. The library is polled
+// rather than watched with file system events, which aren't sent for changes made to network shares by other
+// machines.
+func (l *Library) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		scan, err := l.Scan(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error().Err(err).Msg("error scanning music library")
+		} else if scan.Added > 0 || scan.Updated > 0 || scan.Removed > 0 {
+			log.Info().Int("added", scan.Added).Int("updated", scan.Updated).Int("removed", scan.Removed).
+				Msg("Scanned music library")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan indexes new and changed files in the library, and hides songs whose files are gone. Files that haven't
+// changed since the last scan aren't read again.
+func (l *Library) Scan(ctx context.Context) (LibraryScan, error) {
+	l.scanning.Lock()
+	defer l.scanning.Unlock()
+
+	indexed, err := models.Songs(
+		qm.Select(models.SongColumns.ID, models.SongColumns.LibraryModifiedAt),
+		qm.Where("library_modified_at is not null"),
+	).All(ctx, l.db)
+	if err != nil {
+		return LibraryScan{}, err
+	}
+	modifiedAt := make(map[string]time.Time, len(indexed))
+	for _, song := range indexed {
+		modifiedAt[song.ID] = song.LibraryModifiedAt.Time
+	}
+
+	scan := LibraryScan{}
+	seen := make(map[string]struct{})
+	for _, root := range l.files.roots {
+		resolvedRoot, errRoot := filepath.EvalSymlinks(root)
+		if errRoot != nil {
+			log.Error().Err(errRoot).Str("directory", root).Msg("error opening music library directory")
+			continue
+		}
+		errWalk := filepath.WalkDir(resolvedRoot, func(filePath string, entry fs.DirEntry, errWalk error) error {
+			if errWalk != nil {
+				// Skip folders that can't be read rather than giving up on the whole library.
+				log.Error().Err(errWalk).Str("path", filePath).Msg("error reading music library")
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if _, audio := audioFileExtensions[strings.ToLower(filepath.Ext(filePath))]; !audio || entry.IsDir() {
+				return nil
+			}
+			return l.indexFile(ctx, filePath, entry, modifiedAt, seen, &scan)
+		})
+		if errWalk != nil {
+			return scan, errWalk
+		}
+	}
+
+	var removed []interface{}
+	for songID := range modifiedAt {
+		if _, exists := seen[songID]; !exists {
+			removed = append(removed, songID)
+		}
+	}
+	if len(removed) > 0 {
+		_, err = models.Songs(qm.WhereIn("id in ?", removed...)).UpdateAll(ctx, l.db,
+			models.M{models.SongColumns.LibraryModifiedAt: nil})
+		if err != nil {
+			return scan, err
+		}
+		scan.Removed = len(removed)
+	}
+
+	scan.Finished = time.Now()
+	l.Lock()
+	l.lastScan = scan
+	l.Unlock()
+	return scan, nil
+}
+
+func (l *Library) indexFile(ctx context.Context, filePath string, entry fs.DirEntry,
+	modifiedAt map[string]time.Time, seen map[string]struct{}, scan *LibraryScan,
+) error {
+	link, err := l.files.fileLink(filePath)
+	if err != nil {
+		return nil
+	}
+	// The first library directory with the file wins, like when it's played.
+	if _, exists := seen[link]; exists {
+		return nil
+	}
+	seen[link] = struct{}{}
+	scan.Songs++
+
+	info, err := entry.Info()
+	if err != nil {
+		return nil
+	}
+	// Postgres keeps microseconds.
+	modified := info.ModTime().UTC().Truncate(time.Microsecond)
+	previous, indexed := modifiedAt[link]
+	if indexed && previous.Equal(modified) {
+		return nil
+	}
+
+	songInfo, err := l.files.fileSongInfo(ctx, filePath)
+	if err != nil {
+		log.Error().Err(err).Str("path", filePath).Msg("error reading music library song")
+		return nil
+	}
+	song := SongModel(songInfo)
+	song.LibraryModifiedAt = null.TimeFrom(modified)
+	// Updating every column clears the loudness of a changed file, so it's measured again.
+	err = song.Upsert(ctx, l.db, true, []string{models.SongColumns.ID}, boil.Infer(), boil.Infer())
+	if err != nil {
+		return err
+	}
+	if indexed {
+		scan.Updated++
+	} else {
+		scan.Added++
+	}
+	return nil
+}
+
+// Search finds library songs with every word of the query in their title, artist or album. Songs titled exactly
+// like the query come first.
+func (l *Library) Search(ctx context.Context, query string, limit int) (models.SongSlice, error) {
+	mods := []qm.QueryMod{
+		qm.Where("library_modified_at is not null"),
+	}
+	for _, word := range strings.Fields(query) {
+		pattern := "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(word) + "%"
+		mods = append(mods, qm.And("(song_name ilike ? or artist ilike ? or album ilike ?)",
+			pattern, pattern, pattern))
+	}
+	mods = append(mods,
+		qm.OrderBy("lower(song_name) = lower(?) desc", strings.TrimSpace(query)),
+		qm.OrderBy("artist asc nulls last"),
+		qm.OrderBy("album asc nulls last"),
+		qm.OrderBy("song_name asc"),
+	)
+	if limit > 0 {
+		mods = append(mods, qm.Limit(limit))
+	}
+	return models.Songs(mods...).All(ctx, l.db)
+}
+
+// libraryQuery returns the search in a local: link.
+func libraryQuery(link string) (string, error) {
+	if !strings.HasPrefix(link, "local:") {
+		return "", ErrUnsupportedLink
+	}
+	query := strings.TrimSpace(strings.TrimPrefix(link, "local:"))
+	if query == "" {
+		return "", errors.New("you must say what to search the music library for")
+	}
+	return query, nil
+}
+
+// SongInfo returns the best match in the library for a local:<search> link.
+func (l *Library) SongInfo(ctx context.Context, link string) (*Song, error) {
+	query, err := libraryQuery(link)
+	if err != nil {
+		return nil, err
+	}
+	songs, err := l.Search(ctx, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(songs) == 0 {
+		return nil, fmt.Errorf("no songs in the music library match %s", query)
+	}
+	return SongFromModel(songs[0]), nil
+}
+
+// PlaylistInfo returns every match in the library for a local:<search> link, like every song on an album.
+func (l *Library) PlaylistInfo(ctx context.Context, link string, shuffle bool) ([]*Song, error) {
+	query, err := libraryQuery(link)
+	if err != nil {
+		return nil, err
+	}
+	songs, err := l.Search(ctx, query, librarySearchLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(songs) == 0 {
+		return nil, fmt.Errorf("no songs in the music library match %s", query)
+	}
+	var playlistSongs []*Song
+	for _, song := range songs {
+		playlistSongs = append(playlistSongs, SongFromModel(song))
+	}
+	if shuffle {
+		rand.Shuffle(len(playlistSongs), func(i, j int) {
+			playlistSongs[i], playlistSongs[j] = playlistSongs[j], playlistSongs[i]
+		})
+	}
+	return playlistSongs, nil
+}
+
+// Open opens the best match in the library for a local:<search> link.
+func (l *Library) Open(ctx context.Context, link string) (io.ReadCloser, error) {
+	songInfo, err := l.SongInfo(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	return l.files.Open(ctx, songInfo.WebpageURL)
+}
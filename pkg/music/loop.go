@@ -0,0 +1,43 @@
+package music
+
+import (
+	"errors"
+	"strings"
+)
+
+// LoopMode is what happens to a song request after it finishes playing.
+type LoopMode string
+
+const (
+	LoopOff   LoopMode = "off"   // Songs are played once
+	LoopTrack LoopMode = "track" // The current song repeats until it is skipped
+	LoopQueue LoopMode = "queue" // Finished songs are added back to the end of the queue
+)
+
+var ErrInvalidLoopMode = errors.New("invalid loop mode")
+
+// ParseLoopMode parses a loop mode name. It also accepts a few common aliases like "song" and "all".
+func ParseLoopMode(mode string) (LoopMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "off", "none", "disable", "disabled":
+		return LoopOff, nil
+	case "track", "song", "one", "single":
+		return LoopTrack, nil
+	case "queue", "all", "playlist":
+		return LoopQueue, nil
+	default:
+		return LoopOff, ErrInvalidLoopMode
+	}
+}
+
+// FriendlyName returns a name for the loop mode to show in messages.
+func (m LoopMode) FriendlyName() string {
+	switch m {
+	case LoopTrack:
+		return "Repeating the current song"
+	case LoopQueue:
+		return "Looping the queue"
+	default:
+		return "Off"
+	}
+}
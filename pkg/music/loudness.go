@@ -0,0 +1,132 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// LoudnessTarget is the integrated loudness songs are normalized to, in LUFS.
+	LoudnessTarget = -14.0
+	// maxLoudnessBoost and maxLoudnessCut limit the gain in dB so quiet songs aren't boosted into clipping and
+	// mismeasured songs aren't made silent.
+	maxLoudnessBoost = 6.0
+	maxLoudnessCut   = -15.0
+	// silentLoudness is the loudness ebur128 reports for songs with nothing to measure.
+	silentLoudness = -70.0
+	// maxLoudnessMeasurements is how many songs can be measured at the same time.
+	maxLoudnessMeasurements = 2
+)
+
+var (
+	ErrLoudnessMeasuring   = errors.New("song is already being measured")
+	ErrLoudnessNotCached   = errors.New("song isn't in the audio cache yet")
+	ErrLoudnessNotMeasured = errors.New("song loudness couldn't be measured")
+)
+
+// integratedLoudness finds the integrated loudness in the summary ebur128 prints when it finishes.
+var integratedLoudness = regexp.MustCompile(`I:\s+(-?[0-9.]+) LUFS`)
+
+var loudnessMeasurements = struct {
+	measuring map[string]struct{}
+	slots     chan struct{}
+	*sync.Mutex
+}{
+	measuring: make(map[string]struct{}),
+	slots:     make(chan struct{}, maxLoudnessMeasurements),
+	Mutex:     &sync.Mutex{},
+}
+
+// MeasureLoudness measures the song's integrated loudness in LUFS with an EBU R128 pass over its decoded audio. The
+// song's cached file or the source's own file is read if there is one. Otherwise the song is only downloaded from its
+// source when there is no audio cache. With a cache it returns ErrLoudnessNotCached, since the cache saves the song
+// the first time it plays. Measurements wait for their turn when too many songs are being measured, and it returns
+// ErrLoudnessMeasuring if the song is already being measured.
+func MeasureLoudness(ctx context.Context, source Source, songID, link string, cache *AudioCache) (float64, error) {
+	cachedPath := cache.file(songID)
+	if cachedPath == "" {
+		if files, ok := source.(fileSource); ok {
+			path, err := files.FilePath(link)
+			if err != nil {
+				return 0, err
+			}
+			cachedPath = path
+		}
+	}
+	if cachedPath == "" && cache != nil {
+		return 0, ErrLoudnessNotCached
+	}
+
+	loudnessMeasurements.Lock()
+	if _, measuring := loudnessMeasurements.measuring[songID]; measuring {
+		loudnessMeasurements.Unlock()
+		return 0, ErrLoudnessMeasuring
+	}
+	loudnessMeasurements.measuring[songID] = struct{}{}
+	loudnessMeasurements.Unlock()
+	defer func() {
+		loudnessMeasurements.Lock()
+		delete(loudnessMeasurements.measuring, songID)
+		loudnessMeasurements.Unlock()
+	}()
+	select {
+	case loudnessMeasurements.slots <- struct{}{}:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	defer func() {
+		<-loudnessMeasurements.slots
+	}()
+
+	measureCtx, measureCtxCancel := context.WithTimeout(ctx, time.Minute*10)
+	defer measureCtxCancel()
+	input := cachedPath
+	if input == "" {
+		input = "pipe:0"
+	}
+	cmd := exec.CommandContext(measureCtx, "ffmpeg", "-hide_banner", "-nostats", "-i", input, "-vn",
+		"-af", "ebur128=framelog=quiet", "-f", "null", "-")
+	if cachedPath == "" {
+		audio, err := source.Open(measureCtx, link)
+		if err != nil {
+			return 0, err
+		}
+		defer audio.Close()
+		cmd.Stdin = audio
+	}
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return 0, err
+	}
+	matches := integratedLoudness.FindAllSubmatch(output, -1)
+	if len(matches) == 0 {
+		return 0, ErrLoudnessNotMeasured
+	}
+	lufs, err := strconv.ParseFloat(string(matches[len(matches)-1][1]), 64)
+	if err != nil || lufs <= silentLoudness {
+		return 0, ErrLoudnessNotMeasured
+	}
+	return lufs, nil
+}
+
+// LoudnessGain returns the gain in dB that brings a song with the integrated loudness to the LoudnessTarget.
+func LoudnessGain(lufs float64) float64 {
+	return math.Max(maxLoudnessCut, math.Min(maxLoudnessBoost, LoudnessTarget-lufs))
+}
+
+// GainMultiplier turns a song's saved gain in dB into a volume multiplier. Songs that haven't been measured play at
+// their original volume.
+func GainMultiplier(gainDB null.Float32) float32 {
+	if !gainDB.Valid {
+		return 1
+	}
+	return float32(math.Pow(10, float64(gainDB.Float32)/20))
+}
@@ -1,33 +1,36 @@
 package music
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
-	"math"
-	"os"
-	"os/exec"
-	"strings"
 	"time"
 
 	"github.com/ClintonCollins/dca"
 	"github.com/bwmarrin/discordgo"
 	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
 
 	"thalassa_discord/models"
 )
 
+// ErrNoAudio is returned when a song ends before any of its audio was played.
+var ErrNoAudio = errors.New("song ended without playing any audio")
+
 type SongQueueEventType int
 
 const (
-	SongAdded      SongQueueEventType = iota // Song has been added to the queue
-	SongPlaying                              // Song is currently playing
-	SongFinished                             // Song has finished playing
-	SongSkipped                              // Song has been skipped
-	SongSkippedAll                           // All songs have been skipped
+	SongAdded         SongQueueEventType = iota // Song has been added to the queue
+	SongPlaying                                 // Song is currently playing
+	SongFinished                                // Song has finished playing
+	SongSkipped                                 // Song has been skipped
+	SongSkippedAll                              // All songs have been skipped
+	SongRemoved                                 // Song has been removed from the queue
+	SongMoved                                   // Song has been moved to a different position in the queue
+	SongQueueShuffled                           // The queue has been shuffled
+	SongPaused                                  // Song has been paused
+	SongResumed                                 // Song has been resumed
+	SongSeeked                                  // Song has been restarted at a new position
 )
 
 type SongQueueEvent struct {
@@ -37,23 +40,40 @@ type SongQueueEvent struct {
 	GuildID     string              `json:"guild_id"`
 }
 
-func StreamSong(ctx context.Context, link string, log zerolog.Logger, vc *discordgo.VoiceConnection, volume float32) {
-	options := dca.StdEncodeOptions
+// encodeOptions returns the encoder settings for a stream starting at startOffset with the volume multiplier.
+func encodeOptions(startOffset time.Duration, volume float32, filter AudioFilter) *dca.EncodeOptions {
+	// Copy the standard options so changes don't leak into other guilds streaming at the same time.
+	options := *dca.StdEncodeOptions
 	options.RawOutput = true
 	options.BufferedFrames = 100
 	options.FrameDuration = 20
 	options.CompressionLevel = 10
 	options.Bitrate = 384
-	options.Volume = int(math.Round(float64(volume)))
-	log.Debug().Msgf("Streaming song %s", link)
+	// The encoder's volume is a whole number multiplier, so it's left at 1 and the volume is set with a filter.
+	options.Volume = 1
+	options.StartTime = int(startOffset.Seconds())
+	options.AudioFilter = fmt.Sprintf("volume=%.2f", volume)
+	if filterGraph := filter.FFmpeg(); filterGraph != "" {
+		options.AudioFilter += "," + filterGraph
+	}
+	return &options
+}
 
-	sCtx, sCtxCancel := context.WithCancel(ctx)
-	defer sCtxCancel()
+// StreamSong streams the song to the voice connection until it finishes or ctx is cancelled. If cachedPath is set the
+// song is read from the audio cache instead of being downloaded, otherwise it's saved to the cache as cacheSongID while
+// it downloads. A prefetch of the song is used for the first stream
+// if it was started with the same offset, volume and filter, otherwise it's closed. It returns an error if the song
+// couldn't be played, including when it ends without any audio, and nil when it finishes or is stopped.
+func StreamSong(ctx context.Context, source Source, link, cachedPath string, cache *AudioCache, cacheSongID string,
+	prefetch *Prefetch, log zerolog.Logger, vc *discordgo.VoiceConnection, playback *Playback,
+) error {
+	defer prefetch.Close()
+	log.Debug().Msgf("Streaming song %s", link)
 
 	errSpeaking := vc.Speaking(true)
 	if errSpeaking != nil {
 		log.Error().Err(errSpeaking).Msg("error setting speaking to true")
-		return
+		return errSpeaking
 	}
 
 	defer func() {
@@ -69,161 +89,145 @@ func StreamSong(ctx context.Context, link string, log zerolog.Logger, vc *discor
 		}
 	}()
 
-	ytdlArgs := []string{
-		"--no-progress",
-		"--no-call-home",
-		"--default-search", "ytsearch",
-		"--no-playlist",
-		"--no-mtime",
-		"--no-warnings",
-		"-o", "-",
-		"--format",
-		"bestaudio*/best",
-		"--prefer-ffmpeg",
-		"--quiet",
-		link,
+	// The pipeline is restarted at the new offset every time the song is seeked.
+	var err error
+	for {
+		sCtx, sCtxCancel := context.WithCancel(ctx)
+		startOffset, volume, filter := playback.startStream(sCtxCancel)
+		p := prefetch.take(startOffset, volume, filter)
+		if p != nil {
+			log.Debug().Msgf("Using prefetched song %s", link)
+		} else {
+			p, err = startPipeline(sCtx, source, link, cachedPath, cache, cacheSongID,
+				encodeOptions(startOffset, volume, filter))
+			if err != nil {
+				log.Error().Err(err).Msg("error starting song pipeline")
+				sCtxCancel()
+				break
+			}
+		}
+		err = p.stream(sCtx, log, vc, playback)
+		sCtxCancel()
+		if err != nil || ctx.Err() != nil || !playback.takeSeek() {
+			break
+		}
+		log.Debug().Msgf("Seeking song %s", link)
 	}
-	cmd := exec.CommandContext(sCtx, "yt-dlp", ytdlArgs...)
+	log.Debug().Msg("song finished streaming")
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
 
-	ytdl, err := cmd.StdoutPipe()
-	if err != nil {
-		log.Error().Err(err).Msg("error getting yt-dlp stdout")
+// encoder turns a song into Opus frames. Outside of tests it's a *dca.EncodeSession running ffmpeg.
+type encoder interface {
+	dca.OpusReader
+	Error() error
+	FFMPEGMessages() string
+	Cleanup()
+}
+
+// encodeMem and encodeFile start encoding a song read from its source or from a file. Tests replace them to stream
+// songs without ffmpeg.
+var (
+	encodeMem = func(audio io.Reader, options *dca.EncodeOptions) (encoder, error) {
+		return dca.EncodeMem(audio, options)
 	}
-	cmd.Stderr = os.Stderr
+	encodeFile = func(path string, options *dca.EncodeOptions) (encoder, error) {
+		return dca.EncodeFile(path, options)
+	}
+)
 
-	skipped := false
+// pipeline is a song being read from its source, or from the audio cache, and encoded by ffmpeg.
+type pipeline struct {
+	encoding encoder
+	audio    io.ReadCloser
+	cancel   context.CancelFunc
+}
 
-	// Add defer to make sure cleanup is always called.
-	defer func(ytCmd *exec.Cmd, ytStdout io.ReadCloser, skippedSong *bool) {
-		// Wait for yt-dlp to finish.
-		errWait := ytCmd.Wait()
-		if errWait != nil && !errors.Is(errWait, context.Canceled) && !skipped {
-			log.Error().Err(errWait).Msg("error waiting for yt-dlp")
+// startPipeline starts reading and encoding the song. The encoder buffers a few seconds of audio ahead of the stream.
+// Songs downloaded from their source are saved to the cache as cacheSongID, unless it's empty.
+func startPipeline(ctx context.Context, source Source, link, cachedPath string, cache *AudioCache, cacheSongID string,
+	options *dca.EncodeOptions,
+) (*pipeline, error) {
+	pCtx, pCtxCancel := context.WithCancel(ctx)
+	if cachedPath == "" {
+		if files, ok := source.(fileSource); ok {
+			path, err := files.FilePath(link)
+			if err != nil {
+				pCtxCancel()
+				return nil, err
+			}
+			cachedPath = path
+		}
+	}
+	if cachedPath != "" {
+		encoding, errEncode := encodeFile(cachedPath, options)
+		if errEncode != nil {
+			pCtxCancel()
+			return nil, errEncode
 		}
-		// Drain the yt-dlp buffer. This shouldn't need to be drained.
-		_, _ = io.Copy(io.Discard, ytStdout)
-		// Close yt-dlp stdout. This shouldn't need to be closed.
-		_ = ytStdout.Close()
-	}(cmd, ytdl, &skipped)
+		return &pipeline{encoding: encoding, cancel: pCtxCancel}, nil
+	}
 
-	err = cmd.Start()
+	audio, err := source.Open(pCtx, link)
 	if err != nil {
-		log.Error().Err(err).Msg("error starting yt-dlp")
-		return
+		pCtxCancel()
+		return nil, err
 	}
+	audio = cache.Tee(cacheSongID, audio)
 
 	// Create a new dca encode session.
-	encoding, errEncode := dca.EncodeMem(ytdl, options)
+	encoding, errEncode := encodeMem(audio, options)
 	if errEncode != nil {
-		log.Error().Err(errEncode).Msg("error encoding song")
-		return
+		_ = audio.Close()
+		pCtxCancel()
+		return nil, errEncode
 	}
-	defer encoding.Cleanup()
+	return &pipeline{encoding: encoding, audio: audio, cancel: pCtxCancel}, nil
+}
+
+// stream sends the encoded song to the voice connection until it finishes or ctx is cancelled, then closes the
+// pipeline.
+func (p *pipeline) stream(ctx context.Context, log zerolog.Logger, vc *discordgo.VoiceConnection,
+	playback *Playback,
+) error {
+	defer p.close(log)
 
 	// Setup DCA streaming.
 	streamChan := make(chan error)
-	dca.NewStream(encoding, vc, streamChan)
+	session := dca.NewStream(p.encoding, vc, streamChan)
+	playback.attach(session, vc)
 	select {
 	case <-ctx.Done():
-		skipped = true
-		log.Debug().Msg("song was skipped or program was stopped")
-		return
+		log.Debug().Msg("song was skipped, seeked or program was stopped")
+		return nil
 	case errStream := <-streamChan:
 		if errStream != nil && errStream != io.EOF {
 			log.Error().Err(errStream).Msg("error streaming song")
+			return errStream
 		}
 	}
-	log.Debug().Msg("song finished streaming")
-}
-
-func GetSongInfo(ctx context.Context, url string) (*Song, error) {
-	ytdlCtx, ytdlCtxCancel := context.WithTimeout(ctx, time.Minute*1)
-	defer ytdlCtxCancel()
-	ytdlpArgs := []string{
-		"--dump-json",
-		"--no-playlist",
-		"--no-progress",
-		"--no-warnings",
-		"--default-search",
-		"ytsearch",
-		"--no-call-home",
-		"--skip-download",
-		url,
-	}
-
-	cmd := exec.CommandContext(ytdlCtx, "yt-dlp", ytdlpArgs...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		log.Error().Err(err).Msg("error getting song info")
-		return nil, err
+	// A song that can't be read or decoded reaches the end straight away.
+	if errEncode := p.encoding.Error(); errEncode != nil {
+		log.Error().Err(errEncode).Str("ffmpeg", p.encoding.FFMPEGMessages()).Msg("error encoding song")
+		return errEncode
 	}
-	song := &Song{}
-	errUnmarshal := json.Unmarshal(output, song)
-	if errUnmarshal != nil {
-		log.Error().Err(errUnmarshal).Str("song_data", string(output)).Msg("error unmarshalling song")
-		return nil, errUnmarshal
+	if session.PlaybackPosition() == 0 {
+		return ErrNoAudio
 	}
-	return song, nil
+	return nil
 }
 
-func GetPlaylistInfo(ctx context.Context, url string, shuffle bool) ([]*Song, error) {
-	ytdlCtx, ytdlCtxCancel := context.WithTimeout(ctx, time.Minute*5)
-	defer ytdlCtxCancel()
-	ytdlpArgs := []string{
-		"--dump-json",
-		"--flat-playlist",
-		"--no-progress",
-		"--no-warnings",
-		"--default-search",
-		"ytsearch",
-		"--no-call-home",
-		"--skip-download",
-	}
-	if shuffle {
-		ytdlpArgs = append(ytdlpArgs, "--playlist-random")
-	}
-	ytdlpArgs = append(ytdlpArgs, url)
-
-	cmd := exec.CommandContext(ytdlCtx, "yt-dlp", ytdlpArgs...)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		log.Error().Err(err).Msg("error getting playlist info")
-		return nil, err
-	}
-	var playListSongs []*Song
-	songs := bytes.Split(output, []byte("\n"))
-	for _, song := range songs {
-		s := song
-		if s == nil || len(s) == 0 {
-			continue
+// close stops the encoder and the source.
+func (p *pipeline) close(log zerolog.Logger) {
+	p.encoding.Cleanup()
+	if p.audio != nil {
+		errClose := p.audio.Close()
+		if errClose != nil {
+			log.Error().Err(errClose).Msg("error reading song from its source")
 		}
-		// Ignore hidden videos
-		if strings.Contains(string(s), "unavailable video is hidden") {
-			continue
-		}
-		songInfo := &Song{}
-		errUnmarshal := json.Unmarshal(s, songInfo)
-		if errUnmarshal != nil {
-			log.Error().Err(errUnmarshal).Str("song_data", string(s)).Msg("error unmarshalling song")
-			continue
-		}
-		if (songInfo.Duration == 0 && !songInfo.IsLive) || songInfo.Title == "[Deleted video]" || songInfo.Title == "[Private video]" {
-			log.Info().Fields(map[string]interface{}{
-				"song_title":       songInfo.Title,
-				"song_urls":        songInfo.Urls,
-				"song_is_live":     songInfo.IsLive,
-				"song_duration":    songInfo.Duration,
-				"song_extractor":   songInfo.Extractor,
-				"song_webpage_url": songInfo.WebpageURL,
-			}).Msg("Skipping invalid playlist song")
-			continue
-		}
-		log.Debug().Msgf("Found song in playlist: %s", songInfo.Title)
-		playListSongs = append(playListSongs, songInfo)
-	}
-	if len(playListSongs) == 0 {
-		log.Debug().Msg("No songs found in playlist")
-		return nil, errors.New("no songs found in playlist")
 	}
-	return playListSongs, nil
+	p.cancel()
 }
@@ -0,0 +1,155 @@
+package music
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ClintonCollins/dca"
+	"github.com/bwmarrin/discordgo"
+	"github.com/rs/zerolog"
+)
+
+// fakeFrameSize is how many bytes of a song fakeEncoder puts in each frame.
+const fakeFrameSize = 4
+
+// fakeEncoder stands in for ffmpeg by sending the song's bytes as they are, a few at a time.
+type fakeEncoder struct {
+	audio io.Reader
+}
+
+func (e *fakeEncoder) OpusFrame() ([]byte, error) {
+	frame := make([]byte, fakeFrameSize)
+	n, err := io.ReadFull(e.audio, frame)
+	if n > 0 {
+		return frame[:n], nil
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return nil, err
+}
+
+func (e *fakeEncoder) FrameDuration() time.Duration {
+	return 20 * time.Millisecond
+}
+
+func (e *fakeEncoder) Error() error {
+	return nil
+}
+
+func (e *fakeEncoder) FFMPEGMessages() string {
+	return ""
+}
+
+func (e *fakeEncoder) Cleanup() {
+	if closer, ok := e.audio.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
+// useFakeEncoder encodes songs with fakeEncoder for the test.
+func useFakeEncoder(t *testing.T) {
+	t.Helper()
+	savedMem, savedFile := encodeMem, encodeFile
+	encodeMem = func(audio io.Reader, _ *dca.EncodeOptions) (encoder, error) {
+		return &fakeEncoder{audio: audio}, nil
+	}
+	encodeFile = func(path string, _ *dca.EncodeOptions) (encoder, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return &fakeEncoder{audio: file}, nil
+	}
+	t.Cleanup(func() {
+		encodeMem, encodeFile = savedMem, savedFile
+	})
+}
+
+// streamFromSource plays the song through the pipeline to a voice connection and returns what was sent to it.
+func streamFromSource(source Source, cachedPath string, cache *AudioCache) ([]byte, error) {
+	p, err := startPipeline(context.Background(), source, "https://example.com/song", cachedPath, cache, "song",
+		encodeOptions(0, 1, FilterOff))
+	if err != nil {
+		return nil, err
+	}
+	vc := &discordgo.VoiceConnection{OpusSend: make(chan []byte)}
+	sent := make(chan []byte)
+	go func() {
+		var audio []byte
+		for frame := range vc.OpusSend {
+			audio = append(audio, frame...)
+		}
+		sent <- audio
+	}()
+	err = p.stream(context.Background(), zerolog.Nop(), vc, NewPlayback(0, 1, 1, FilterOff))
+	close(vc.OpusSend)
+	return <-sent, err
+}
+
+func TestStreamFromSource(t *testing.T) {
+	useFakeEncoder(t)
+	audio := bytes.Repeat([]byte("fake audio "), 100)
+	tests := []struct {
+		name    string
+		source  *fakeSource
+		want    []byte
+		wantErr error
+	}{
+		{name: "the song is streamed", source: &fakeSource{name: "fake", supported: true, audio: audio}, want: audio},
+		{name: "a song without audio", source: &fakeSource{name: "fake", supported: true}, wantErr: ErrNoAudio},
+		{
+			name:    "the source fails",
+			source:  &fakeSource{name: "fake", supported: true, err: errFakeSource},
+			wantErr: errFakeSource,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sent, err := streamFromSource(test.source, "", nil)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("stream error = %v, want %v", err, test.wantErr)
+			}
+			if !bytes.Equal(sent, test.want) {
+				t.Errorf("sent %d bytes to the voice connection, want %d", len(sent), len(test.want))
+			}
+		})
+	}
+}
+
+func TestStreamCachesSong(t *testing.T) {
+	useFakeEncoder(t)
+	cache, err := NewAudioCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audio := bytes.Repeat([]byte("fake audio "), 100)
+	source := &fakeSource{name: "fake", supported: true, audio: audio}
+
+	sent, err := streamFromSource(source, "", cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sent, audio) {
+		t.Fatal("the song wasn't streamed while it was cached")
+	}
+	cachedPath := cache.Path("song")
+	if cachedPath == "" {
+		t.Fatal("the streamed song wasn't cached")
+	}
+
+	// The source is no longer needed once the song is cached.
+	source.err = errFakeSource
+	sent, err = streamFromSource(source, cachedPath, cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sent, audio) {
+		t.Error("the cached song doesn't match the streamed one")
+	}
+}
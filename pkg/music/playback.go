@@ -0,0 +1,186 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ClintonCollins/dca"
+	"github.com/bwmarrin/discordgo"
+)
+
+var ErrPlaybackNotStarted = errors.New("playback has not started")
+
+// Playback controls a song while it is being streamed to a voice connection.
+type Playback struct {
+	session        *dca.StreamingSession
+	vc             *discordgo.VoiceConnection
+	streamCancel   context.CancelFunc
+	startOffset    time.Duration
+	volume         float32
+	gain           float32
+	filter         AudioFilter
+	seekTo         *time.Duration
+	paused         bool
+	pausedAt       time.Time
+	pausedDuration time.Duration
+	*sync.Mutex
+}
+
+// NewPlayback creates a playback that starts streaming the song at startOffset with the audio filter. Volume is a
+// multiplier where 1 is the original volume of the song, and gain is a multiplier from GainMultiplier that evens out
+// the song's loudness. They're applied together.
+func NewPlayback(startOffset time.Duration, volume, gain float32, filter AudioFilter) *Playback {
+	return &Playback{startOffset: startOffset, volume: volume, gain: gain, filter: filter, Mutex: &sync.Mutex{}}
+}
+
+// attach connects the playback to the session streaming the song. If the song was paused before the stream started,
+// the stream is paused straight away.
+func (p *Playback) attach(session *dca.StreamingSession, vc *discordgo.VoiceConnection) {
+	p.Lock()
+	defer p.Unlock()
+	p.session = session
+	p.vc = vc
+	if p.paused {
+		session.SetPaused(true)
+	}
+}
+
+// SetPaused pauses or resumes sending audio to the voice connection. The encoding pipeline is left running and
+// will block until playback is resumed. It returns false if the playback was already in the requested state.
+func (p *Playback) SetPaused(paused bool) bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.paused == paused {
+		return false
+	}
+	p.paused = paused
+	if paused {
+		p.pausedAt = time.Now()
+	} else {
+		p.pausedDuration += time.Since(p.pausedAt)
+		p.pausedAt = time.Time{}
+	}
+	if p.session != nil {
+		p.session.SetPaused(paused)
+	}
+	if p.vc != nil {
+		_ = p.vc.Speaking(!paused)
+	}
+	return true
+}
+
+// Paused reports whether the playback is paused.
+func (p *Playback) Paused() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.paused
+}
+
+// PausedDuration returns the total time the playback has spent paused, including the current pause.
+func (p *Playback) PausedDuration() time.Duration {
+	p.Lock()
+	defer p.Unlock()
+	if p.paused {
+		return p.pausedDuration + time.Since(p.pausedAt)
+	}
+	return p.pausedDuration
+}
+
+// Position returns how far into the song playback is.
+func (p *Playback) Position() time.Duration {
+	p.Lock()
+	defer p.Unlock()
+	return p.position()
+}
+
+func (p *Playback) position() time.Duration {
+	if p.session == nil {
+		return p.startOffset
+	}
+	// Filters that change the speed play more or less of the song than the time that has passed.
+	return p.startOffset + time.Duration(float64(p.session.PlaybackPosition())*p.filter.Speed())
+}
+
+// SetVolume changes the volume of the song. ffmpeg can't change the volume of a running stream, so the stream is
+// restarted at the current position.
+func (p *Playback) SetVolume(volume float32) {
+	p.Lock()
+	defer p.Unlock()
+	if p.volume == volume {
+		return
+	}
+	p.volume = volume
+	if p.streamCancel == nil {
+		return
+	}
+	position := p.position()
+	p.seekTo = &position
+	p.streamCancel()
+}
+
+// Filter returns the audio filter the song is playing with.
+func (p *Playback) Filter() AudioFilter {
+	p.Lock()
+	defer p.Unlock()
+	return p.filter
+}
+
+// SetFilter changes the audio filter of the song. Like the volume, the stream is restarted at the current position.
+func (p *Playback) SetFilter(filter AudioFilter) {
+	p.Lock()
+	defer p.Unlock()
+	if p.filter == filter {
+		return
+	}
+	// The position is worked out at the old speed before the filter changes.
+	position := p.position()
+	p.filter = filter
+	if p.streamCancel == nil {
+		return
+	}
+	p.seekTo = &position
+	p.streamCancel()
+}
+
+// Seek restarts the stream at the offset. Pause time is reset because the position is known exactly again.
+func (p *Playback) Seek(offset time.Duration) error {
+	p.Lock()
+	defer p.Unlock()
+	if p.streamCancel == nil {
+		return ErrPlaybackNotStarted
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	p.seekTo = &offset
+	p.streamCancel()
+	return nil
+}
+
+// startStream records the function that stops the next stream and returns the offset, volume and filter it starts
+// with. The volume includes the song's gain.
+func (p *Playback) startStream(cancel context.CancelFunc) (time.Duration, float32, AudioFilter) {
+	p.Lock()
+	defer p.Unlock()
+	p.session = nil
+	p.streamCancel = cancel
+	return p.startOffset, p.volume * p.gain, p.filter
+}
+
+// takeSeek returns whether a seek was requested while streaming and moves the start offset to it.
+func (p *Playback) takeSeek() bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.seekTo == nil {
+		return false
+	}
+	p.startOffset = *p.seekTo
+	p.seekTo = nil
+	p.pausedDuration = 0
+	if p.paused {
+		p.pausedAt = time.Now()
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package music
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Prefetch is a song that has started downloading and encoding before it's played, so it can start without a gap
+// when the song before it ends. A nil prefetch is valid and never has anything in it.
+type Prefetch struct {
+	startOffset time.Duration
+	volume      float32
+	filter      AudioFilter
+	pipeline    *pipeline
+	log         zerolog.Logger
+	*sync.Mutex
+}
+
+// PrefetchSong starts reading and encoding the song at startOffset with the volume multiplier and filter. A song that
+// isn't cached is saved to the cache as cacheSongID while it downloads. The prefetch stops when ctx is cancelled or
+// it's closed.
+func PrefetchSong(ctx context.Context, source Source, link, cachedPath string, cache *AudioCache, cacheSongID string,
+	startOffset time.Duration, volume float32, filter AudioFilter, log zerolog.Logger,
+) (*Prefetch, error) {
+	p, err := startPipeline(ctx, source, link, cachedPath, cache, cacheSongID,
+		encodeOptions(startOffset, volume, filter))
+	if err != nil {
+		return nil, err
+	}
+	return &Prefetch{startOffset: startOffset, volume: volume, filter: filter, pipeline: p, log: log,
+		Mutex: &sync.Mutex{}}, nil
+}
+
+// take hands the prefetched pipeline over to the stream if it was started with the same offset, volume and filter.
+// Otherwise the prefetch is closed. Either way the prefetch is empty afterwards.
+func (p *Prefetch) take(startOffset time.Duration, volume float32, filter AudioFilter) *pipeline {
+	if p == nil {
+		return nil
+	}
+	p.Lock()
+	defer p.Unlock()
+	taken := p.pipeline
+	p.pipeline = nil
+	if taken == nil {
+		return nil
+	}
+	if p.startOffset != startOffset || p.volume != volume || p.filter != filter {
+		taken.close(p.log)
+		return nil
+	}
+	return taken
+}
+
+// Close stops the prefetch if it hasn't been used.
+func (p *Prefetch) Close() {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	if p.pipeline != nil {
+		p.pipeline.close(p.log)
+		p.pipeline = nil
+	}
+}
@@ -0,0 +1,84 @@
+package music
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type probeResult struct {
+	Format struct {
+		Duration string            `json:"duration"`
+		Tags     map[string]string `json:"tags"`
+	} `json:"format"`
+}
+
+// probeTimeout is how long ffprobe has to read a song.
+const probeTimeout = time.Second * 30
+
+// probeAudio reads the duration and tags of a file or URL with ffprobe.
+func probeAudio(ctx context.Context, input string) (*probeResult, error) {
+	return runProbe(ctx, input, nil)
+}
+
+// probeAudioStream reads the duration and tags of audio that is being downloaded with ffprobe. The duration can be
+// missing for formats that only have it at the end.
+func probeAudioStream(ctx context.Context, audio io.Reader) (*probeResult, error) {
+	return runProbe(ctx, "pipe:0", audio)
+}
+
+func runProbe(ctx context.Context, input string, stdin io.Reader) (*probeResult, error) {
+	probeCtx, probeCtxCancel := context.WithTimeout(ctx, probeTimeout)
+	defer probeCtxCancel()
+	cmd := exec.CommandContext(probeCtx, "ffprobe", "-v", "quiet", "-print_format", "json", "-show_format", input)
+	cmd.Stdin = stdin
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	result := &probeResult{}
+	err = json.Unmarshal(output, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// duration returns the length of the audio in seconds, or 0 if it isn't known.
+func (r *probeResult) duration() float64 {
+	duration, err := strconv.ParseFloat(r.Format.Duration, 64)
+	if err != nil {
+		return 0
+	}
+	return duration
+}
+
+// tag returns the tag with the name, ignoring case, or an empty string if it isn't set.
+func (r *probeResult) tag(name string) string {
+	for key, value := range r.Format.Tags {
+		if strings.EqualFold(key, name) {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// setTags copies the title, artist, album and track tags to the song.
+func (r *probeResult) setTags(song *Song) {
+	if title := r.tag("title"); title != "" {
+		song.Title = title
+	}
+	if artist := r.tag("artist"); artist != "" {
+		song.Artist = artist
+	}
+	if album := r.tag("album"); album != "" {
+		song.Album = album
+	}
+	if track := r.tag("track"); track != "" {
+		song.Track = track
+	}
+}
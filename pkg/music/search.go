@@ -0,0 +1,74 @@
+package music
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+var ErrNoSearchResults = errors.New("no songs found")
+
+// searchResult is a song from a yt-dlp search. Searches list songs without looking each one up, so the link is in url
+// rather than webpage_url.
+type searchResult struct {
+	Song
+	URL string `json:"url"`
+}
+
+// Search finds up to the number of results songs on YouTube with yt-dlp. Results only have what the search page shows,
+// so look up the picked song with GetSongInfo before playing it.
+func Search(ctx context.Context, query string, results int) ([]*Song, error) {
+	searchCtx, searchCtxCancel := context.WithTimeout(ctx, time.Minute)
+	defer searchCtxCancel()
+	ytdlpArgs := []string{
+		"--dump-json",
+		"--flat-playlist",
+		"--no-progress",
+		"--no-warnings",
+		"--no-call-home",
+		"--skip-download",
+		fmt.Sprintf("ytsearch%d:%s", results, query),
+	}
+
+	cmd := exec.CommandContext(searchCtx, "yt-dlp", ytdlpArgs...)
+	output, err := cmd.Output()
+	if err != nil {
+		log.Error().Err(err).Msg("error searching for songs")
+		return nil, err
+	}
+	var songs []*Song
+	for _, line := range bytes.Split(output, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		result := &searchResult{}
+		errUnmarshal := json.Unmarshal(line, result)
+		if errUnmarshal != nil {
+			log.Error().Err(errUnmarshal).Str("song_data", string(line)).Msg("error unmarshalling search result")
+			continue
+		}
+		// Streams that haven't started yet can't be played.
+		if result.LiveStatus == "is_upcoming" {
+			continue
+		}
+		song := &result.Song
+		if song.WebpageURL == "" {
+			song.WebpageURL = result.URL
+		}
+		if song.WebpageURL == "" {
+			continue
+		}
+		song.OriginalURL = song.WebpageURL
+		songs = append(songs, song)
+	}
+	if len(songs) == 0 {
+		return nil, ErrNoSearchResults
+	}
+	return songs, nil
+}
@@ -0,0 +1,67 @@
+package music
+
+import (
+	"math"
+
+	"github.com/volatiletech/null/v8"
+
+	"thalassa_discord/models"
+	"thalassa_discord/pkg/utils"
+)
+
+// Live reports whether the song is a live stream, which has no duration and plays until it's stopped.
+func (s *Song) Live() bool {
+	return s.IsLive || s.LiveStatus == "is_live"
+}
+
+// SongModel returns the song row for the song info. Live streams are saved without a duration.
+func SongModel(songInfo *Song) *models.Song {
+	live := songInfo.Live()
+	return &models.Song{
+		ID:                songInfo.ID,
+		Platform:          null.StringFrom(songInfo.ExtractorKey),
+		SongName:          songInfo.Title,
+		Description:       null.StringFrom(songInfo.Description),
+		URL:               songInfo.WebpageURL,
+		DurationInSeconds: null.NewInt(int(math.Round(songInfo.Duration)), !live),
+		IsStream:          live,
+		ThumbnailURL:      null.StringFrom(songInfo.Thumbnail),
+		Artist:            utils.InterfaceToNullString(songInfo.Artist),
+		Album:             utils.InterfaceToNullString(songInfo.Album),
+		Track:             utils.InterfaceToNullString(songInfo.Track),
+		ChannelID:         null.NewString(songInfo.ChannelID, songInfo.ChannelID != ""),
+		Uploader:          null.NewString(songInfo.Uploader, songInfo.Uploader != ""),
+	}
+}
+
+// SongFromModel turns a saved song back into song info without looking it up again. The song is played with the
+// source named by its platform.
+func SongFromModel(song *models.Song) *Song {
+	songInfo := &Song{
+		ID:           song.ID,
+		Title:        song.SongName,
+		Description:  song.Description.String,
+		WebpageURL:   song.URL,
+		OriginalURL:  song.URL,
+		Extractor:    song.Platform.String,
+		ExtractorKey: song.Platform.String,
+		Duration:     float64(song.DurationInSeconds.Int),
+		Thumbnail:    song.ThumbnailURL.String,
+		ChannelID:    song.ChannelID.String,
+		Uploader:     song.Uploader.String,
+		IsLive:       song.IsStream,
+	}
+	if song.IsStream {
+		songInfo.LiveStatus = "is_live"
+	}
+	if song.Artist.Valid {
+		songInfo.Artist = song.Artist.String
+	}
+	if song.Album.Valid {
+		songInfo.Album = song.Album.String
+	}
+	if song.Track.Valid {
+		songInfo.Track = song.Track.String
+	}
+	return songInfo
+}
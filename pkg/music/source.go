@@ -0,0 +1,158 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedLink is returned by a source that can't play a link, so the next source can be tried.
+var ErrUnsupportedLink = errors.New("link not supported by this source")
+
+// ErrEmptyPlaylist is returned when a playlist has no songs that can be played.
+var ErrEmptyPlaylist = errors.New("no songs found in playlist")
+
+// Source finds songs and opens their audio.
+type Source interface {
+	// Name identifies the source. Songs from the source have it as their extractor key, which is saved as the
+	// song's platform, so the same source is used to play them later.
+	Name() string
+	// SongInfo resolves the song at the link. It returns ErrUnsupportedLink if the link isn't for this source.
+	SongInfo(ctx context.Context, link string) (*Song, error)
+	// PlaylistInfo lists the songs in the playlist at the link. It returns ErrUnsupportedLink if the link isn't for
+	// this source.
+	PlaylistInfo(ctx context.Context, link string, shuffle bool) ([]*Song, error)
+	// Open starts reading the song's audio in any format ffmpeg understands. Closing the reader stops the download.
+	Open(ctx context.Context, link string) (io.ReadCloser, error)
+}
+
+// fileSource is implemented by sources whose songs are already files ffmpeg can open itself, which lets ffmpeg seek
+// without reading the song from the start.
+type fileSource interface {
+	FilePath(link string) (string, error)
+}
+
+// playlistStreamer is implemented by sources that can list a playlist's songs as they're found, rather than all at
+// once when the listing is done.
+type playlistStreamer interface {
+	StreamPlaylist(ctx context.Context, link string, shuffle bool, found func(*Song)) error
+}
+
+type sourceRegistry struct {
+	byKey  map[string][]Source
+	byName map[string]Source
+	*sync.RWMutex
+}
+
+var sources = sourceRegistry{
+	byKey:   make(map[string][]Source),
+	byName:  make(map[string]Source),
+	RWMutex: &sync.RWMutex{},
+}
+
+// defaultSource is tried last for every link, and plays songs whose source isn't registered.
+var defaultSource Source = &YtdlpSource{}
+
+func init() {
+	RegisterSource(&HTTPSource{}, httpAudioExtensions...)
+}
+
+// RegisterSource adds the source to the registry. Keys are URL schemes like file, hosts like example.com, which also
+// match their subdomains, or file extensions like .mp3. Sources registered for a link's host are tried first, then
+// those registered for its scheme and then for the extension of its path. yt-dlp is tried last.
+func RegisterSource(source Source, keys ...string) {
+	sources.Lock()
+	defer sources.Unlock()
+	for _, key := range keys {
+		key = strings.ToLower(key)
+		sources.byKey[key] = append(sources.byKey[key], source)
+	}
+	sources.byName[source.Name()] = source
+}
+
+// SourcesForLink returns the sources to try for the link, in order.
+func SourcesForLink(link string) []Source {
+	sources.RLock()
+	defer sources.RUnlock()
+	var candidates []Source
+	parsedURL, err := url.Parse(link)
+	if err == nil {
+		host := strings.ToLower(parsedURL.Hostname())
+		for host != "" {
+			candidates = append(candidates, sources.byKey[host]...)
+			_, parent, found := strings.Cut(host, ".")
+			if !found {
+				break
+			}
+			host = parent
+		}
+		if parsedURL.Scheme != "" {
+			candidates = append(candidates, sources.byKey[strings.ToLower(parsedURL.Scheme)]...)
+		}
+		if extension := path.Ext(parsedURL.Path); extension != "" {
+			candidates = append(candidates, sources.byKey[strings.ToLower(extension)]...)
+		}
+	}
+	return append(candidates, defaultSource)
+}
+
+// SourceNamed returns the source that found a song with the platform, falling back to yt-dlp.
+func SourceNamed(platform string) Source {
+	sources.RLock()
+	defer sources.RUnlock()
+	if source, exists := sources.byName[platform]; exists {
+		return source
+	}
+	return defaultSource
+}
+
+// GetSongInfo resolves the song at the link with the first source that supports it.
+func GetSongInfo(ctx context.Context, link string) (*Song, error) {
+	for _, source := range SourcesForLink(link) {
+		song, err := source.SongInfo(ctx, link)
+		if errors.Is(err, ErrUnsupportedLink) {
+			continue
+		}
+		return song, err
+	}
+	return nil, ErrUnsupportedLink
+}
+
+// GetPlaylistInfo lists the songs in the playlist at the link with the first source that supports it.
+func GetPlaylistInfo(ctx context.Context, link string, shuffle bool) ([]*Song, error) {
+	for _, source := range SourcesForLink(link) {
+		songs, err := source.PlaylistInfo(ctx, link, shuffle)
+		if errors.Is(err, ErrUnsupportedLink) {
+			continue
+		}
+		return songs, err
+	}
+	return nil, ErrUnsupportedLink
+}
+
+// StreamPlaylistInfo lists the songs in the playlist at the link with the first source that supports it, passing
+// each one to found as it's found. Entries in the playlist that can't be played are passed as nil. Sources that
+// can't stream a playlist pass every song once they're done listing it.
+func StreamPlaylistInfo(ctx context.Context, link string, shuffle bool, found func(*Song)) error {
+	for _, source := range SourcesForLink(link) {
+		var err error
+		if streamer, ok := source.(playlistStreamer); ok {
+			err = streamer.StreamPlaylist(ctx, link, shuffle, found)
+		} else {
+			var songs []*Song
+			songs, err = source.PlaylistInfo(ctx, link, shuffle)
+			for _, song := range songs {
+				found(song)
+			}
+		}
+		if errors.Is(err, ErrUnsupportedLink) {
+			continue
+		}
+		return err
+	}
+	return ErrUnsupportedLink
+}
@@ -0,0 +1,182 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var ErrFileOutsideLibrary = errors.New("file isn't in the music library")
+
+// audioFileExtensions are the files a directory playlist picks up.
+var audioFileExtensions = map[string]struct{}{
+	".aac": {}, ".flac": {}, ".m4a": {}, ".mp3": {}, ".oga": {}, ".ogg": {}, ".opus": {}, ".wav": {}, ".webm": {},
+}
+
+// FileSource plays files from music library directories. Links look like file:artist/album/song.mp3, relative to a
+// library directory, and can't reach files outside of them. If more than one directory has the file, the first one
+// configured wins.
+type FileSource struct {
+	roots []string
+}
+
+func NewFileSource(roots ...string) *FileSource {
+	return &FileSource{roots: roots}
+}
+
+func (*FileSource) Name() string {
+	return "local"
+}
+
+// relativePath returns the slash separated path of the link inside the library.
+func relativePath(link string) (string, error) {
+	if !strings.HasPrefix(link, "file:") {
+		return "", ErrUnsupportedLink
+	}
+	relative := strings.TrimPrefix(link, "file:")
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+relative)), "/"), nil
+}
+
+// FilePath returns the file the link points to, making sure symlinks don't lead out of the library.
+func (s *FileSource) FilePath(link string) (string, error) {
+	relative, err := relativePath(link)
+	if err != nil {
+		return "", err
+	}
+	err = os.ErrNotExist
+	for _, root := range s.roots {
+		var resolved string
+		resolved, err = resolveInRoot(root, relative)
+		if err == nil {
+			return resolved, nil
+		}
+	}
+	return "", err
+}
+
+func resolveInRoot(root, relative string) (string, error) {
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(root, filepath.FromSlash(relative)))
+	if err != nil {
+		return "", err
+	}
+	if !insideRoot(root, resolved) {
+		return "", ErrFileOutsideLibrary
+	}
+	return resolved, nil
+}
+
+func insideRoot(root, filePath string) bool {
+	inside, err := filepath.Rel(root, filePath)
+	return err == nil && inside != ".." && !strings.HasPrefix(inside, ".."+string(filepath.Separator))
+}
+
+// fileLink returns the link for a file in the library.
+func (s *FileSource) fileLink(filePath string) (string, error) {
+	for _, root := range s.roots {
+		resolvedRoot, err := filepath.EvalSymlinks(root)
+		if err != nil || !insideRoot(resolvedRoot, filePath) {
+			continue
+		}
+		relative, err := filepath.Rel(resolvedRoot, filePath)
+		if err != nil {
+			return "", err
+		}
+		return "file:" + filepath.ToSlash(relative), nil
+	}
+	return "", ErrFileOutsideLibrary
+}
+
+func (s *FileSource) SongInfo(ctx context.Context, link string) (*Song, error) {
+	filePath, err := s.FilePath(link)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, errors.New("that's a folder, use the playlist command to play it")
+	}
+	return s.fileSongInfo(ctx, filePath)
+}
+
+func (s *FileSource) fileSongInfo(ctx context.Context, filePath string) (*Song, error) {
+	link, err := s.fileLink(filePath)
+	if err != nil {
+		return nil, err
+	}
+	song := &Song{
+		ID:           link,
+		Title:        strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)),
+		WebpageURL:   link,
+		OriginalURL:  link,
+		Extractor:    "local",
+		ExtractorKey: s.Name(),
+	}
+	probe, err := probeAudio(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	song.Duration = probe.duration()
+	probe.setTags(song)
+	return song, nil
+}
+
+// PlaylistInfo lists the audio files in a folder and its subfolders in name order. A single file is a playlist with
+// one song.
+func (s *FileSource) PlaylistInfo(ctx context.Context, link string, shuffle bool) ([]*Song, error) {
+	filePath, err := s.FilePath(link)
+	if err != nil {
+		return nil, err
+	}
+	var filePaths []string
+	err = filepath.WalkDir(filePath, func(walkPath string, entry fs.DirEntry, errWalk error) error {
+		if errWalk != nil {
+			return errWalk
+		}
+		if _, audio := audioFileExtensions[strings.ToLower(filepath.Ext(walkPath))]; audio && !entry.IsDir() {
+			filePaths = append(filePaths, walkPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filePaths)
+	if shuffle {
+		rand.Shuffle(len(filePaths), func(i, j int) {
+			filePaths[i], filePaths[j] = filePaths[j], filePaths[i]
+		})
+	}
+	var songs []*Song
+	for _, walkPath := range filePaths {
+		song, errSong := s.fileSongInfo(ctx, walkPath)
+		if errSong != nil {
+			continue
+		}
+		songs = append(songs, song)
+	}
+	if len(songs) == 0 {
+		return nil, ErrEmptyPlaylist
+	}
+	return songs, nil
+}
+
+func (s *FileSource) Open(_ context.Context, link string) (io.ReadCloser, error) {
+	filePath, err := s.FilePath(link)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(filePath)
+}
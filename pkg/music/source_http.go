@@ -0,0 +1,174 @@
+package music
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// httpAudioExtensions are the file extensions of links HTTPSource is tried for. Other links, like web pages, go
+// straight to yt-dlp without a request from HTTPSource first.
+var httpAudioExtensions = []string{".mp3", ".ogg", ".oga", ".opus", ".flac", ".wav", ".m4a", ".aac", ".weba"}
+
+// ErrPrivateAddress is returned for links to loopback, private or link-local addresses, so users can't make the bot
+// send requests into the network it runs in.
+var ErrPrivateAddress = errors.New("links to private network addresses aren't allowed")
+
+// HTTPSource plays audio files and Icecast or Shoutcast streams linked to directly.
+type HTTPSource struct{}
+
+// publicAddressOnly refuses connections to addresses that aren't on the public internet. It's checked after the host
+// is resolved, so it also covers redirects and hosts that resolve to private addresses.
+func publicAddressOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// httpAudioClient has no timeout because streams can play for hours. Requests are stopped with their context. It
+// doesn't use a proxy, so the address it connects to is the one that is checked.
+var httpAudioClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   publicAddressOnly,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	},
+}
+
+func (*HTTPSource) Name() string {
+	return "HTTP"
+}
+
+func (*HTTPSource) request(ctx context.Context, method, link string) (*http.Response, error) {
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, ErrUnsupportedLink
+	}
+	request, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := httpAudioClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("%s returned %s", link, response.Status)
+	}
+	return response, nil
+}
+
+func isAudioContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "audio/") || mediaType == "application/ogg"
+}
+
+// SongInfo checks the headers of a HEAD request to see whether the link is audio. Anything else, like a web page, is
+// left for yt-dlp. Links to private addresses return ErrPrivateAddress.
+func (s *HTTPSource) SongInfo(ctx context.Context, link string) (*Song, error) {
+	headerCtx, headerCtxCancel := context.WithTimeout(ctx, time.Second*15)
+	defer headerCtxCancel()
+	response, err := s.request(headerCtx, http.MethodHead, link)
+	if errors.Is(err, ErrPrivateAddress) {
+		return nil, ErrPrivateAddress
+	}
+	if err != nil {
+		return nil, ErrUnsupportedLink
+	}
+	_ = response.Body.Close()
+	stationName := response.Header.Get("icy-name")
+	live := stationName != "" || response.Header.Get("icy-br") != ""
+	if !live && !isAudioContentType(response.Header.Get("Content-Type")) {
+		return nil, ErrUnsupportedLink
+	}
+
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return nil, ErrUnsupportedLink
+	}
+	hash := sha1.Sum([]byte(link))
+	song := &Song{
+		ID:           "http-" + hex.EncodeToString(hash[:]),
+		Title:        path.Base(parsedURL.Path),
+		WebpageURL:   link,
+		OriginalURL:  link,
+		Extractor:    "http",
+		ExtractorKey: s.Name(),
+		IsLive:       live,
+	}
+	if song.Title == "/" || song.Title == "." {
+		song.Title = parsedURL.Host
+	}
+	if live {
+		song.LiveStatus = "is_live"
+		if stationName != "" {
+			song.Title = stationName
+		}
+		song.Description = response.Header.Get("icy-description")
+		return song, nil
+	}
+	// ffprobe reads the song through the same client rather than the link, so it can't be redirected to a private
+	// address either.
+	probeCtx, probeCtxCancel := context.WithTimeout(ctx, probeTimeout)
+	defer probeCtxCancel()
+	audio, err := s.Open(probeCtx, link)
+	if err != nil {
+		return song, nil
+	}
+	probe, err := probeAudioStream(probeCtx, audio)
+	_ = audio.Close()
+	if err == nil {
+		song.Duration = probe.duration()
+		probe.setTags(song)
+	}
+	return song, nil
+}
+
+// PlaylistInfo treats the audio as a playlist with one song.
+func (s *HTTPSource) PlaylistInfo(ctx context.Context, link string, _ bool) ([]*Song, error) {
+	song, err := s.SongInfo(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	return []*Song{song}, nil
+}
+
+func (s *HTTPSource) Open(ctx context.Context, link string) (io.ReadCloser, error) {
+	response, err := s.request(ctx, http.MethodGet, link)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
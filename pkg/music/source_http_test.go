@@ -0,0 +1,60 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPublicAddressOnly(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.216.34:443", allowed: true},
+		{address: "[2606:2800:220:1:248:1893:25c8:1946]:80", allowed: true},
+		{address: "127.0.0.1:80"},
+		{address: "[::1]:80"},
+		{address: "10.0.0.5:8000"},
+		{address: "172.16.3.4:80"},
+		{address: "192.168.1.10:80"},
+		{address: "169.254.169.254:80"},
+		{address: "[fe80::1]:80"},
+		{address: "[fd00::1]:80"},
+		{address: "0.0.0.0:80"},
+		{address: "[::ffff:127.0.0.1]:80"},
+	}
+	for _, test := range tests {
+		err := publicAddressOnly("tcp", test.address, nil)
+		if test.allowed && err != nil {
+			t.Errorf("%s refused: %v", test.address, err)
+		}
+		if !test.allowed && !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("%s allowed, want ErrPrivateAddress", test.address)
+		}
+	}
+}
+
+func TestHTTPSourcePrivateAddress(t *testing.T) {
+	var requested atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requested.Store(true)
+		writer.Header().Set("Content-Type", "audio/mpeg")
+	}))
+	defer server.Close()
+
+	_, err := (&HTTPSource{}).SongInfo(context.Background(), server.URL+"/song.mp3")
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Errorf("SongInfo error = %v, want ErrPrivateAddress", err)
+	}
+	_, err = (&HTTPSource{}).Open(context.Background(), server.URL+"/song.mp3")
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Errorf("Open error = %v, want ErrPrivateAddress", err)
+	}
+	if requested.Load() {
+		t.Error("the server on a loopback address was sent a request")
+	}
+}
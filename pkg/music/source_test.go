@@ -0,0 +1,184 @@
+package music
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+)
+
+var errFakeSource = errors.New("fake source failed")
+
+// fakeSource resolves every link to the same songs, or returns err, and opens the same audio for every song. Links
+// it doesn't support return ErrUnsupportedLink so the next source is tried.
+type fakeSource struct {
+	name      string
+	supported bool
+	songs     []*Song
+	audio     []byte
+	err       error
+}
+
+func (s *fakeSource) Name() string {
+	return s.name
+}
+
+func (s *fakeSource) SongInfo(_ context.Context, _ string) (*Song, error) {
+	if !s.supported {
+		return nil, ErrUnsupportedLink
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.songs[0], nil
+}
+
+func (s *fakeSource) PlaylistInfo(_ context.Context, _ string, _ bool) ([]*Song, error) {
+	if !s.supported {
+		return nil, ErrUnsupportedLink
+	}
+	return s.songs, s.err
+}
+
+func (s *fakeSource) Open(_ context.Context, _ string) (io.ReadCloser, error) {
+	if !s.supported {
+		return nil, ErrUnsupportedLink
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return io.NopCloser(bytes.NewReader(s.audio)), nil
+}
+
+// useSources replaces the registry with the sources for the test, with fallback tried last.
+func useSources(t *testing.T, fallback Source, register map[string]Source) {
+	t.Helper()
+	savedSources, savedDefault := sources, defaultSource
+	sources = sourceRegistry{
+		byKey:   make(map[string][]Source),
+		byName:  make(map[string]Source),
+		RWMutex: &sync.RWMutex{},
+	}
+	defaultSource = fallback
+	for key, source := range register {
+		RegisterSource(source, key)
+	}
+	t.Cleanup(func() {
+		sources, defaultSource = savedSources, savedDefault
+	})
+}
+
+func sourceNames(candidates []Source) []string {
+	names := make([]string, 0, len(candidates))
+	for _, source := range candidates {
+		names = append(names, source.Name())
+	}
+	return names
+}
+
+func TestSourcesForLink(t *testing.T) {
+	useSources(t, &fakeSource{name: "default"}, map[string]Source{
+		"example.com": &fakeSource{name: "host"},
+		"fake":        &fakeSource{name: "scheme"},
+		".mp3":        &fakeSource{name: "extension"},
+	})
+	tests := []struct {
+		link string
+		want []string
+	}{
+		{link: "https://example.com/song", want: []string{"host", "default"}},
+		{link: "https://music.EXAMPLE.com/song", want: []string{"host", "default"}},
+		{link: "fake:///song", want: []string{"scheme", "default"}},
+		{link: "fake://example.com/song", want: []string{"host", "scheme", "default"}},
+		{link: "https://example.org/song", want: []string{"default"}},
+		{link: "https://example.org/song.MP3", want: []string{"extension", "default"}},
+		{link: "https://example.com/song.mp3?start=10", want: []string{"host", "extension", "default"}},
+		{link: "fake:///song.mp3", want: []string{"scheme", "extension", "default"}},
+		{link: "a song to search for", want: []string{"default"}},
+	}
+	for _, test := range tests {
+		got := sourceNames(SourcesForLink(test.link))
+		if len(got) != len(test.want) {
+			t.Errorf("SourcesForLink(%q) = %v, want %v", test.link, got, test.want)
+			continue
+		}
+		for index := range got {
+			if got[index] != test.want[index] {
+				t.Errorf("SourcesForLink(%q) = %v, want %v", test.link, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestSourceFallback(t *testing.T) {
+	hostSong := &Song{Title: "host"}
+	defaultSong := &Song{Title: "default"}
+	tests := []struct {
+		name     string
+		host     *fakeSource
+		fallback *fakeSource
+		want     *Song
+		wantErr  error
+	}{
+		{
+			name:     "host source supports the link",
+			host:     &fakeSource{name: "host", supported: true, songs: []*Song{hostSong}},
+			fallback: &fakeSource{name: "default", supported: true, songs: []*Song{defaultSong}},
+			want:     hostSong,
+		},
+		{
+			name:     "unsupported link falls back",
+			host:     &fakeSource{name: "host"},
+			fallback: &fakeSource{name: "default", supported: true, songs: []*Song{defaultSong}},
+			want:     defaultSong,
+		},
+		{
+			name:     "errors don't fall back",
+			host:     &fakeSource{name: "host", supported: true, err: errFakeSource},
+			fallback: &fakeSource{name: "default", supported: true, songs: []*Song{defaultSong}},
+			wantErr:  errFakeSource,
+		},
+		{
+			name:     "no source supports the link",
+			host:     &fakeSource{name: "host"},
+			fallback: &fakeSource{name: "default"},
+			wantErr:  ErrUnsupportedLink,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			useSources(t, test.fallback, map[string]Source{"example.com": test.host})
+			link := "https://example.com/song"
+
+			song, err := GetSongInfo(context.Background(), link)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("GetSongInfo error = %v, want %v", err, test.wantErr)
+			}
+			if song != test.want {
+				t.Errorf("GetSongInfo = %v, want %v", song, test.want)
+			}
+
+			songs, err := GetPlaylistInfo(context.Background(), link, false)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("GetPlaylistInfo error = %v, want %v", err, test.wantErr)
+			}
+			if test.want != nil && (len(songs) != 1 || songs[0] != test.want) {
+				t.Errorf("GetPlaylistInfo = %v, want [%v]", songs, test.want)
+			}
+
+			var found []*Song
+			err = StreamPlaylistInfo(context.Background(), link, false, func(song *Song) {
+				found = append(found, song)
+			})
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("StreamPlaylistInfo error = %v, want %v", err, test.wantErr)
+			}
+			if test.want != nil && (len(found) != 1 || found[0] != test.want) {
+				t.Errorf("StreamPlaylistInfo found %v, want [%v]", found, test.want)
+			}
+		})
+	}
+}
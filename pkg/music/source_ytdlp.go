@@ -0,0 +1,237 @@
+package music
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// YtdlpSource plays anything yt-dlp supports. It's the default source.
+type YtdlpSource struct{}
+
+func (*YtdlpSource) Name() string {
+	return "yt-dlp"
+}
+
+// SongInfo resolves the song with yt-dlp. Anything that isn't a link is searched for on YouTube.
+func (*YtdlpSource) SongInfo(ctx context.Context, url string) (*Song, error) {
+	ytdlCtx, ytdlCtxCancel := context.WithTimeout(ctx, time.Minute*1)
+	defer ytdlCtxCancel()
+	ytdlpArgs := []string{
+		"--dump-json",
+		"--no-playlist",
+		"--no-progress",
+		"--no-warnings",
+		"--default-search",
+		"ytsearch",
+		"--no-call-home",
+		"--skip-download",
+		url,
+	}
+
+	cmd := exec.CommandContext(ytdlCtx, "yt-dlp", ytdlpArgs...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting song info")
+		return nil, err
+	}
+	song := &Song{}
+	errUnmarshal := json.Unmarshal(output, song)
+	if errUnmarshal != nil {
+		log.Error().Err(errUnmarshal).Str("song_data", string(output)).Msg("error unmarshalling song")
+		return nil, errUnmarshal
+	}
+	return song, nil
+}
+
+// PlaylistInfo lists the songs in the playlist with yt-dlp.
+func (source *YtdlpSource) PlaylistInfo(ctx context.Context, url string, shuffle bool) ([]*Song, error) {
+	var playListSongs []*Song
+	err := source.StreamPlaylist(ctx, url, shuffle, func(songInfo *Song) {
+		if songInfo != nil {
+			playListSongs = append(playListSongs, songInfo)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return playListSongs, nil
+}
+
+// StreamPlaylist lists the songs in the playlist with yt-dlp, passing each one to found as soon as yt-dlp prints it.
+func (*YtdlpSource) StreamPlaylist(ctx context.Context, url string, shuffle bool, found func(*Song)) error {
+	// Songs are used as they're found, so a big playlist only has to finish listing within the timeout.
+	ytdlCtx, ytdlCtxCancel := context.WithTimeout(ctx, time.Minute*15)
+	defer ytdlCtxCancel()
+	ytdlpArgs := []string{
+		"--dump-json",
+		"--flat-playlist",
+		"--no-progress",
+		"--no-warnings",
+		"--default-search",
+		"ytsearch",
+		"--no-call-home",
+		"--skip-download",
+	}
+	if shuffle {
+		ytdlpArgs = append(ytdlpArgs, "--playlist-random")
+	}
+	ytdlpArgs = append(ytdlpArgs, url)
+
+	cmd := exec.CommandContext(ytdlCtx, "yt-dlp", ytdlpArgs...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	err = cmd.Start()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting playlist info")
+		return err
+	}
+	playable := 0
+	scanner := bufio.NewScanner(stdout)
+	// Each line is a whole JSON document, which can be much longer than the default limit.
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		songInfo, ok := parsePlaylistLine(scanner.Bytes())
+		if !ok {
+			continue
+		}
+		if songInfo != nil {
+			playable++
+		}
+		found(songInfo)
+	}
+	errScan := scanner.Err()
+	err = cmd.Wait()
+	if err != nil {
+		log.Error().Err(err).Str("stderr", stderr.String()).Msg("error getting playlist info")
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	if errScan != nil {
+		log.Error().Err(errScan).Msg("error reading playlist info")
+		return errScan
+	}
+	if playable == 0 {
+		log.Debug().Msg("No songs found in playlist")
+		return ErrEmptyPlaylist
+	}
+	return nil
+}
+
+// parsePlaylistLine parses a line of yt-dlp's playlist output. It returns a nil song for an entry that can't be
+// played, and false for a line that isn't an entry at all.
+func parsePlaylistLine(line []byte) (*Song, bool) {
+	if len(line) == 0 {
+		return nil, false
+	}
+	// Hidden videos are reported as a message rather than an entry.
+	if bytes.Contains(line, []byte("unavailable video is hidden")) {
+		return nil, true
+	}
+	songInfo := &Song{}
+	errUnmarshal := json.Unmarshal(line, songInfo)
+	if errUnmarshal != nil {
+		log.Error().Err(errUnmarshal).Str("song_data", string(line)).Msg("error unmarshalling song")
+		return nil, false
+	}
+	// Streams that haven't started yet can't be played, and finished ones are listed with no duration until
+	// they're processed.
+	if (songInfo.Duration == 0 && !songInfo.Live()) || songInfo.LiveStatus == "is_upcoming" ||
+		songInfo.Title == "[Deleted video]" || songInfo.Title == "[Private video]" {
+		log.Info().Fields(map[string]interface{}{
+			"song_title":       songInfo.Title,
+			"song_urls":        songInfo.Urls,
+			"song_is_live":     songInfo.Live(),
+			"song_live_status": songInfo.LiveStatus,
+			"song_duration":    songInfo.Duration,
+			"song_extractor":   songInfo.Extractor,
+			"song_webpage_url": songInfo.WebpageURL,
+		}).Msg("Skipping invalid playlist song")
+		return nil, true
+	}
+	log.Debug().Msgf("Found song in playlist: %s", songInfo.Title)
+	return songInfo, true
+}
+
+// Open streams the song's best audio format from yt-dlp.
+func (*YtdlpSource) Open(ctx context.Context, link string) (io.ReadCloser, error) {
+	ytdlArgs := []string{
+		"--no-progress",
+		"--no-call-home",
+		"--default-search", "ytsearch",
+		"--no-playlist",
+		"--no-mtime",
+		"--no-warnings",
+		"-o", "-",
+		"--format",
+		"bestaudio*/best",
+		"--prefer-ffmpeg",
+		"--quiet",
+		link,
+	}
+	return startCommand(ctx, "yt-dlp", ytdlArgs...)
+}
+
+// commandReader reads the output of a command. Closing it before the output ends stops the command.
+type commandReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+	eof    atomic.Bool
+}
+
+func startCommand(ctx context.Context, name string, args ...string) (*commandReader, error) {
+	cmdCtx, cmdCtxCancel := context.WithCancel(ctx)
+	cmd := exec.CommandContext(cmdCtx, name, args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cmdCtxCancel()
+		return nil, err
+	}
+	cmd.Stderr = os.Stderr
+	err = cmd.Start()
+	if err != nil {
+		cmdCtxCancel()
+		return nil, err
+	}
+	return &commandReader{ReadCloser: stdout, cmd: cmd, cancel: cmdCtxCancel}, nil
+}
+
+func (r *commandReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if errors.Is(err, io.EOF) {
+		r.eof.Store(true)
+	}
+	return n, err
+}
+
+// Close waits for the command to exit. The command's error is only returned if all of its output was read, since
+// otherwise it was stopped on purpose.
+func (r *commandReader) Close() error {
+	if !r.eof.Load() {
+		r.cancel()
+	}
+	// Drain the buffer so the command isn't left blocked writing to it.
+	_, _ = io.Copy(io.Discard, r.ReadCloser)
+	errWait := r.cmd.Wait()
+	r.cancel()
+	if r.eof.Load() {
+		return errWait
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package music
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+	unitTimestampRegex = regexp.MustCompile(`^(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s?)?$`)
+)
+
+// ParseTimestamp parses a position in a song. It accepts clock timestamps like 1:23 or 1:02:03, plain seconds like
+// 83, and unit timestamps like 1m23s or 1h2m3s.
+func ParseTimestamp(timestamp string) (time.Duration, error) {
+	timestamp = strings.ToLower(strings.TrimSpace(timestamp))
+	if timestamp == "" {
+		return 0, ErrInvalidTimestamp
+	}
+	if strings.Contains(timestamp, ":") {
+		parts := strings.Split(timestamp, ":")
+		if len(parts) > 3 {
+			return 0, ErrInvalidTimestamp
+		}
+		total := 0
+		for _, part := range parts {
+			value, err := strconv.Atoi(part)
+			if err != nil || value < 0 {
+				return 0, ErrInvalidTimestamp
+			}
+			total = total*60 + value
+		}
+		return time.Duration(total) * time.Second, nil
+	}
+	matches := unitTimestampRegex.FindStringSubmatch(timestamp)
+	if matches == nil {
+		return 0, ErrInvalidTimestamp
+	}
+	var total time.Duration
+	for index, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if matches[index+1] == "" {
+			continue
+		}
+		value, err := strconv.Atoi(matches[index+1])
+		if err != nil {
+			return 0, ErrInvalidTimestamp
+		}
+		total += time.Duration(value) * unit
+	}
+	return total, nil
+}
+
+// StartOffsetFromURL returns the start position given by a t or start parameter in a song URL, like the ones
+// YouTube adds when sharing a video at the current time.
+func StartOffsetFromURL(link string) time.Duration {
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return 0
+	}
+	values := parsedURL.Query()
+	if fragment, errFragment := url.ParseQuery(parsedURL.Fragment); errFragment == nil {
+		for key, value := range fragment {
+			if _, exists := values[key]; !exists {
+				values[key] = value
+			}
+		}
+	}
+	for _, key := range []string{"t", "start"} {
+		if values.Get(key) == "" {
+			continue
+		}
+		offset, errTimestamp := ParseTimestamp(values.Get(key))
+		if errTimestamp == nil {
+			return offset
+		}
+	}
+	return 0
+}
+
+// StartOffset returns where the song should start playing, taken from the requested URL or yt-dlp's start_time.
+// Offsets past the end of the song are ignored, and live streams always start at the live edge.
+func (s *Song) StartOffset() time.Duration {
+	if s.Live() {
+		return 0
+	}
+	offset := StartOffsetFromURL(s.OriginalURL)
+	if offset == 0 && s.StartTime > 0 {
+		offset = time.Duration(s.StartTime) * time.Second
+	}
+	if s.Duration > 0 && offset >= time.Duration(s.Duration*float64(time.Second)) {
+		return 0
+	}
+	return offset
+}
@@ -48,6 +48,7 @@ type Song struct {
 	HasDrm               interface{}            `json:"_has_drm"`
 	Height               int                    `json:"height"`
 	ID                   string                 `json:"id"`
+	IeKey                string                 `json:"ie_key"`
 	IsLive               bool                   `json:"is_live"`
 	Language             interface{}            `json:"language"`
 	LikeCount            int                    `json:"like_count"`
@@ -56,11 +57,13 @@ type Song struct {
 	PlayableInEmbed      bool                   `json:"playable_in_embed"`
 	Playlist             interface{}            `json:"playlist"`
 	PlaylistIndex        interface{}            `json:"playlist_index"`
+	PlaylistCount        int                    `json:"playlist_count"`
 	Protocol             string                 `json:"protocol"`
 	ReleaseTimestamp     interface{}            `json:"release_timestamp"`
 	RequestedFormats     []SongRequestedFormats `json:"requested_formats"`
 	RequestedSubtitles   interface{}            `json:"requested_subtitles"`
 	Resolution           string                 `json:"resolution"`
+	StartTime            float64                `json:"start_time"`
 	StretchedRatio       interface{}            `json:"stretched_ratio"`
 	Subtitles            SongSubtitles          `json:"subtitles"`
 	Tags                 []interface{}          `json:"tags"`
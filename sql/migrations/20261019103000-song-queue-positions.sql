@@ -0,0 +1,28 @@
+-- +migrate Up
+create sequence song_request_position_seq;
+
+alter table song_request
+    add column position bigint;
+
+-- Keep the existing queue order, which was requested_at then id.
+update song_request s
+set position = o.rn
+from (select id, row_number() over (order by requested_at, id) as rn from song_request) o
+where s.id = o.id;
+
+select setval('song_request_position_seq', coalesce(max(position), 0) + 1, false)
+from song_request;
+
+alter table song_request
+    alter column position set default nextval('song_request_position_seq');
+alter table song_request
+    alter column position set not null;
+
+alter sequence song_request_position_seq owned by song_request.position;
+
+create index idx_song_request_queue on song_request (guild_id, played, position);
+
+-- +migrate Down
+drop index idx_song_request_queue;
+alter table song_request
+    drop column position;
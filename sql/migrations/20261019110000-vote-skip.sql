@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table discord_server
+    add column vote_skip_threshold_percent int default 50 not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column vote_skip_threshold_percent;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table song_request
+    add column start_offset_seconds int default 0 not null;
+
+-- +migrate Down
+alter table song_request
+    drop column start_offset_seconds;
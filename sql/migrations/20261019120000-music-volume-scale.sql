@@ -0,0 +1,12 @@
+-- +migrate Up
+-- music_volume is now a multiplier where 1.0 is 100%. The old value was rounded to a whole number before being
+-- handed to ffmpeg, so keep whatever volume guilds were actually hearing.
+update discord_server
+set music_volume = least(greatest(round(music_volume::numeric), 0), 2);
+
+alter table discord_server
+    alter column music_volume set default 1.0;
+
+-- +migrate Down
+alter table discord_server
+    alter column music_volume set default 0.5;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table discord_server
+    add column music_loop_mode text default 'off' not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_loop_mode;
@@ -0,0 +1,16 @@
+-- +migrate Up
+alter table discord_server
+    add column music_autoplay_enabled bool default false not null;
+
+alter table song_request
+    add column autoplay bool default false not null;
+alter table song_request
+    add column skipped bool default false not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_autoplay_enabled;
+alter table song_request
+    drop column autoplay;
+alter table song_request
+    drop column skipped;
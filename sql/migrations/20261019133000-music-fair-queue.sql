@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table discord_server
+    add column music_fair_queue bool default false not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_fair_queue;
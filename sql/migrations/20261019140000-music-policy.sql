@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Limits on what can be queued in a guild. A null limit means there is no limit.
+create table music_policy
+(
+    guild_id                  text primary key references discord_server,
+    max_song_duration_seconds int,
+    max_queued_songs_per_user int,
+    max_playlist_size         int,
+    allow_live_streams        bool default true not null,
+    max_age_limit             int,
+    allowed_extractors        text,
+    allowed_domains           text
+);
+
+alter table role_permission
+    add column ignore_music_policy bool default false not null;
+
+-- +migrate Down
+drop table music_policy;
+alter table role_permission
+    drop column ignore_music_policy;
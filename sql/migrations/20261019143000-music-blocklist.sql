@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Songs, uploader channels and title keywords that can't be requested in a guild.
+create table music_blocklist
+(
+    id               bigserial primary key,
+    guild_id         text                                   not null references discord_server,
+    entry_type       text                                   not null,
+    value            text                                   not null,
+    label            text,
+    added_by_user_id text                                   not null,
+    created_at       timestamp with time zone default now() not null,
+    unique (guild_id, entry_type, value)
+);
+
+alter table song
+    add column channel_id text,
+    add column uploader   text;
+
+-- +migrate Down
+drop table music_blocklist;
+alter table song
+    drop column channel_id,
+    drop column uploader;
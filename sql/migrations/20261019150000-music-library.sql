@@ -0,0 +1,12 @@
+-- +migrate Up
+-- When the file of a song in the local music library was last changed. It's null for songs that aren't in the
+-- library, including library songs whose file has been removed.
+alter table song
+    add column library_modified_at timestamp with time zone;
+
+create index song_library_modified_at_idx on song (library_modified_at) where library_modified_at is not null;
+
+-- +migrate Down
+drop index song_library_modified_at_idx;
+alter table song
+    drop column library_modified_at;
@@ -0,0 +1,30 @@
+-- +migrate Up
+-- Named lists of songs people can queue again later. Playlists without an owner are shared with the whole guild.
+create table saved_playlist
+(
+    id                 bigserial primary key,
+    guild_id           text                                   not null references discord_server,
+    owner_user_id      text,
+    name               text                                   not null,
+    created_by_user_id text                                   not null,
+    created_at         timestamp with time zone default now() not null,
+    updated_at         timestamp with time zone default now() not null
+);
+
+create unique index saved_playlist_name_idx on saved_playlist (guild_id, coalesce(owner_user_id, ''), lower(name));
+
+create table saved_playlist_song
+(
+    id                bigserial primary key,
+    saved_playlist_id bigint                                 not null references saved_playlist on delete cascade,
+    song_id           text                                   not null references song,
+    position          int                                    not null,
+    added_by_user_id  text                                   not null,
+    added_at          timestamp with time zone default now() not null
+);
+
+create index saved_playlist_song_position_idx on saved_playlist_song (saved_playlist_id, position);
+
+-- +migrate Down
+drop table saved_playlist_song;
+drop table saved_playlist;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- How long each song request was actually listened to, which is less than the song's duration when it's skipped.
+alter table song_request
+    add column played_seconds int;
+
+create index idx_song_request_history on song_request (guild_id, played_at) where played = true;
+create index idx_song_request_requester_history on song_request (guild_id, requested_by_user_id, played_at)
+    where played = true;
+
+-- +migrate Down
+drop index idx_song_request_history;
+drop index idx_song_request_requester_history;
+alter table song_request
+    drop column played_seconds;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- How long a live stream plays before moving on to the next song. Null lets streams play until they're skipped.
+alter table music_policy
+    add column max_stream_seconds int;
+
+-- +migrate Down
+alter table music_policy
+    drop column max_stream_seconds;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- The audio filter preset songs are played with. Empty means no filter.
+alter table discord_server
+    add column music_filter text default '' not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_filter;
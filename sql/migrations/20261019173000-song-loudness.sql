@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Integrated loudness of each song from an EBU R128 pass, and the gain that brings it to the loudness songs are
+-- normalized to. Null until the song is measured. Live streams are never measured.
+alter table song
+    add column loudness_lufs    real,
+    add column loudness_gain_db real;
+
+-- +migrate Down
+alter table song
+    drop column loudness_lufs,
+    drop column loudness_gain_db;
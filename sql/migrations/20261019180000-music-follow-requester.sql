@@ -0,0 +1,9 @@
+-- +migrate Up
+-- When on, the bot joins the voice channel of whoever requests a song instead of always using the music voice
+-- channel, which becomes the channel it joins when the requester isn't in one.
+alter table discord_server
+    add column music_follow_requester bool default false not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_follow_requester;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- How long the bot waits with nobody in its voice channel before it pauses or leaves, and which it does. Zero
+-- seconds turns it off. Songs that finish with nobody having heard them can be kept in the queue instead of being
+-- marked played.
+alter table discord_server
+    add column music_idle_seconds int  default 300     not null,
+    add column music_idle_action  text default 'pause' not null,
+    add column music_keep_unheard bool default false   not null;
+
+-- +migrate Down
+alter table discord_server
+    drop column music_idle_seconds,
+    drop column music_idle_action,
+    drop column music_keep_unheard;
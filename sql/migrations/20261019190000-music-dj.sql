@@ -0,0 +1,25 @@
+-- +migrate Up
+-- Members with the DJ role have full control of the queue. When the queue is locked, or more people than
+-- dj_only_listeners are in the bot's voice channel, only DJs can add songs. A null limit means there is no limit.
+alter table music_policy
+    add column dj_role_id        text,
+    add column queue_locked      bool default false not null,
+    add column dj_only_listeners int;
+
+-- Members given DJ for a while without the DJ role.
+create table music_dj_grant
+(
+    guild_id           text                                   not null references discord_server,
+    user_id            text                                   not null,
+    granted_by_user_id text                                   not null,
+    expires_at         timestamp with time zone               not null,
+    created_at         timestamp with time zone default now() not null,
+    primary key (guild_id, user_id)
+);
+
+-- +migrate Down
+drop table music_dj_grant;
+alter table music_policy
+    drop column dj_role_id,
+    drop column queue_locked,
+    drop column dj_only_listeners;